@@ -7,6 +7,8 @@
 package core
 
 import (
+	"cmp"
+	"os"
 	"reflect"
 	"runtime"
 	"runtime/debug"
@@ -274,3 +276,128 @@ func ValueSimple(val reflect.Value) (any, bool) {
 		return nil, false
 	}
 }
+
+// ValueCmp compares two values for sorting. It returns a negative number when
+// "a" sorts before "b", a positive number when "a" sorts after "b", and zero
+// when the order is not defined. Numbers are compared numerically, strings
+// lexically, false sorts before true, and nil values sort first. Structs and
+// arrays are compared element by element, pointers and interfaces by the
+// values they point to. Pointers already visited while comparing the values
+// (cyclic data structures) are considered equal. Values of different kinds
+// are ordered by the kind.
+func ValueCmp(a, b reflect.Value) int {
+	return valueCmp(a, b, nil)
+}
+
+// cmpVisit represents a pair of pointers visited by [valueCmp].
+type cmpVisit struct {
+	a, b uintptr
+}
+
+// valueCmp implements [ValueCmp]. The "visited" set tracks pairs of pointers
+// compared on the current path to stop the recursion on cyclic values. It's
+// created on the first pointer, so comparing scalars doesn't allocate.
+//
+// nolint: cyclop, gocognit
+func valueCmp(a, b reflect.Value, visited map[cmpVisit]bool) int {
+	if a.Kind() == reflect.Interface && b.Kind() == reflect.Interface {
+		if c := nilCmp(a, b); c != 0 || a.IsNil() {
+			return c
+		}
+		a, b = a.Elem(), b.Elem()
+	}
+	if !a.IsValid() || !b.IsValid() {
+		return cmp.Compare(btoi(a.IsValid()), btoi(b.IsValid()))
+	}
+	if a.Kind() != b.Kind() {
+		return cmp.Compare(a.Kind(), b.Kind())
+	}
+
+	switch a.Kind() {
+	case reflect.Bool:
+		return cmp.Compare(btoi(a.Bool()), btoi(b.Bool()))
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(a.Int(), b.Int())
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(a.Uint(), b.Uint())
+
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(a.Float(), b.Float())
+
+	case reflect.Complex64, reflect.Complex128:
+		ac, bc := a.Complex(), b.Complex()
+		if c := cmp.Compare(real(ac), real(bc)); c != 0 {
+			return c
+		}
+		return cmp.Compare(imag(ac), imag(bc))
+
+	case reflect.String:
+		return cmp.Compare(a.String(), b.String())
+
+	case reflect.Pointer:
+		if c := nilCmp(a, b); c != 0 || a.IsNil() {
+			return c
+		}
+		if a.Pointer() == b.Pointer() {
+			return 0
+		}
+		vis := cmpVisit{a.Pointer(), b.Pointer()}
+		if visited[vis] {
+			return 0
+		}
+		if visited == nil {
+			visited = make(map[cmpVisit]bool)
+		}
+		visited[vis] = true
+		return valueCmp(a.Elem(), b.Elem(), visited)
+
+	case reflect.Struct:
+		if a.Type() != b.Type() {
+			return cmp.Compare(a.Type().String(), b.Type().String())
+		}
+		for i := 0; i < a.NumField(); i++ {
+			if c := valueCmp(a.Field(i), b.Field(i), visited); c != 0 {
+				return c
+			}
+		}
+		return 0
+
+	case reflect.Array:
+		for i := 0; i < min(a.Len(), b.Len()); i++ {
+			if c := valueCmp(a.Index(i), b.Index(i), visited); c != 0 {
+				return c
+			}
+		}
+		return cmp.Compare(a.Len(), b.Len())
+
+	default:
+		return 0
+	}
+}
+
+// nilCmp compares two nillable values so nil sorts first. Returns zero when
+// both values are nil or both are not nil.
+func nilCmp(a, b reflect.Value) int {
+	return cmp.Compare(btoi(!a.IsNil()), btoi(!b.IsNil()))
+}
+
+// btoi returns 1 for true and 0 for false.
+func btoi(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// ColorSupported returns true when the NO_COLOR environment variable is not
+// set and the standard output is a terminal.
+func ColorSupported() bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	fi, err := os.Stdout.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
@@ -4,6 +4,7 @@
 package core
 
 import (
+	"os"
 	"reflect"
 	"runtime"
 	"testing"
@@ -633,3 +634,244 @@ func Test_ValueSimple_tabular(t *testing.T) {
 		})
 	}
 }
+
+func Test_ValueCmp_tabular(t *testing.T) {
+	one, two := 1, 2
+
+	tt := []struct {
+		testN string
+
+		a    any
+		b    any
+		want int
+	}{
+		{"bool false & false", false, false, 0},
+		{"bool true & true", true, true, 0},
+		{"bool true & false", true, false, 1},
+		{"bool false & true", false, true, -1},
+
+		{"int 0 & 0", 0, 0, 0},
+		{"int 1 & 1", 0, 0, 0},
+		{"int 2 & 1", 2, 1, 1},
+		{"int 1 & 2", 1, 2, -1},
+
+		{"int8 0 & 0", int8(0), int8(0), 0},
+		{"int8 1 & 1", int8(0), int8(0), 0},
+		{"int8 2 & 1", int8(2), int8(1), 1},
+		{"int8 1 & 2", int8(1), int8(2), -1},
+
+		{"int16 0 & 0", int16(0), int16(0), 0},
+		{"int16 1 & 1", int16(0), int16(0), 0},
+		{"int16 2 & 1", int16(2), int16(1), 1},
+		{"int16 1 & 2", int16(1), int16(2), -1},
+
+		{"int32 0 & 0", int32(0), int32(0), 0},
+		{"int32 1 & 1", int32(0), int32(0), 0},
+		{"int32 2 & 1", int32(2), int32(1), 1},
+		{"int32 1 & 2", int32(1), int32(2), -1},
+
+		{"int64 0 & 0", int64(0), int64(0), 0},
+		{"int64 1 & 1", int64(0), int64(0), 0},
+		{"int64 2 & 1", int64(2), int64(1), 1},
+		{"int64 1 & 2", int64(1), int64(2), -1},
+
+		{"uint 0 & 0", uint(0), uint(0), 0},
+		{"uint 1 & 1", uint(0), uint(0), 0},
+		{"uint 2 & 1", uint(2), uint(1), 1},
+		{"uint 1 & 2", uint(1), uint(2), -1},
+
+		{"uint8 0 & 0", uint8(0), uint8(0), 0},
+		{"uint8 1 & 1", uint8(0), uint8(0), 0},
+		{"uint8 2 & 1", uint8(2), uint8(1), 1},
+		{"uint8 1 & 2", uint8(1), uint8(2), -1},
+
+		{"uint16 0 & 0", uint16(0), uint16(0), 0},
+		{"uint16 1 & 1", uint16(0), uint16(0), 0},
+		{"uint16 2 & 1", uint16(2), uint16(1), 1},
+		{"uint16 1 & 2", uint16(1), uint16(2), -1},
+
+		{"uint32 0 & 0", uint32(0), uint32(0), 0},
+		{"uint32 1 & 1", uint32(0), uint32(0), 0},
+		{"uint32 2 & 1", uint32(2), uint32(1), 1},
+		{"uint32 1 & 2", uint32(1), uint32(2), -1},
+
+		{"uint64 0 & 0", uint64(0), uint64(0), 0},
+		{"uint64 1 & 1", uint64(0), uint64(0), 0},
+		{"uint64 2 & 1", uint64(2), uint64(1), 1},
+		{"uint64 1 & 2", uint64(1), uint64(2), -1},
+
+		{"uintptr 0 & 0", uintptr(0), uintptr(0), 0},
+		{"uintptr 1 & 1", uintptr(0), uintptr(0), 0},
+		{"uintptr 2 & 1", uintptr(2), uintptr(1), 1},
+		{"uintptr 1 & 2", uintptr(1), uintptr(2), -1},
+
+		{"float32 0 & 0", float32(0.0), float32(0.0), 0},
+		{"float32 1 & 1", float32(0.0), float32(0.0), 0},
+		{"float32 2 & 1", float32(2.0), float32(1.0), 1},
+		{"float32 1 & 2", float32(1.0), float32(2.0), -1},
+
+		{"float64 0 & 0", 0.0, 0.0, 0},
+		{"float64 1 & 1", 0.0, 0.0, 0},
+		{"float64 2 & 1", 2.0, 1.0, 1},
+		{"float64 1 & 2", 1.0, 2.0, -1},
+
+		{"string empty & empty", "", "", 0},
+		{"string abc & abc", "abc", "abc", 0},
+		{"string xyz & abc", "xyz", "abc", 1},
+		{"string abc & xyz", "abc", "xyz", -1},
+		{"string 10 & 2", "10", "2", -1},
+
+		{"int 10 & 2", 10, 2, 1},
+		{"float64 10 & 2.5", 10.0, 2.5, 1},
+
+		{"complex128 equal", complex(1, 2), complex(1, 2), 0},
+		{"complex128 real", complex(2, 1), complex(1, 2), 1},
+		{"complex128 imag", complex(1, 1), complex(1, 2), -1},
+
+		{"pointer nil & nil", (*int)(nil), (*int)(nil), 0},
+		{"pointer nil & not nil", (*int)(nil), &one, -1},
+		{"pointer not nil & nil", &one, (*int)(nil), 1},
+		{"pointer same", &one, &one, 0},
+		{"pointer values", &two, &one, 1},
+
+		{"struct equal", types.TIntStr{Int: 1}, types.TIntStr{Int: 1}, 0},
+		{"struct first field", types.TIntStr{Int: 2}, types.TIntStr{Int: 1}, 1},
+		{
+			"struct second field",
+			types.TIntStr{Int: 1, Str: "a"},
+			types.TIntStr{Int: 1, Str: "b"},
+			-1,
+		},
+
+		{"array equal", [2]int{1, 2}, [2]int{1, 2}, 0},
+		{"array", [2]int{1, 3}, [2]int{1, 2}, 1},
+
+		{"different kinds", 1, "a", -1},
+		{"not comparable kinds", []int{2}, []int{1}, 0},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			a := reflect.ValueOf(tc.a)
+			b := reflect.ValueOf(tc.b)
+
+			// --- When ---
+			have := ValueCmp(a, b)
+
+			// --- Then ---
+			if tc.want != have {
+				t.Errorf(expMsg, tc.want, have)
+			}
+		})
+	}
+}
+
+func Test_ValueCmp(t *testing.T) {
+	t.Run("interfaces", func(t *testing.T) {
+		// --- Given ---
+		val := reflect.ValueOf([]any{nil, 2, 10, nil})
+
+		// --- When ---
+		have0 := ValueCmp(val.Index(0), val.Index(3))
+		have1 := ValueCmp(val.Index(0), val.Index(1))
+		have2 := ValueCmp(val.Index(1), val.Index(0))
+		have3 := ValueCmp(val.Index(2), val.Index(1))
+
+		// --- Then ---
+		if have0 != 0 {
+			t.Errorf(expMsg, 0, have0)
+		}
+		if have1 != -1 {
+			t.Errorf(expMsg, -1, have1)
+		}
+		if have2 != 1 {
+			t.Errorf(expMsg, 1, have2)
+		}
+		if have3 != 1 {
+			t.Errorf(expMsg, 1, have3)
+		}
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		// --- When ---
+		have := ValueCmp(reflect.Value{}, reflect.ValueOf(1))
+
+		// --- Then ---
+		if have != -1 {
+			t.Errorf(expMsg, -1, have)
+		}
+	})
+
+	t.Run("cyclic pointers", func(t *testing.T) {
+		// --- Given ---
+		type node struct {
+			Val  int
+			Next *node
+		}
+		a, b := &node{Val: 1}, &node{Val: 1}
+		a.Next, b.Next = b, a
+
+		// --- When ---
+		have := ValueCmp(reflect.ValueOf(a), reflect.ValueOf(b))
+
+		// --- Then ---
+		if have != 0 {
+			t.Errorf(expMsg, 0, have)
+		}
+	})
+
+	t.Run("cyclic pointers not equal", func(t *testing.T) {
+		// --- Given ---
+		type node struct {
+			Next *node
+			Val  int
+		}
+		a, b := &node{Val: 1}, &node{Val: 2}
+		a.Next, b.Next = a, b
+
+		// --- When ---
+		have := ValueCmp(reflect.ValueOf(a), reflect.ValueOf(b))
+
+		// --- Then ---
+		if have != -1 {
+			t.Errorf(expMsg, -1, have)
+		}
+	})
+}
+
+func Test_ColorSupported(t *testing.T) {
+	t.Run("disabled with NO_COLOR", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv("NO_COLOR", "1")
+
+		// --- When ---
+		have := ColorSupported()
+
+		// --- Then ---
+		if have {
+			t.Error("expected colors to be disabled")
+		}
+	})
+
+	t.Run("disabled when not a terminal", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv("NO_COLOR", "")
+		stdout := os.Stdout
+		t.Cleanup(func() { os.Stdout = stdout })
+		fil, err := os.CreateTemp(t.TempDir(), "stdout")
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { _ = fil.Close() })
+		os.Stdout = fil
+
+		// --- When ---
+		have := ColorSupported()
+
+		// --- Then ---
+		if have {
+			t.Error("expected colors to be disabled")
+		}
+	})
+}
@@ -156,6 +156,26 @@ func (typ *TPtr) Identity(val *string) *string { return val }
 
 // /////////////////////////////////////////////////////////////////////////////
 
+type TStringer struct{ Val string }
+
+func (typ TStringer) String() string { return "str:" + typ.Val }
+
+func (typ TStringer) GoString() string { return "gostr:" + typ.Val }
+
+// /////////////////////////////////////////////////////////////////////////////
+
+type TGoStringer struct{ Val string }
+
+func (typ TGoStringer) GoString() string { return "gostr:" + typ.Val }
+
+// /////////////////////////////////////////////////////////////////////////////
+
+type TPanicStringer struct{ Val string }
+
+func (typ TPanicStringer) String() string { panic("string") }
+
+// /////////////////////////////////////////////////////////////////////////////
+
 type TRec struct {
 	Int int
 	Rec *TRec // Recursive.
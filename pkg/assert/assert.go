@@ -13,11 +13,18 @@ import (
 // true if the count matches, otherwise marks the test as failed, writes an
 // error message to the test log and returns false.
 //
-// Currently, only strings are supported.
-func Count(t tester.T, count int, what, where any, opts ...check.Option) bool {
+// Currently, strings, slices and arrays are supported. See [check.Count] for
+// details.
+func Count(
+	t tester.Minimal,
+	count int,
+	what, where any,
+	opts ...check.Option,
+) bool {
 	t.Helper()
+	ran(t)
 	if e := check.Count(count, what, where, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -26,10 +33,11 @@ func Count(t tester.T, count int, what, where any, opts ...check.Option) bool {
 // Type asserts that both arguments are of the same type. Returns true if
 // they are, otherwise marks the test as failed, writes an error message to the
 // test log and returns false.
-func Type(t tester.T, want, have any, opts ...check.Option) bool {
+func Type(t tester.Minimal, want, have any, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.Type(want, have, opts...); e != nil {
-		t.Fatal(e)
+		failNow(t, e, opts...)
 		return false
 	}
 	return true
@@ -38,10 +46,11 @@ func Type(t tester.T, want, have any, opts ...check.Option) bool {
 // Fields asserts struct or pointer to a struct "s" has "want" number of
 // fields. Returns true if it does, otherwise marks the test as failed, writes
 // an error message to the test log and returns false.
-func Fields(t tester.T, want int, s any, opts ...check.Option) bool {
+func Fields(t tester.Minimal, want int, s any, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.Fields(want, s, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
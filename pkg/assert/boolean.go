@@ -10,10 +10,11 @@ import (
 
 // True asserts "have" is true. Returns true if it's, otherwise marks the test
 // as failed, writes an error message to the test log and returns false.
-func True(t tester.T, have bool, opts ...check.Option) bool {
+func True(t tester.Minimal, have bool, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.True(have, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -21,10 +22,11 @@ func True(t tester.T, have bool, opts ...check.Option) bool {
 
 // False asserts "have" is false. Returns true if it's, otherwise marks the
 // test as failed, writes an error message to the test log and returns false.
-func False(t tester.T, have bool, opts ...check.Option) bool {
+func False(t tester.Minimal, have bool, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if err := check.False(have, opts...); err != nil {
-		t.Error(err)
+		fail(t, err, opts...)
 		return false
 	}
 	return true
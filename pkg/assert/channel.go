@@ -14,10 +14,64 @@ import (
 //
 // The "within" may represent duration in the form of a string, int, int64 or
 // [time.Duration].
-func ChannelWillClose[C any](t tester.T, within any, c <-chan C, opts ...check.Option) bool {
+func ChannelWillClose[C any](
+	t tester.Minimal,
+	within any,
+	c <-chan C,
+	opts ...check.Option,
+) bool {
 	t.Helper()
+	ran(t)
 	if err := check.ChannelWillClose(within, c, opts...); err != nil {
-		t.Error(err)
+		fail(t, err, opts...)
+		return false
+	}
+	return true
+}
+
+// ChannelClosed asserts channel "ch" is closed. Returns true if it is,
+// otherwise marks the test as failed, writes an error message to the test log
+// and returns false. See [check.ChannelClosed] for details.
+func ChannelClosed(t tester.Minimal, ch any, opts ...check.Option) bool {
+	t.Helper()
+	ran(t)
+	if e := check.ChannelClosed(ch, opts...); e != nil {
+		fail(t, e, opts...)
+		return false
+	}
+	return true
+}
+
+// ChannelEmpty asserts channel "ch" has no buffered values. Returns true if it
+// has none, otherwise marks the test as failed, writes an error message to the
+// test log and returns false.
+func ChannelEmpty(t tester.Minimal, ch any, opts ...check.Option) bool {
+	t.Helper()
+	ran(t)
+	if e := check.ChannelEmpty(ch, opts...); e != nil {
+		fail(t, e, opts...)
+		return false
+	}
+	return true
+}
+
+// ChannelReceives asserts a value equal to "want" is received from channel
+// "ch" within the "timeout" duration. Returns true if it was, otherwise marks
+// the test as failed, writes an error message to the test log and returns
+// false.
+//
+// The "timeout" may represent duration in the form of a string, int, int64 or
+// [time.Duration].
+func ChannelReceives(
+	t tester.Minimal,
+	want, ch, timeout any,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	ran(t)
+	if e := check.ChannelReceives(want, ch, timeout, opts...); e != nil {
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -65,3 +65,144 @@ func Test_ChannelWillClose(t *testing.T) {
 		affirm.Equal(t, false, have)
 	})
 }
+
+func Test_ChannelClosed(t *testing.T) {
+	closed := make(chan int)
+	close(closed)
+
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := ChannelClosed(tspy, closed)
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := ChannelClosed(tspy, make(chan int))
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := ChannelClosed(tspy, make(chan int), opt)
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+}
+
+func Test_ChannelEmpty(t *testing.T) {
+	full := make(chan int, 1)
+	full <- 1
+
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := ChannelEmpty(tspy, make(chan int, 1))
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := ChannelEmpty(tspy, full)
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := ChannelEmpty(tspy, full, opt)
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+}
+
+func Test_ChannelReceives(t *testing.T) {
+	one := func() chan int {
+		c := make(chan int, 1)
+		c <- 1
+		return c
+	}
+
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := ChannelReceives(tspy, 1, one(), "1s")
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := ChannelReceives(tspy, 2, one(), "1s")
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := ChannelReceives(tspy, 2, one(), "1s", opt)
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+}
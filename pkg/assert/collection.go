@@ -14,17 +14,18 @@ import (
 // Len asserts "have" has "want" length. Returns true if it is, otherwise it
 // marks the test as failed, writes an error message to the test log and
 // returns false.
-func Len(t tester.T, want int, have any, opts ...check.Option) bool {
+func Len(t tester.Minimal, want int, have any, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.Len(want, have, opts...); e != nil {
 		var cnt int
 		if val, ok := notice.From(e).MetaLookup("len"); ok {
 			cnt = val.(int) // nolint: forcetypeassert
 		}
 		if want > cnt {
-			t.Fatal(e)
+			failNow(t, e, opts...)
 		} else {
-			t.Error(e)
+			fail(t, e, opts...)
 		}
 		return false
 	}
@@ -34,17 +35,18 @@ func Len(t tester.T, want int, have any, opts ...check.Option) bool {
 // Cap asserts "have" has "want" capacity. Returns true if it is, otherwise it
 // marks the test as failed, writes an error message to the test log and
 // returns false.
-func Cap(t tester.T, want int, have any, opts ...check.Option) bool {
+func Cap(t tester.Minimal, want int, have any, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.Cap(want, have, opts...); e != nil {
 		var cnt int
 		if val, ok := notice.From(e).MetaLookup("cap"); ok {
 			cnt = val.(int) // nolint: forcetypeassert
 		}
 		if want > cnt {
-			t.Fatal(e)
+			failNow(t, e, opts...)
 		} else {
-			t.Error(e)
+			fail(t, e, opts...)
 		}
 		return false
 	}
@@ -54,10 +56,16 @@ func Cap(t tester.T, want int, have any, opts ...check.Option) bool {
 // Has asserts the slice has "want" value. Returns true if it does, otherwise
 // marks the test as failed, writes an error message to the test log and
 // returns false.
-func Has[T comparable](t tester.T, want T, bag []T, opts ...check.Option) bool {
+func Has[T comparable](
+	t tester.Minimal,
+	want T,
+	bag []T,
+	opts ...check.Option,
+) bool {
 	t.Helper()
+	ran(t)
 	if e := check.Has(want, bag, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -66,10 +74,16 @@ func Has[T comparable](t tester.T, want T, bag []T, opts ...check.Option) bool {
 // HasNo asserts slice does not have a "want" value. Returns true if it does
 // not, otherwise marks the test as failed, writes an error message to the test
 // log and returns false.
-func HasNo[T comparable](t tester.T, want T, bag []T, opts ...check.Option) bool {
+func HasNo[T comparable](
+	t tester.Minimal,
+	want T,
+	bag []T,
+	opts ...check.Option,
+) bool {
 	t.Helper()
+	ran(t)
 	if e := check.HasNo(want, bag, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -78,11 +92,18 @@ func HasNo[T comparable](t tester.T, want T, bag []T, opts ...check.Option) bool
 // HasKey asserts the map has a key. Returns true if it does, otherwise marks
 // the test as failed, writes an error message to the test log and returns
 // false.
-func HasKey[K comparable, V any](t tester.T, key K, set map[K]V, opts ...check.Option) (V, bool) {
+func HasKey[K comparable, V any](
+	t tester.Minimal,
+	key K,
+	set map[K]V,
+	opts ...check.Option) (V,
+	bool,
+) {
 	t.Helper()
+	ran(t)
 	val, e := check.HasKey(key, set, opts...)
 	if e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return val, false
 	}
 	return val, true
@@ -91,10 +112,16 @@ func HasKey[K comparable, V any](t tester.T, key K, set map[K]V, opts ...check.O
 // HasNoKey asserts the map has no key. Returns true if it doesn't, otherwise
 // marks the test as failed, writes an error message to the test log and
 // returns false.
-func HasNoKey[K comparable, V any](t tester.T, key K, set map[K]V, opts ...check.Option) bool {
+func HasNoKey[K comparable, V any](
+	t tester.Minimal,
+	key K,
+	set map[K]V,
+	opts ...check.Option,
+) bool {
 	t.Helper()
+	ran(t)
 	if e := check.HasNoKey(key, set, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -104,7 +131,7 @@ func HasNoKey[K comparable, V any](t tester.T, key K, set map[K]V, opts ...check
 // it doesn't, otherwise marks the test as failed, writes an error message to
 // the test log and returns false.
 func HasKeyValue[K, V comparable](
-	t tester.T,
+	t tester.Minimal,
 	key K,
 	want V,
 	set map[K]V,
@@ -112,8 +139,9 @@ func HasKeyValue[K, V comparable](
 ) bool {
 
 	t.Helper()
+	ran(t)
 	if e := check.HasKeyValue(key, want, set, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -123,10 +151,15 @@ func HasKeyValue[K, V comparable](
 // in the "want" slice must be in the "have" slice. Returns nil if they are,
 // otherwise returns an error with a message indicating the expected and actual
 // values.
-func SliceSubset[T comparable](t tester.T, want, have []T, opts ...check.Option) bool {
+func SliceSubset[T comparable](
+	t tester.Minimal,
+	want, have []T,
+	opts ...check.Option,
+) bool {
 	t.Helper()
+	ran(t)
 	if e := check.SliceSubset(want, have, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -138,14 +171,15 @@ func SliceSubset[T comparable](t tester.T, want, have []T, opts ...check.Option)
 // "want is a subset of "have", otherwise marks the test as failed, writes an
 // error message to the test log and returns false.
 func MapSubset[K cmp.Ordered, V any](
-	t tester.T,
+	t tester.Minimal,
 	want, have map[K]V,
 	opts ...check.Option,
 ) bool {
 
 	t.Helper()
+	ran(t)
 	if e := check.MapSubset(want, have, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -156,14 +190,47 @@ func MapSubset[K cmp.Ordered, V any](
 // corresponding "have" maps, otherwise marks the test as failed, writes an
 // error message to the test log and returns false.
 func MapsSubset[K cmp.Ordered, V any](
-	t tester.T,
+	t tester.Minimal,
 	want, have []map[K]V,
 	opts ...check.Option,
 ) bool {
 
 	t.Helper()
+	ran(t)
 	if e := check.MapsSubset(want, have, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
+		return false
+	}
+	return true
+}
+
+// SliceSorted asserts the slice or array is sorted according to the "less"
+// function. Returns true if it is, otherwise marks the test as failed, writes
+// an error message to the test log and returns false.
+func SliceSorted(
+	t tester.Minimal,
+	have any,
+	less func(i, j int) bool,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	ran(t)
+	if e := check.SliceSorted(have, less, opts...); e != nil {
+		fail(t, e, opts...)
+		return false
+	}
+	return true
+}
+
+// Unique asserts the slice or array has no duplicate elements. Returns true if
+// all elements are unique, otherwise marks the test as failed, writes an error
+// message to the test log and returns false.
+func Unique(t tester.Minimal, have any, opts ...check.Option) bool {
+	t.Helper()
+	ran(t)
+	if e := check.Unique(have, opts...); e != nil {
+		fail(t, e, opts...)
 		return false
 	}
 	return true
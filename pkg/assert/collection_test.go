@@ -553,3 +553,94 @@ func Test_MapsSubset(t *testing.T) {
 		affirm.Equal(t, false, have)
 	})
 }
+
+func Test_SliceSorted(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := SliceSorted(tspy, []int{1, 2}, lessInt([]int{1, 2}))
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := SliceSorted(tspy, []int{2, 1}, lessInt([]int{2, 1}))
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("     trail: type.field[1]\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := SliceSorted(tspy, []int{2, 1}, lessInt([]int{2, 1}), opt)
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+}
+
+// lessInt returns less function for a slice of integers.
+func lessInt(s []int) func(i, j int) bool {
+	return func(i, j int) bool { return s[i] < s[j] }
+}
+
+func Test_Unique(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := Unique(tspy, []int{1, 2})
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := Unique(tspy, []int{1, 1})
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("       trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := Unique(tspy, []int{1, 1}, opt)
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+}
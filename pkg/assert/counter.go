@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package assert
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/ctx42/testing/pkg/notice"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// counters holds the numbers of assertions run for the test managers
+// registered with [CountAtLeast].
+var counters sync.Map // map[tester.Minimal]*atomic.Int64
+
+// CountAtLeast registers a cleanup function verifying at least "n" assertions
+// from this package ran for the test manager. It guards against tests which
+// silently return early without asserting anything. When fewer assertions
+// ran, the cleanup marks the test as failed and writes an error message to
+// the test log. Assertions using other test managers, for example, the ones
+// of subtests, are not counted.
+//
+// Example:
+//
+//	func Test_Something(t *testing.T) {
+//	    assert.CountAtLeast(t, 2)
+//
+//	    // Test code.
+//	}
+func CountAtLeast(t tester.Minimal, n int) {
+	t.Helper()
+	cnt := &atomic.Int64{}
+	counters.Store(t, cnt)
+	t.Cleanup(func() {
+		counters.CompareAndDelete(t, cnt)
+		if have := cnt.Load(); have < int64(n) {
+			fail(t, notice.New("expected at least N assertions to run").
+				Want("%d", n).
+				Have("%d", have))
+		}
+	})
+}
+
+// ran increments the number of assertions run for the test manager
+// registered with [CountAtLeast].
+func ran(t tester.Minimal) {
+	if cnt, ok := counters.Load(t); ok {
+		cnt.(*atomic.Int64).Add(1) // nolint: forcetypeassert
+	}
+}
@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package assert
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_CountAtLeast(t *testing.T) {
+	t.Run("enough assertions", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		// --- When ---
+		CountAtLeast(tspy, 2)
+
+		// --- Then ---
+		True(tspy, true)
+		Equal(tspy, 1, 1)
+	})
+
+	t.Run("failed assertions are counted", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual("expected value to be true")
+		tspy.Close()
+
+		// --- When ---
+		CountAtLeast(tspy, 1)
+
+		// --- Then ---
+		True(tspy, false)
+	})
+
+	t.Run("error - not enough assertions", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		wMsg := "expected at least N assertions to run:\n" +
+			"  want: 2\n" +
+			"  have: 1"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		// --- When ---
+		CountAtLeast(tspy, 2)
+
+		// --- Then ---
+		True(tspy, true)
+	})
+
+	t.Run("error - no assertions", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  have: 0")
+		tspy.Close()
+
+		// --- When ---
+		CountAtLeast(tspy, 1)
+	})
+
+	t.Run("other test managers are not counted", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  have: 0")
+		tspy.Close()
+
+		other := tester.New(t).Close()
+
+		// --- When ---
+		CountAtLeast(tspy, 1)
+
+		// --- Then ---
+		True(other, true)
+	})
+
+	t.Run("counter removed at cleanup", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).ExpectCleanups(1).Close()
+		CountAtLeast(tspy, 0)
+
+		// --- When ---
+		tspy.Finish()
+
+		// --- Then ---
+		_, ok := counters.Load(tspy)
+		affirm.Equal(t, false, ok)
+	})
+}
+
+func Test_ran(t *testing.T) {
+	t.Run("registered", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).ExpectCleanups(1).Close()
+		CountAtLeast(tspy, 0)
+
+		// --- When ---
+		ran(tspy)
+		ran(tspy)
+
+		// --- Then ---
+		cnt, _ := counters.Load(tspy)
+		affirm.Equal(t, int64(2), cnt.(*atomic.Int64).Load())
+	})
+
+	t.Run("not registered", func(t *testing.T) {
+		// --- When ---
+		ran(t)
+
+		// --- Then ---
+		_, ok := counters.Load(t)
+		affirm.Equal(t, false, ok)
+	})
+}
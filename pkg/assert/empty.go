@@ -12,10 +12,11 @@ import (
 // test as failed, writes an error message to the test log and returns false.
 //
 // See [check.Empty] for the list of values which are considered empty.
-func Empty(t tester.T, have any, opts ...check.Option) bool {
+func Empty(t tester.Minimal, have any, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.Empty(have, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -26,10 +27,11 @@ func Empty(t tester.T, have any, opts ...check.Option) bool {
 // returns false.
 //
 // See [check.Empty] for the list of values which are considered empty.
-func NotEmpty(t tester.T, have any, opts ...check.Option) bool {
+func NotEmpty(t tester.Minimal, have any, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.NotEmpty(have, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -4,6 +4,8 @@
 package assert
 
 import (
+	"reflect"
+
 	"github.com/ctx42/testing/pkg/check"
 	"github.com/ctx42/testing/pkg/tester"
 )
@@ -11,10 +13,49 @@ import (
 // Equal asserts both values are equal. Returns true if they are, otherwise
 // marks the test as failed, writes an error message to the test log and
 // returns false.
-func Equal(t tester.T, want, have any, opts ...check.Option) bool {
+func Equal(t tester.Minimal, want, have any, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if err := check.Equal(want, have, opts...); err != nil {
-		t.Error(err)
+		fail(t, err, opts...)
+		return false
+	}
+	return true
+}
+
+// EqualValues asserts both values are equal. It works like [Equal] but uses
+// [reflect.Value] instances. Returns true if they are, otherwise marks the
+// test as failed, writes an error message to the test log and returns false.
+func EqualValues(
+	t tester.Minimal,
+	wVal, hVal reflect.Value,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	ran(t)
+	if err := check.EqualValues(wVal, hVal, opts...); err != nil {
+		fail(t, err, opts...)
+		return false
+	}
+	return true
+}
+
+// EqualT asserts both values are equal. It works like [Equal] but, when no
+// options are given, compares values using the == operator first and uses
+// [check.Equal] only when they are not equal (see [check.EqualT]). Returns
+// true if they are, otherwise marks the test as failed, writes an error
+// message to the test log and returns false.
+func EqualT[T comparable](
+	t tester.Minimal,
+	want, have T,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	ran(t)
+	if err := check.EqualT(want, have, opts...); err != nil {
+		fail(t, err, opts...)
 		return false
 	}
 	return true
@@ -23,10 +64,11 @@ func Equal(t tester.T, want, have any, opts ...check.Option) bool {
 // NotEqual asserts both values are not equal. Returns true if they are not,
 // otherwise marks the test as failed, writes an error message to the test log
 // and returns false.
-func NotEqual(t tester.T, want, have any, opts ...check.Option) bool {
+func NotEqual(t tester.Minimal, want, have any, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if err := check.NotEqual(want, have, opts...); err != nil {
-		t.Error(err)
+		fail(t, err, opts...)
 		return false
 	}
 	return true
@@ -4,10 +4,12 @@
 package assert
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/ctx42/testing/internal/affirm"
 	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/notice"
 	"github.com/ctx42/testing/pkg/tester"
 )
 
@@ -52,6 +54,135 @@ func Test_Equal(t *testing.T) {
 		// --- Then ---
 		affirm.Equal(t, false, have)
 	})
+
+	t.Run("log message with user message", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "[creating user 42] expected values to be equal:\n" +
+			"  want: 42\n" +
+			"  have: 44"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		opt := check.WithMsg("creating user %d", 42)
+
+		// --- When ---
+		have := Equal(tspy, 42, 44, opt)
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("log message quiet", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual("expected values to be equal (trail: type.field)")
+		tspy.Close()
+
+		opts := []check.Option{
+			check.WithTrail("type.field"),
+			check.WithVerbosity(notice.Quiet),
+		}
+
+		// --- When ---
+		have := Equal(tspy, 42, 44, opts...)
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+}
+
+func Test_EqualT(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := EqualT(tspy, 42, 42)
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := EqualT(tspy, 42, 44)
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := EqualT(tspy, 42, 44, opt)
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+}
+
+func Test_EqualValues(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := EqualValues(tspy, reflect.ValueOf(42), reflect.ValueOf(42))
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := EqualValues(tspy, reflect.ValueOf(42), reflect.ValueOf(44))
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := EqualValues(
+			tspy,
+			reflect.ValueOf(42),
+			reflect.ValueOf(44),
+			opt,
+		)
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
 }
 
 func Test_NotEqual(t *testing.T) {
@@ -10,10 +10,11 @@ import (
 
 // Error asserts "err" is not nil. Returns true if it's, otherwise marks the
 // test as failed, writes an error message to the test log and returns false.
-func Error(t tester.T, err error, opts ...check.Option) bool {
+func Error(t tester.Minimal, err error, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.Error(err, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -21,10 +22,11 @@ func Error(t tester.T, err error, opts ...check.Option) bool {
 
 // NoError asserts "err" is nil. Returns true if it is not, otherwise marks the
 // test as failed, writes an error message to the test log and returns false.
-func NoError(t tester.T, err error, opts ...check.Option) bool {
+func NoError(t tester.Minimal, err error, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.NoError(err, opts...); e != nil {
-		t.Fatal(e)
+		failNow(t, e, opts...)
 		return false
 	}
 	return true
@@ -33,10 +35,11 @@ func NoError(t tester.T, err error, opts ...check.Option) bool {
 // ErrorIs asserts whether any error in "err" tree matches the "want" target.
 // Returns true if it does, otherwise marks the test as failed, writes an error
 // message to the test log and returns false.
-func ErrorIs(t tester.T, want, err error, opts ...check.Option) bool {
+func ErrorIs(t tester.Minimal, want, err error, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.ErrorIs(want, err, opts...); e != nil {
-		t.Fatal(e)
+		failNow(t, e, opts...)
 		return false
 	}
 	return true
@@ -46,10 +49,11 @@ func ErrorIs(t tester.T, want, err error, opts ...check.Option) bool {
 // and if one is found, sets a target to that error. Returns true if it does,
 // otherwise marks the test as failed, writes an error message to the test log
 // and returns false.
-func ErrorAs(t tester.T, want any, err error, opts ...check.Option) bool {
+func ErrorAs(t tester.Minimal, want any, err error, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.ErrorAs(want, err, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -58,10 +62,16 @@ func ErrorAs(t tester.T, want any, err error, opts ...check.Option) bool {
 // ErrorEqual asserts "err" is not nil and its message equals to "want".
 // Returns true if it is, otherwise marks the test as failed, writes an error
 // message to the test log and returns false.
-func ErrorEqual(t tester.T, want string, err error, opts ...check.Option) bool {
+func ErrorEqual(
+	t tester.Minimal,
+	want string,
+	err error,
+	opts ...check.Option,
+) bool {
 	t.Helper()
+	ran(t)
 	if e := check.ErrorEqual(want, err, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -70,10 +80,16 @@ func ErrorEqual(t tester.T, want string, err error, opts ...check.Option) bool {
 // ErrorContain asserts "err" is not nil and its message contains "want".
 // Returns true if it does, otherwise marks the test as failed, writes an error
 // message to the test log and returns false.
-func ErrorContain(t tester.T, want string, err error, opts ...check.Option) bool {
+func ErrorContain(
+	t tester.Minimal,
+	want string,
+	err error,
+	opts ...check.Option,
+) bool {
 	t.Helper()
+	ran(t)
 	if e := check.ErrorContain(want, err, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -86,10 +102,16 @@ func ErrorContain(t tester.T, want string, err error, opts ...check.Option) bool
 // The "want" can be either a regular expression string or instance of
 // [regexp.Regexp]. The [fmt.Sprint] is used to get string representation of
 // have argument.
-func ErrorRegexp(t tester.T, want string, err error, opts ...check.Option) bool {
+func ErrorRegexp(
+	t tester.Minimal,
+	want string,
+	err error,
+	opts ...check.Option,
+) bool {
 	t.Helper()
+	ran(t)
 	if e := check.ErrorRegexp(want, err, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package assert
+
+import (
+	"sync/atomic"
+
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// FailureHandler represents a function called for every failed assertion.
+type FailureHandler func(t tester.T, err error)
+
+// onFailure holds the handler set with [OnFailure].
+var onFailure atomic.Pointer[FailureHandler]
+
+// OnFailure sets the global handler called for every failed assertion from
+// this package with the test manager and the assertion error. The handler is
+// called before the error is written to the test log, so teams can forward
+// failures to observability systems, capture artifacts, or skip the test
+// based on the error code (see [github.com/ctx42/testing/pkg/notice.CodeFrom]).
+// The handler is not called for test managers implementing only the
+// [tester.Minimal] interface. Calling it with nil removes the handler.
+//
+// Example:
+//
+//	assert.OnFailure(func(t tester.T, err error) {
+//	    if notice.CodeFrom(err) == "flaky" {
+//	        t.Skip("skipping flaky test")
+//	    }
+//	})
+func OnFailure(fn FailureHandler) {
+	if fn == nil {
+		onFailure.Store(nil)
+		return
+	}
+	onFailure.Store(&fn)
+}
+
+// fail prepends the message set with [check.WithMsg] to the error, calls the
+// handler set with [OnFailure], and marks the test as failed, writing the
+// error to the test log.
+func fail(t tester.Minimal, err error, opts ...check.Option) {
+	t.Helper()
+	err = check.Report(err, opts...)
+	callHandler(t, err)
+	t.Error(err)
+}
+
+// failNow prepends the message set with [check.WithMsg] to the error, calls
+// the handler set with [OnFailure], and marks the test as failed, writing the
+// error to the test log and stopping the test execution.
+func failNow(t tester.Minimal, err error, opts ...check.Option) {
+	t.Helper()
+	err = check.Report(err, opts...)
+	callHandler(t, err)
+	t.Fatal(err)
+}
+
+// callHandler calls the handler set with [OnFailure] if there is one and the
+// test manager implements [tester.T].
+func callHandler(t tester.Minimal, err error) {
+	t.Helper()
+	fn := onFailure.Load()
+	if fn == nil {
+		return
+	}
+	if tt, ok := t.(tester.T); ok {
+		(*fn)(tt, err)
+	}
+}
@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package assert
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/notice"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// minimalT is a test manager implementing only the [tester.Minimal].
+type minimalT struct{ spy *tester.Spy }
+
+func (mt minimalT) Cleanup(fn func()) { mt.spy.Cleanup(fn) }
+func (mt minimalT) Error(args ...any) { mt.spy.Error(args...) }
+func (mt minimalT) Fatal(args ...any) { mt.spy.Fatal(args...) }
+func (mt minimalT) Helper()           { mt.spy.Helper() }
+func (mt minimalT) Name() string      { return mt.spy.Name() }
+
+func Test_OnFailure(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { OnFailure(nil) })
+		var called bool
+
+		// --- When ---
+		OnFailure(func(tester.T, error) { called = true })
+
+		// --- Then ---
+		fn := onFailure.Load()
+		affirm.NotNil(t, fn)
+		(*fn)(nil, nil)
+		affirm.Equal(t, true, called)
+	})
+
+	t.Run("nil removes handler", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { OnFailure(nil) })
+		OnFailure(func(tester.T, error) {})
+
+		// --- When ---
+		OnFailure(nil)
+
+		// --- Then ---
+		affirm.Nil(t, onFailure.Load())
+	})
+
+	t.Run("called for failed assertion", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { OnFailure(nil) })
+
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual("expected value to be true")
+		tspy.Close()
+
+		var haveT tester.T
+		var haveErr error
+		OnFailure(func(t tester.T, err error) { haveT, haveErr = t, err })
+
+		// --- When ---
+		have := True(tspy, false)
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+		affirm.Equal(t, true, haveT == tspy)
+		affirm.Equal(t, "expected value to be true", haveErr.Error())
+	})
+
+	t.Run("not called for successful assertion", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { OnFailure(nil) })
+		tspy := tester.New(t).Close()
+
+		var called bool
+		OnFailure(func(tester.T, error) { called = true })
+
+		// --- When ---
+		have := True(tspy, true)
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+		affirm.Equal(t, false, called)
+	})
+
+	t.Run("not called for minimal test manager", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { OnFailure(nil) })
+
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual("expected value to be true")
+		tspy.Close()
+
+		var called bool
+		OnFailure(func(tester.T, error) { called = true })
+
+		// --- When ---
+		have := True(minimalT{spy: tspy}, false)
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+		affirm.Equal(t, false, called)
+	})
+
+	t.Run("called for fatal assertion", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { OnFailure(nil) })
+
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		var called bool
+		OnFailure(func(tester.T, error) { called = true })
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { Type(tspy, 1, "abc") })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+		affirm.Equal(t, true, called)
+	})
+
+	t.Run("called before logging", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { OnFailure(nil) })
+
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual("handler\nexpected value to be true")
+		tspy.Close()
+
+		OnFailure(func(t tester.T, _ error) { t.Log("handler") })
+
+		// --- When ---
+		have := True(tspy, false)
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("error code", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { OnFailure(nil) })
+
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		var code string
+		OnFailure(func(_ tester.T, err error) { code = notice.CodeFrom(err) })
+
+		// --- When ---
+		Equal(tspy, 1, "1")
+
+		// --- Then ---
+		affirm.Equal(t, "equal.type-mismatch", code)
+	})
+	t.Run("called with user message", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { OnFailure(nil) })
+
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		var haveErr error
+		OnFailure(func(_ tester.T, err error) { haveErr = err })
+
+		// --- When ---
+		True(tspy, false, check.WithMsg("msg"))
+
+		// --- Then ---
+		affirm.Equal(t, "[msg] expected value to be true", haveErr.Error())
+	})
+}
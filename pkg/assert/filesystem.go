@@ -4,6 +4,8 @@
 package assert
 
 import (
+	"io/fs"
+
 	"github.com/ctx42/testing/pkg/check"
 	"github.com/ctx42/testing/pkg/tester"
 )
@@ -12,10 +14,11 @@ import (
 // points to a filesystem entry, which is not a file, or there is an error when
 // trying to check the path. Returns true on success, otherwise marks the test
 // as failed, writes an error message to the test log and returns false.
-func FileExist(t tester.T, pth string, opts ...check.Option) bool {
+func FileExist(t tester.Minimal, pth string, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.FileExist(pth, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -25,10 +28,11 @@ func FileExist(t tester.T, pth string, opts ...check.Option) bool {
 // path points to an existing filesystem entry. Returns true on success,
 // otherwise marks the test as failed, writes an error message to the test log
 // and returns false.
-func NoFileExist(t tester.T, pth string, opts ...check.Option) bool {
+func NoFileExist(t tester.Minimal, pth string, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.NoFileExist(pth, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -40,10 +44,56 @@ func NoFileExist(t tester.T, pth string, opts ...check.Option) bool {
 // then [Contain] assertion is used to check it contains the "want" string.
 // Returns true on success, otherwise marks the test as failed, writes an error
 // message to the test log and returns false.
-func FileContain[T check.Content](t tester.T, want T, pth string, opts ...check.Option) bool {
+func FileContain[T check.Content](
+	t tester.Minimal,
+	want T,
+	pth string,
+	opts ...check.Option,
+) bool {
 	t.Helper()
+	ran(t)
 	if e := check.FileContain(want, pth, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
+		return false
+	}
+	return true
+}
+
+// FileEqual asserts a file at "pth" can be read and its content is equal to
+// "want". It fails if the path points to a filesystem entry, which is not a
+// file, or there is an error reading the file. Returns true on success,
+// otherwise marks the test as failed, writes an error message to the test log
+// and returns false.
+func FileEqual[T check.Content](
+	t tester.Minimal,
+	want T,
+	pth string,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	ran(t)
+	if e := check.FileEqual(want, pth, opts...); e != nil {
+		fail(t, e, opts...)
+		return false
+	}
+	return true
+}
+
+// FileMode asserts "pth" points to an existing filesystem entry with "want"
+// permission bits. Returns true on success, otherwise marks the test as
+// failed, writes an error message to the test log and returns false.
+func FileMode(
+	t tester.Minimal,
+	want fs.FileMode,
+	pth string,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	ran(t)
+	if e := check.FileMode(want, pth, opts...); e != nil {
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -53,10 +103,11 @@ func FileContain[T check.Content](t tester.T, want T, pth string, opts ...check.
 // points to a filesystem entry, which is not a directory, or there is an error
 // when trying to check the path. Returns true on success, otherwise marks the
 // test as failed, writes an error message to the test log and returns false.
-func DirExist(t tester.T, pth string, opts ...check.Option) bool {
+func DirExist(t tester.Minimal, pth string, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.DirExist(pth, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -66,10 +117,11 @@ func DirExist(t tester.T, pth string, opts ...check.Option) bool {
 // path points to an existing filesystem entry. Returns true on success,
 // otherwise marks the test as failed, writes an error message to the test log
 // and returns false.
-func NoDirExist(t tester.T, pth string, opts ...check.Option) bool {
+func NoDirExist(t tester.Minimal, pth string, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.NoDirExist(pth, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -5,6 +5,7 @@ package assert
 
 import (
 	"testing"
+	"testing/fstest"
 
 	"github.com/ctx42/testing/internal/affirm"
 	"github.com/ctx42/testing/pkg/check"
@@ -142,6 +143,94 @@ func Test_FileContain(t *testing.T) {
 	})
 }
 
+func Test_FileEqual(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := FileEqual(tspy, "abc def ghi\njkl mno pqr", "testdata/file.txt")
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := FileEqual(tspy, "abc", "testdata/file.txt")
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := FileEqual(tspy, "abc", "testdata/file.txt", opt)
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+}
+
+func Test_FileMode(t *testing.T) {
+	fsys := fstest.MapFS{"file.txt": &fstest.MapFile{Mode: 0640}}
+
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := FileMode(tspy, 0640, "file.txt", check.WithFS(fsys))
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := FileMode(tspy, 0600, "file.txt", check.WithFS(fsys))
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := FileMode(tspy, 0600, "file.txt", check.WithFS(fsys), opt)
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+}
+
 func Test_DirExist(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		// --- Given ---
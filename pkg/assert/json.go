@@ -15,10 +15,35 @@ import (
 // Example:
 //
 //	assert.JSON(t, `{"hello": "world"}`, `{"foo": "bar"}`)
-func JSON(t tester.T, want, have string, opts ...check.Option) bool {
+func JSON(t tester.Minimal, want, have string, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.JSON(want, have, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
+		return false
+	}
+	return true
+}
+
+// JSONPath asserts the value at "path" in "jsonDoc" is equal to "want".
+// Returns true if it is, otherwise marks the test as failed, writes an error
+// message to the test log and returns false. See [check.JSONPath] for the
+// supported document types and the path syntax.
+//
+// Example:
+//
+//	assert.JSONPath(t, `$.users[0].name`, "Bob", doc)
+func JSONPath(
+	t tester.Minimal,
+	path string,
+	want, jsonDoc any,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	ran(t)
+	if e := check.JSONPath(path, want, jsonDoc, opts...); e != nil {
+		fail(t, e, opts...)
 		return false
 	}
 	return true
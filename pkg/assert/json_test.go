@@ -61,3 +61,46 @@ func Test_JSON(t *testing.T) {
 		affirm.Equal(t, false, got)
 	})
 }
+
+func Test_JSONPath(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := JSONPath(tspy, "a.b", 1, `{"a": {"b": 1}}`)
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := JSONPath(tspy, "a.b", 2, `{"a": {"b": 1}}`)
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := JSONPath(tspy, "a.b", 2, `{"a": {"b": 1}}`, opt)
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+}
@@ -10,10 +10,11 @@ import (
 
 // Nil asserts "have" is nil. Returns true if it is, otherwise marks the test
 // as failed, writes an error message to the test log and returns false.
-func Nil(t tester.T, have any, opts ...check.Option) bool {
+func Nil(t tester.Minimal, have any, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.Nil(have, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -22,10 +23,11 @@ func Nil(t tester.T, have any, opts ...check.Option) bool {
 // NotNil asserts "have" is not nil. Returns true if it is not, otherwise marks
 // the test as failed, writes an error message to the test log and returns
 // false.
-func NotNil(t tester.T, have any, opts ...check.Option) bool {
+func NotNil(t tester.Minimal, have any, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.NotNil(have, opts...); e != nil {
-		t.Fatal(e)
+		failNow(t, e, opts...)
 		return false
 	}
 	return true
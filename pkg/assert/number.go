@@ -12,15 +12,18 @@ import (
 // Greater checks the "want" value is greater than the "have" value. Returns
 // true if it is, otherwise marks the test as failed, writes an error message to
 // the test log and returns false.
+//
+//	want > have
 func Greater[T constraints.Ordered](
-	t tester.T,
+	t tester.Minimal,
 	want, have T,
 	opts ...check.Option,
 ) bool {
 
 	t.Helper()
+	ran(t)
 	if err := check.Greater(want, have, opts...); err != nil {
-		t.Error(err)
+		fail(t, err, opts...)
 		return false
 	}
 	return true
@@ -29,15 +32,18 @@ func Greater[T constraints.Ordered](
 // GreaterOrEqual checks the "want" value is greater or equal than the "have"
 // value. Returns true if it is, otherwise marks the test as failed, writes an
 // error message to the test log and returns false.
+//
+//	want >= have
 func GreaterOrEqual[T constraints.Ordered](
-	t tester.T,
+	t tester.Minimal,
 	want, have T,
 	opts ...check.Option,
 ) bool {
 
 	t.Helper()
+	ran(t)
 	if err := check.GreaterOrEqual(want, have, opts...); err != nil {
-		t.Error(err)
+		fail(t, err, opts...)
 		return false
 	}
 	return true
@@ -46,15 +52,18 @@ func GreaterOrEqual[T constraints.Ordered](
 // Smaller checks the "want" value is smaller than the "have" value. Returns
 // true if it is, otherwise marks the test as failed, writes an error message
 // to the test log and returns false.
+//
+//	want < have
 func Smaller[T constraints.Ordered](
-	t tester.T,
+	t tester.Minimal,
 	want, have T,
 	opts ...check.Option,
 ) bool {
 
 	t.Helper()
+	ran(t)
 	if err := check.Smaller(want, have, opts...); err != nil {
-		t.Error(err)
+		fail(t, err, opts...)
 		return false
 	}
 	return true
@@ -63,15 +72,18 @@ func Smaller[T constraints.Ordered](
 // SmallerOrEqual checks the "want" value is smaller or equal than the "have"
 // value. Returns true if it is, otherwise marks the test as failed, writes an
 // error message to the test log and returns false.
+//
+//	want <= have
 func SmallerOrEqual[T constraints.Ordered](
-	t tester.T,
+	t tester.Minimal,
 	want, have T,
 	opts ...check.Option,
 ) bool {
 
 	t.Helper()
+	ran(t)
 	if err := check.SmallerOrEqual(want, have, opts...); err != nil {
-		t.Error(err)
+		fail(t, err, opts...)
 		return false
 	}
 	return true
@@ -83,14 +95,15 @@ func SmallerOrEqual[T constraints.Ordered](
 //
 //	|w-h|/|w| <= delta
 func Delta[T, E constraints.Number](
-	t tester.T,
+	t tester.Minimal,
 	want T, delta E, have T,
 	opts ...check.Option,
 ) bool {
 
 	t.Helper()
+	ran(t)
 	if e := check.Delta(want, delta, have, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -103,14 +116,15 @@ func Delta[T, E constraints.Number](
 //
 //	|w[i]-h[i]| <= delta
 func DeltaSlice[T, E constraints.Number](
-	t tester.T,
+	t tester.Minimal,
 	want []T, delta E, have []T,
 	opts ...check.Option,
 ) bool {
 
 	t.Helper()
+	ran(t)
 	if err := check.DeltaSlice(want, delta, have, opts...); err != nil {
-		t.Error(err)
+		fail(t, err, opts...)
 		return false
 	}
 	return true
@@ -122,14 +136,15 @@ func DeltaSlice[T, E constraints.Number](
 //
 //	|w-h|/|w| <= epsilon
 func Epsilon[T, E constraints.Number](
-	t tester.T,
+	t tester.Minimal,
 	want T, epsilon E, have T,
 	opts ...check.Option,
 ) bool {
 
 	t.Helper()
+	ran(t)
 	if e := check.Epsilon(want, epsilon, have, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -142,14 +157,15 @@ func Epsilon[T, E constraints.Number](
 //
 //	|w[i]-h[i]|/|w[i]| <= epsilon
 func EpsilonSlice[T, E constraints.Number](
-	t tester.T,
+	t tester.Minimal,
 	want []T, epsilon E, have []T,
 	opts ...check.Option,
 ) bool {
 
 	t.Helper()
+	ran(t)
 	if err := check.EpsilonSlice(want, epsilon, have, opts...); err != nil {
-		t.Error(err)
+		fail(t, err, opts...)
 		return false
 	}
 	return true
@@ -161,14 +177,15 @@ func EpsilonSlice[T, E constraints.Number](
 // marks the test as failed, writes an error message to the test log and
 // returns false.
 func Increasing[T constraints.Ordered](
-	t tester.T,
+	t tester.Minimal,
 	seq []T,
 	opts ...check.Option,
 ) bool {
 
 	t.Helper()
+	ran(t)
 	if err := check.Increasing(seq, opts...); err != nil {
-		t.Error(err)
+		fail(t, err, opts...)
 		return false
 	}
 	return true
@@ -176,14 +193,15 @@ func Increasing[T constraints.Ordered](
 
 // NotIncreasing is inverse of [Increasing].
 func NotIncreasing[T constraints.Ordered](
-	t tester.T,
+	t tester.Minimal,
 	seq []T,
 	opts ...check.Option,
 ) bool {
 
 	t.Helper()
+	ran(t)
 	if err := check.NotIncreasing(seq, opts...); err != nil {
-		t.Error(err)
+		fail(t, err, opts...)
 		return false
 	}
 	return true
@@ -195,14 +213,15 @@ func NotIncreasing[T constraints.Ordered](
 // marks the test as failed, writes an error message to the test log and
 // returns false.
 func Decreasing[T constraints.Ordered](
-	t tester.T,
+	t tester.Minimal,
 	seq []T,
 	opts ...check.Option,
 ) bool {
 
 	t.Helper()
+	ran(t)
 	if err := check.Decreasing(seq, opts...); err != nil {
-		t.Error(err)
+		fail(t, err, opts...)
 		return false
 	}
 	return true
@@ -210,14 +229,107 @@ func Decreasing[T constraints.Ordered](
 
 // NotDecreasing is inverse of [Decreasing].
 func NotDecreasing[T constraints.Ordered](
-	t tester.T,
+	t tester.Minimal,
 	seq []T,
 	opts ...check.Option,
 ) bool {
 
 	t.Helper()
+	ran(t)
 	if err := check.NotDecreasing(seq, opts...); err != nil {
-		t.Error(err)
+		fail(t, err, opts...)
+		return false
+	}
+	return true
+}
+
+// Between asserts the "have" value is within the closed range of "minimum"
+// and "maximum" values. Returns true if it is, otherwise marks the test as
+// failed, writes an error message to the test log and returns false.
+//
+//	minimum <= have <= maximum
+func Between(
+	t tester.Minimal,
+	minimum, maximum, have any,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	ran(t)
+	if err := check.Between(minimum, maximum, have, opts...); err != nil {
+		fail(t, err, opts...)
+		return false
+	}
+	return true
+}
+
+// GreaterThan asserts the "have" value is greater than the "limit" value.
+// Returns true if it is, otherwise marks the test as failed, writes an error
+// message to the test log and returns false.
+//
+// For values of the same type, it's the same as [Smaller] with the same
+// arguments (see [check.GreaterThan]).
+//
+//	have > limit
+func GreaterThan(t tester.Minimal, limit, have any, opts ...check.Option) bool {
+	t.Helper()
+	ran(t)
+	if err := check.GreaterThan(limit, have, opts...); err != nil {
+		fail(t, err, opts...)
+		return false
+	}
+	return true
+}
+
+// LessOrEqual asserts the "have" value is less or equal to the "limit" value.
+// Returns true if it is, otherwise marks the test as failed, writes an error
+// message to the test log and returns false.
+//
+// For values of the same type, it's the same as [GreaterOrEqual] with the
+// same arguments (see [check.GreaterThan]).
+//
+//	have <= limit
+func LessOrEqual(t tester.Minimal, limit, have any, opts ...check.Option) bool {
+	t.Helper()
+	ran(t)
+	if err := check.LessOrEqual(limit, have, opts...); err != nil {
+		fail(t, err, opts...)
+		return false
+	}
+	return true
+}
+
+// Positive asserts the "have" value is greater than zero. Returns true if it
+// is, otherwise marks the test as failed, writes an error message to the test
+// log and returns false.
+func Positive[T constraints.Number](
+	t tester.Minimal,
+	have T,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	ran(t)
+	if err := check.Positive(have, opts...); err != nil {
+		fail(t, err, opts...)
+		return false
+	}
+	return true
+}
+
+// Negative asserts the "have" value is less than zero. Returns true if it is,
+// otherwise marks the test as failed, writes an error message to the test log
+// and returns false.
+func Negative[T constraints.Number](
+	t tester.Minimal,
+	have T,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	ran(t)
+	if err := check.Negative(have, opts...); err != nil {
+		fail(t, err, opts...)
 		return false
 	}
 	return true
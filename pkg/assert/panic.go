@@ -10,10 +10,11 @@ import (
 
 // Panic asserts "fn" panics. Returns true if it panicked, otherwise marks the
 // test as failed, writes an error message to the test log and returns false.
-func Panic(t tester.T, fn check.TestFunc, opts ...check.Option) bool {
+func Panic(t tester.Minimal, fn check.TestFunc, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.Panic(fn, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -22,10 +23,11 @@ func Panic(t tester.T, fn check.TestFunc, opts ...check.Option) bool {
 // NoPanic asserts "fn" does not panic. Returns true if it did not panic,
 // otherwise marks the test as failed, writes an error message to the test log
 // and returns false.
-func NoPanic(t tester.T, fn check.TestFunc, opts ...check.Option) bool {
+func NoPanic(t tester.Minimal, fn check.TestFunc, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.NoPanic(fn, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -35,10 +37,16 @@ func NoPanic(t tester.T, fn check.TestFunc, opts ...check.Option) bool {
 // as a string contains "want". Returns true if it panics and does contain the
 // wanted string, otherwise marks the test as failed, writes an error message
 // to the test log and returns false.
-func PanicContain(t tester.T, want string, fn check.TestFunc, opts ...check.Option) bool {
+func PanicContain(
+	t tester.Minimal,
+	want string,
+	fn check.TestFunc,
+	opts ...check.Option,
+) bool {
 	t.Helper()
+	ran(t)
 	if e := check.PanicContain(want, fn, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -47,11 +55,16 @@ func PanicContain(t tester.T, want string, fn check.TestFunc, opts ...check.Opti
 // PanicMsg asserts the "fn" panics and returns the recovered panic value
 // represented as a string. If the function did not panic, it marks the test as
 // failed and writes an error message to the test log.
-func PanicMsg(t tester.T, fn check.TestFunc, opts ...check.Option) *string {
+func PanicMsg(
+	t tester.Minimal,
+	fn check.TestFunc,
+	opts ...check.Option,
+) *string {
 	t.Helper()
+	ran(t)
 	msg, e := check.PanicMsg(fn, opts...)
 	if e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return nil
 	}
 	return msg
@@ -14,10 +14,11 @@ import (
 //
 // Both arguments must be pointer variables. Pointer variable sameness is
 // determined based on the equality of both type and value.
-func Same(t tester.T, want, have any, opts ...check.Option) bool {
+func Same(t tester.Minimal, want, have any, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.Same(want, have, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -29,10 +30,11 @@ func Same(t tester.T, want, have any, opts ...check.Option) bool {
 //
 // Both arguments must be pointer variables. Pointer variable sameness is
 // determined based on the equality of both type and value.
-func NotSame(t tester.T, want, have any, opts ...check.Option) bool {
+func NotSame(t tester.Minimal, want, have any, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.NotSame(want, have, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package assert
+
+import (
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// SemVer asserts "have" is a valid semantic version as defined by
+// https://semver.org. Returns true if it is, otherwise marks the test as
+// failed, writes an error message to the test log and returns false.
+func SemVer(t tester.Minimal, have string, opts ...check.Option) bool {
+	t.Helper()
+	ran(t)
+	if e := check.SemVer(have, opts...); e != nil {
+		fail(t, e, opts...)
+		return false
+	}
+	return true
+}
+
+// SemVerConstraint asserts "have" semantic version satisfies the
+// "constraint". Returns true if it does, otherwise marks the test as failed,
+// writes an error message to the test log and returns false. See
+// [check.SemVerConstraint] for the constraint syntax.
+func SemVerConstraint(
+	t tester.Minimal,
+	constraint, have string,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	ran(t)
+	if e := check.SemVerConstraint(constraint, have, opts...); e != nil {
+		fail(t, e, opts...)
+		return false
+	}
+	return true
+}
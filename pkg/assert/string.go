@@ -11,10 +11,11 @@ import (
 // Contain asserts "want" is a substring of "have". Returns true if it's,
 // otherwise marks the test as failed, writes an error message to the test log
 // and returns false.
-func Contain(t tester.T, want, have string, opts ...check.Option) bool {
+func Contain(t tester.Minimal, want, have string, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.Contain(want, have, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -23,10 +24,97 @@ func Contain(t tester.T, want, have string, opts ...check.Option) bool {
 // NotContain asserts "want" is not a substring of "have". Returns true if it's
 // not, otherwise marks the test as failed, writes an error message to the test
 // log and returns false.
-func NotContain(t tester.T, want, have string, opts ...check.Option) bool {
+func NotContain(
+	t tester.Minimal,
+	want, have string,
+	opts ...check.Option,
+) bool {
 	t.Helper()
+	ran(t)
 	if e := check.NotContain(want, have, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
+		return false
+	}
+	return true
+}
+
+// EqualFold asserts "want" and "have" strings are equal under simple Unicode
+// case-folding. Returns true if they are, otherwise marks the test as failed,
+// writes an error message to the test log and returns false.
+func EqualFold(t tester.Minimal, want, have string, opts ...check.Option) bool {
+	t.Helper()
+	ran(t)
+	if e := check.EqualFold(want, have, opts...); e != nil {
+		fail(t, e, opts...)
+		return false
+	}
+	return true
+}
+
+// EqualTrimmed asserts "want" and "have" strings are equal after normalizing
+// whitespace (see [check.EqualTrimmed]). Returns true if they are, otherwise
+// marks the test as failed, writes an error message to the test log and
+// returns false.
+func EqualTrimmed(
+	t tester.Minimal,
+	want, have string,
+	opts ...check.Option,
+) bool {
+	t.Helper()
+	ran(t)
+	if e := check.EqualTrimmed(want, have, opts...); e != nil {
+		fail(t, e, opts...)
+		return false
+	}
+	return true
+}
+
+// HasPrefix asserts "have" string starts with the "prefix". Returns true if it
+// does, otherwise marks the test as failed, writes an error message to the
+// test log and returns false.
+func HasPrefix(
+	t tester.Minimal,
+	prefix, have string,
+	opts ...check.Option,
+) bool {
+	t.Helper()
+	ran(t)
+	if e := check.HasPrefix(prefix, have, opts...); e != nil {
+		fail(t, e, opts...)
+		return false
+	}
+	return true
+}
+
+// HasSuffix asserts "have" string ends with the "suffix". Returns true if it
+// does, otherwise marks the test as failed, writes an error message to the
+// test log and returns false.
+func HasSuffix(
+	t tester.Minimal,
+	suffix, have string,
+	opts ...check.Option,
+) bool {
+	t.Helper()
+	ran(t)
+	if e := check.HasSuffix(suffix, have, opts...); e != nil {
+		fail(t, e, opts...)
+		return false
+	}
+	return true
+}
+
+// StringContains asserts "want" is a substring of "have". Returns true if it
+// is, otherwise marks the test as failed, writes an error message to the
+// test log and returns false.
+func StringContains(
+	t tester.Minimal,
+	want, have string,
+	opts ...check.Option,
+) bool {
+	t.Helper()
+	ran(t)
+	if e := check.StringContains(want, have, opts...); e != nil {
+		fail(t, e, opts...)
 		return false
 	}
 	return true
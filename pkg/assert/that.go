@@ -0,0 +1,226 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/notice"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// Subject represents a value under test for chainable assertions. Use [That]
+// to create it.
+type Subject struct {
+	t      tester.Minimal // Test manager.
+	val    any            // The value under test.
+	trail  string         // Trail to the value under test.
+	opts   []check.Option // Options used by all assertions.
+	failed bool           // Set when the value couldn't be found.
+}
+
+// That returns the [Subject] representing the "have" value for chainable
+// assertions. The options are used by all assertions of the subject.
+//
+// Example:
+//
+//	assert.That(t, order).Field("User.Name").Equals("bob")
+//	assert.That(t, tags).Contains("go")
+func That(t tester.Minimal, have any, opts ...check.Option) *Subject {
+	t.Helper()
+	ops := check.DefaultOptions(opts...)
+	return &Subject{t: t, val: have, trail: ops.Trail, opts: opts}
+}
+
+// Field returns the [Subject] representing the value at the "path" in the
+// current subject value. The path uses the [notice.Trail] format, for
+// example, `User.Name`, `Items[1].Price` or `Meta["key"]`, where names are
+// struct fields, bracketed numbers are slice or array indexes and the other
+// bracketed values are map keys. Pointers and interfaces are dereferenced,
+// and unexported struct fields cannot be accessed. If the path doesn't exist,
+// it marks the test as failed and writes an error message to the test log,
+// and all assertions of the returned subject return false without logging.
+func (sub *Subject) Field(path string) *Subject {
+	sub.t.Helper()
+	if sub.failed {
+		return sub
+	}
+	trail := sub.trail
+	val := reflect.ValueOf(sub.val)
+	for _, seg := range notice.ParseTrail(path) {
+		trail = joinTrail(trail, seg)
+		var ok bool
+		if val, ok = segmentValue(val, seg); !ok {
+			msg := notice.New("expected the field to exist").
+				SetTrail(trail).
+				Append("path", "%s", path)
+			fail(sub.t, msg, sub.opts...)
+			return &Subject{t: sub.t, trail: trail, opts: sub.opts, failed: true}
+		}
+	}
+	var have any
+	if val.IsValid() {
+		have = val.Interface()
+	}
+	return &Subject{t: sub.t, val: have, trail: trail, opts: sub.opts}
+}
+
+// Equals asserts the subject value is equal to "want" using [check.Equal].
+// Returns true if it is, otherwise marks the test as failed, writes an error
+// message to the test log and returns false.
+func (sub *Subject) Equals(want any, opts ...check.Option) bool {
+	sub.t.Helper()
+	if sub.failed {
+		return false
+	}
+	return Equal(sub.t, want, sub.val, sub.options(opts)...)
+}
+
+// Contains asserts the subject value contains "want". For strings, "want"
+// must be a substring, for slices and arrays, one of the elements must be
+// equal to "want", and for maps, one of the keys must be equal to "want".
+// Returns true if it does, otherwise marks the test as failed, writes an
+// error message to the test log and returns false.
+func (sub *Subject) Contains(want any, opts ...check.Option) bool {
+	sub.t.Helper()
+	if sub.failed {
+		return false
+	}
+	ran(sub.t)
+	if e := contains(want, sub.val, sub.options(opts)...); e != nil {
+		fail(sub.t, e, sub.options(opts)...)
+		return false
+	}
+	return true
+}
+
+// IsEmpty asserts the subject value is empty using [check.Empty]. Returns
+// true if it is, otherwise marks the test as failed, writes an error message
+// to the test log and returns false.
+func (sub *Subject) IsEmpty(opts ...check.Option) bool {
+	sub.t.Helper()
+	if sub.failed {
+		return false
+	}
+	return Empty(sub.t, sub.val, sub.options(opts)...)
+}
+
+// options returns the subject options followed by "opts" and the option
+// setting the trail to the subject value.
+func (sub *Subject) options(opts []check.Option) []check.Option {
+	all := make([]check.Option, 0, len(sub.opts)+len(opts)+1)
+	all = append(all, sub.opts...)
+	all = append(all, opts...)
+	return append(all, check.WithTrail(sub.trail))
+}
+
+// joinTrail returns the trail with the segment appended.
+func joinTrail(trail string, seg notice.Segment) string {
+	return append(notice.ParseTrail(trail), seg).String()
+}
+
+// segmentValue returns the value represented by the segment in "val".
+// Returns false if it doesn't exist.
+func segmentValue(val reflect.Value, seg notice.Segment) (reflect.Value, bool) {
+	for val.Kind() == reflect.Pointer || val.Kind() == reflect.Interface {
+		if val.IsNil() {
+			return reflect.Value{}, false
+		}
+		val = val.Elem()
+	}
+
+	switch seg.Kind {
+	case notice.SegField:
+		if val.Kind() != reflect.Struct {
+			return reflect.Value{}, false
+		}
+		fld, ok := val.Type().FieldByName(seg.Name)
+		if !ok || !fld.IsExported() {
+			return reflect.Value{}, false
+		}
+		ret, err := val.FieldByIndexErr(fld.Index)
+		return ret, err == nil
+
+	case notice.SegIndex:
+		if val.Kind() == reflect.Map {
+			return mapValue(val, seg.Name)
+		}
+		if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
+			return reflect.Value{}, false
+		}
+		idx, err := strconv.Atoi(seg.Name)
+		if err != nil || idx >= val.Len() {
+			return reflect.Value{}, false
+		}
+		return val.Index(idx), true
+
+	default:
+		if val.Kind() != reflect.Map {
+			return reflect.Value{}, false
+		}
+		return mapValue(val, seg.Name)
+	}
+}
+
+// mapValue returns the map value for the key represented as a string. String
+// keys must be quoted, other keys are parsed using [fmt.Sscan]. Returns false
+// if the key doesn't exist or cannot be parsed.
+func mapValue(val reflect.Value, str string) (reflect.Value, bool) {
+	key := reflect.New(val.Type().Key()).Elem()
+	if key.Kind() == reflect.String {
+		unq, err := strconv.Unquote(str)
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		key.SetString(unq)
+	} else if _, err := fmt.Sscan(str, key.Addr().Interface()); err != nil {
+		return reflect.Value{}, false
+	}
+	ret := val.MapIndex(key)
+	return ret, ret.IsValid()
+}
+
+// contains checks "have" contains "want". See [Subject.Contains] for details.
+func contains(want, have any, opts ...check.Option) error {
+	ops := check.DefaultOptions(opts...)
+	if str, ok := have.(string); ok {
+		sub, ok := want.(string)
+		if !ok {
+			const mHeader = "expected argument \"want\" to be string got %T"
+			return notice.New(mHeader, want).SetTrail(ops.Trail)
+		}
+		if strings.Contains(str, sub) {
+			return nil
+		}
+		return check.Contain(sub, str, opts...)
+	}
+
+	val := reflect.ValueOf(have)
+	var items []reflect.Value
+	switch val.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < val.Len(); i++ {
+			items = append(items, val.Index(i))
+		}
+	case reflect.Map:
+		items = val.MapKeys()
+	default:
+		return notice.New("cannot check if %T contains a value", have).
+			SetTrail(ops.Trail)
+	}
+
+	for _, item := range items {
+		if check.EqualValues(reflect.ValueOf(want), item, opts...) == nil {
+			return nil
+		}
+	}
+	return notice.New("expected %s to contain a value", val.Kind()).
+		SetTrail(ops.Trail).
+		Want("%s", ops.Dumper.Any(want)).
+		Append(val.Kind().String(), "%s", ops.Dumper.Any(have))
+}
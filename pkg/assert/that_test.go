@@ -0,0 +1,518 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package assert
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+type tUser struct {
+	Name string
+	Tags []string
+	Meta map[string]any
+	Next *tUser
+	priv int
+}
+
+type tOrder struct {
+	ID    int
+	User  *tUser
+	Items [2]int
+	Codes map[int]string
+	Any   any
+}
+
+func Test_That(t *testing.T) {
+	t.Run("subject", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := That(tspy, 42)
+
+		// --- Then ---
+		affirm.Equal(t, true, have.t == tspy)
+		affirm.Equal(t, 42, have.val)
+		affirm.Equal(t, "", have.trail)
+		affirm.Equal(t, 0, len(have.opts))
+		affirm.Equal(t, false, have.failed)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := That(tspy, 42, check.WithTrail("type.field"))
+
+		// --- Then ---
+		affirm.Equal(t, "type.field", have.trail)
+		affirm.Equal(t, 1, len(have.opts))
+	})
+}
+
+func Test_Subject_Field(t *testing.T) {
+	t.Run("struct field", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		val := tOrder{ID: 1, User: &tUser{Name: "bob"}}
+
+		// --- When ---
+		have := That(tspy, val).Field("User.Name")
+
+		// --- Then ---
+		affirm.Equal(t, "bob", have.val)
+		affirm.Equal(t, "User.Name", have.trail)
+		affirm.Equal(t, false, have.failed)
+	})
+
+	t.Run("trail from options", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		val := tOrder{ID: 1, User: &tUser{Name: "bob"}}
+
+		// --- When ---
+		have := That(tspy, val, check.WithTrail("tOrder")).Field("User.Name")
+
+		// --- Then ---
+		affirm.Equal(t, "bob", have.val)
+		affirm.Equal(t, "tOrder.User.Name", have.trail)
+	})
+
+	t.Run("chained", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		val := tOrder{ID: 1, User: &tUser{Name: "bob"}}
+
+		// --- When ---
+		have := That(tspy, val).Field("User").Field("Name")
+
+		// --- Then ---
+		affirm.Equal(t, "bob", have.val)
+		affirm.Equal(t, "User.Name", have.trail)
+	})
+
+	t.Run("slice index", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		val := tUser{Tags: []string{"a", "b"}}
+
+		// --- When ---
+		have := That(tspy, val).Field("Tags[1]")
+
+		// --- Then ---
+		affirm.Equal(t, "b", have.val)
+		affirm.Equal(t, "Tags[1]", have.trail)
+	})
+
+	t.Run("array index", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		val := tOrder{Items: [2]int{1, 2}}
+
+		// --- When ---
+		have := That(tspy, val).Field("Items[0]")
+
+		// --- Then ---
+		affirm.Equal(t, 1, have.val)
+	})
+
+	t.Run("string map key", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		val := tUser{Meta: map[string]any{"A": &tUser{Name: "bob"}}}
+
+		// --- When ---
+		have := That(tspy, val).Field(`Meta["A"].Name`)
+
+		// --- Then ---
+		affirm.Equal(t, "bob", have.val)
+		affirm.Equal(t, `Meta["A"].Name`, have.trail)
+	})
+
+	t.Run("int map key", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		val := tOrder{Codes: map[int]string{1: "abc"}}
+
+		// --- When ---
+		have := That(tspy, val).Field("Codes[1]")
+
+		// --- Then ---
+		affirm.Equal(t, "abc", have.val)
+	})
+
+	t.Run("interface", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		val := tOrder{Any: tUser{Name: "bob"}}
+
+		// --- When ---
+		have := That(tspy, val).Field("Any.Name")
+
+		// --- Then ---
+		affirm.Equal(t, "bob", have.val)
+	})
+
+	t.Run("nil value", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		val := tOrder{}
+
+		// --- When ---
+		have := That(tspy, val).Field("User")
+
+		// --- Then ---
+		affirm.Equal(t, true, have.val.(*tUser) == nil)
+		affirm.Equal(t, false, have.failed)
+	})
+
+	t.Run("error - not existing field", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "expected the field to exist:\n" +
+			"  trail: User.Other\n" +
+			"   path: User.Other.Name"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		val := tOrder{User: &tUser{Name: "bob"}}
+
+		// --- When ---
+		have := That(tspy, val).Field("User.Other.Name")
+
+		// --- Then ---
+		affirm.Nil(t, have.val)
+		affirm.Equal(t, "User.Other", have.trail)
+		affirm.Equal(t, true, have.failed)
+	})
+
+	t.Run("error - unexported field", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := That(tspy, tUser{priv: 1}).Field("priv")
+
+		// --- Then ---
+		affirm.Equal(t, true, have.failed)
+	})
+
+	t.Run("error - nil pointer", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := That(tspy, tOrder{}).Field("User.Name")
+
+		// --- Then ---
+		affirm.Equal(t, true, have.failed)
+	})
+
+	t.Run("error - index out of range", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := That(tspy, tUser{Tags: []string{"a"}}).Field("Tags[1]")
+
+		// --- Then ---
+		affirm.Equal(t, true, have.failed)
+	})
+
+	t.Run("error - not existing map key", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		val := tUser{Meta: map[string]any{"A": 1}}
+
+		// --- When ---
+		have := That(tspy, val).Field(`Meta["B"]`)
+
+		// --- Then ---
+		affirm.Equal(t, true, have.failed)
+	})
+
+	t.Run("error - invalid map key", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		val := tOrder{Codes: map[int]string{1: "abc"}}
+
+		// --- When ---
+		have := That(tspy, val).Field(`Codes["A"]`)
+
+		// --- Then ---
+		affirm.Equal(t, true, have.failed)
+	})
+
+	t.Run("error - reported once", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := That(tspy, tUser{}).Field("Other").Field("Name")
+
+		// --- Then ---
+		affirm.Equal(t, true, have.failed)
+		affirm.Equal(t, "Other", have.trail)
+	})
+}
+
+func Test_Subject_Equals(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		val := tOrder{User: &tUser{Name: "bob"}}
+
+		// --- When ---
+		have := That(tspy, val).Field("User.Name").Equals("bob")
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "expected values to be equal:\n" +
+			"  trail: User.Name\n" +
+			"   want: \"alice\"\n" +
+			"   have: \"bob\""
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		val := tOrder{User: &tUser{Name: "bob"}}
+
+		// --- When ---
+		have := That(tspy, val).Field("User.Name").Equals("alice")
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("uses subject options", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		want := tUser{Name: "bob", Tags: []string{"a"}}
+		have := tUser{Name: "bob", Tags: []string{"b"}}
+		opt := check.WithSkipTrail("tUser.Tags[0]")
+
+		// --- When ---
+		got := That(tspy, have, opt).Equals(want)
+
+		// --- Then ---
+		affirm.Equal(t, true, got)
+	})
+
+	t.Run("missing field", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := That(tspy, tUser{}).Field("Other").Equals("bob")
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+}
+
+func Test_Subject_Contains(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := That(tspy, "abc").Contains("b")
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		val := tUser{Tags: []string{"a", "b"}}
+
+		// --- When ---
+		have := That(tspy, val).Field("Tags").Contains("b")
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("array", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := That(tspy, [2]int{1, 2}).Contains(2)
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("map key", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := That(tspy, map[string]int{"A": 1}).Contains("A")
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("error - string", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: User.Name\n")
+		tspy.Close()
+
+		val := tOrder{User: &tUser{Name: "bob"}}
+
+		// --- When ---
+		have := That(tspy, val).Field("User.Name").Contains("x")
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("error - want not string", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "expected argument \"want\" to be string got int"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		// --- When ---
+		have := That(tspy, "abc").Contains(1)
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("error - slice", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "expected slice to contain a value:\n" +
+			"  trail: Tags\n" +
+			"   want: \"c\"\n" +
+			"  slice:\n" +
+			"         []string{\n" +
+			"           \"a\",\n" +
+			"           \"b\",\n" +
+			"         }"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		val := tUser{Tags: []string{"a", "b"}}
+
+		// --- When ---
+		have := That(tspy, val).Field("Tags").Contains("c")
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("error - not supported type", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual("cannot check if int contains a value")
+		tspy.Close()
+
+		// --- When ---
+		have := That(tspy, 42).Contains(4)
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("missing field", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := That(tspy, tUser{}).Field("Other").Contains("bob")
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+}
+
+func Test_Subject_IsEmpty(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := That(tspy, tUser{}).Field("Tags").IsEmpty()
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: Name\n")
+		tspy.Close()
+
+		// --- When ---
+		have := That(tspy, tUser{Name: "bob"}).Field("Name").IsEmpty()
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("missing field", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := That(tspy, tUser{}).Field("Other").IsEmpty()
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+}
@@ -19,10 +19,11 @@ import (
 // [check.Options.TimeFormat] is used during parsing and the returned date is
 // always in UTC. The int and int64 types are interpreted as Unix Timestamp,
 // and the date returned is also in UTC.
-func Time(t tester.T, want, have any, opts ...check.Option) bool {
+func Time(t tester.Minimal, want, have any, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.Time(want, have, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -37,10 +38,11 @@ func Time(t tester.T, want, have any, opts ...check.Option) bool {
 // [check.Options.TimeFormat] is used during parsing and the returned date is
 // always in UTC. The int and int64 types are interpreted as Unix Timestamp,
 // and the date returned is also in UTC.
-func Exact(t tester.T, want, have any, opts ...check.Option) bool {
+func Exact(t tester.Minimal, want, have any, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.Exact(want, have, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -55,10 +57,11 @@ func Exact(t tester.T, want, have any, opts ...check.Option) bool {
 // [check.Options.TimeFormat] is used during parsing and the returned date is
 // always in UTC. The int and int64 types are interpreted as Unix Timestamp,
 // and the date returned is also in UTC.
-func Before(t tester.T, date, mark any, opts ...check.Option) bool {
+func Before(t tester.Minimal, date, mark any, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.Before(date, mark, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -73,10 +76,11 @@ func Before(t tester.T, date, mark any, opts ...check.Option) bool {
 // [check.Options.TimeFormat] is used during parsing and the returned date is
 // always in UTC. The int and int64 types are interpreted as Unix Timestamp,
 // and the date returned is also in UTC.
-func After(t tester.T, date, mark time.Time, opts ...check.Option) bool {
+func After(t tester.Minimal, date, mark time.Time, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.After(date, mark, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -91,10 +95,15 @@ func After(t tester.T, date, mark time.Time, opts ...check.Option) bool {
 // [check.Options.TimeFormat] is used during parsing and the returned date is
 // always in UTC. The int and int64 types are interpreted as Unix Timestamp,
 // and the date returned is also in UTC.
-func BeforeOrEqual(t tester.T, date, mark time.Time, opts ...check.Option) bool {
+func BeforeOrEqual(
+	t tester.Minimal,
+	date, mark time.Time,
+	opts ...check.Option,
+) bool {
 	t.Helper()
+	ran(t)
 	if e := check.BeforeOrEqual(date, mark, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -109,10 +118,11 @@ func BeforeOrEqual(t tester.T, date, mark time.Time, opts ...check.Option) bool
 // [check.Options.TimeFormat] is used during parsing and the returned date is
 // always in UTC. The int and int64 types are interpreted as Unix Timestamp,
 // and the date returned is also in UTC.
-func AfterOrEqual(t tester.T, date, mark any, opts ...check.Option) bool {
+func AfterOrEqual(t tester.Minimal, date, mark any, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.AfterOrEqual(date, mark, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -127,10 +137,15 @@ func AfterOrEqual(t tester.T, date, mark any, opts ...check.Option) bool {
 // [check.Options.TimeFormat] is used during parsing and the returned date is
 // always in UTC. The int and int64 types are interpreted as Unix Timestamp,
 // and the date returned is also in UTC.
-func Within(t tester.T, want, within, have any, opts ...check.Option) bool {
+func Within(
+	t tester.Minimal,
+	want, within, have any,
+	opts ...check.Option,
+) bool {
 	t.Helper()
+	ran(t)
 	if e := check.Within(want, within, have, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -145,10 +160,11 @@ func Within(t tester.T, want, within, have any, opts ...check.Option) bool {
 // used during parsing and the returned date is always in UTC. The int and
 // int64 types are interpreted as Unix Timestamp, and the date returned is also
 // in UTC.
-func Recent(t tester.T, have any, opts ...check.Option) bool {
+func Recent(t tester.Minimal, have any, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.Recent(have, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -157,10 +173,15 @@ func Recent(t tester.T, have any, opts ...check.Option) bool {
 // Zone asserts "want" and "have" timezones are equal. Returns true if they are,
 // otherwise marks the test as failed, writes an error message to the test log
 // and returns false.
-func Zone(t tester.T, want, have *time.Location, opts ...check.Option) bool {
+func Zone(
+	t tester.Minimal,
+	want, have *time.Location,
+	opts ...check.Option,
+) bool {
 	t.Helper()
+	ran(t)
 	if e := check.Zone(want, have, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -168,14 +189,16 @@ func Zone(t tester.T, want, have *time.Location, opts ...check.Option) bool {
 
 // Duration asserts "want" and "have" durations are equal. Returns true if they
 // are, otherwise marks the test as failed, writes an error message to the test
-// log and returns false.
+// log and returns false. Use the [check.WithDurationDelta] option to allow
+// durations to differ by the given delta.
 //
 // The "want" and "have" might be duration representation in the form of string,
 // int, int64 or [time.Duration].
-func Duration(t tester.T, want, have any, opts ...check.Option) bool {
+func Duration(t tester.Minimal, want, have any, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.Duration(want, have, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package assert
+
+import (
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// UUID asserts "have" is a UUID in the canonical RFC 4122 textual form. The
+// version of the UUID is not checked. Returns true if it is, otherwise marks
+// the test as failed, writes an error message to the test log and returns
+// false.
+func UUID(t tester.Minimal, have string, opts ...check.Option) bool {
+	t.Helper()
+	ran(t)
+	if e := check.UUID(have, opts...); e != nil {
+		fail(t, e, opts...)
+		return false
+	}
+	return true
+}
+
+// UUIDv4 asserts "have" is a valid version 4 UUID in the canonical RFC 4122
+// textual form. Returns true if it is, otherwise marks the test as failed,
+// writes an error message to the test log and returns false.
+func UUIDv4(t tester.Minimal, have string, opts ...check.Option) bool {
+	t.Helper()
+	ran(t)
+	if e := check.UUIDv4(have, opts...); e != nil {
+		fail(t, e, opts...)
+		return false
+	}
+	return true
+}
+
+// UUIDEqual asserts "want" and "have" represent the same UUID. The comparison
+// is case-insensitive and the UUIDs may be given with or without dashes.
+// Returns true if they are, otherwise marks the test as failed, writes an
+// error message to the test log and returns false.
+func UUIDEqual(t tester.Minimal, want, have string, opts ...check.Option) bool {
+	t.Helper()
+	ran(t)
+	if e := check.UUIDEqual(want, have, opts...); e != nil {
+		fail(t, e, opts...)
+		return false
+	}
+	return true
+}
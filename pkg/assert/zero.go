@@ -11,10 +11,11 @@ import (
 // Zero asserts "have" is the zero value for its type. Returns true if it is,
 // otherwise marks the test as failed, writes an error message to the test log
 // and returns false.
-func Zero(t tester.T, have any, opts ...check.Option) bool {
+func Zero(t tester.Minimal, have any, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if e := check.Zero(have, opts...); e != nil {
-		t.Error(e)
+		fail(t, e, opts...)
 		return false
 	}
 	return true
@@ -23,10 +24,11 @@ func Zero(t tester.T, have any, opts ...check.Option) bool {
 // NotZero asserts "have" is not the zero value for its type. Returns true if
 // it is not, otherwise marks the test as failed, writes an error message to
 // the test log and returns false.
-func NotZero(t tester.T, have any, opts ...check.Option) bool {
+func NotZero(t tester.Minimal, have any, opts ...check.Option) bool {
 	t.Helper()
+	ran(t)
 	if err := check.NotZero(have, opts...); err != nil {
-		t.Error(err)
+		fail(t, err, opts...)
 		return false
 	}
 	return true
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/internal/types"
+)
+
+//goland:noinspection GoUnusedGlobalVariable
+var benchEqual error
+
+func BenchmarkEqual(b *testing.B) {
+	b.Run("slice of structs", func(b *testing.B) {
+		want := make([]types.TA, 1000)
+		have := make([]types.TA, 1000)
+		for i := range want {
+			want[i] = types.TA{Int: i, Str: "abc", TAp: &types.TA{Int: i}}
+			have[i] = types.TA{Int: i, Str: "abc", TAp: &types.TA{Int: i}}
+		}
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		var err error
+		for i := 0; i < b.N; i++ {
+			err = Equal(want, have)
+		}
+		benchEqual = err
+	})
+
+	b.Run("slice of structs in parallel", func(b *testing.B) {
+		want := make([]types.TA, 1000)
+		have := make([]types.TA, 1000)
+		for i := range want {
+			want[i] = types.TA{Int: i, Str: "abc", TAp: &types.TA{Int: i}}
+			have[i] = types.TA{Int: i, Str: "abc", TAp: &types.TA{Int: i}}
+		}
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		var err error
+		for i := 0; i < b.N; i++ {
+			err = Equal(want, have, WithParallel(4))
+		}
+		benchEqual = err
+	})
+
+	b.Run("slice of simple structs", func(b *testing.B) {
+		type T struct {
+			Int  int
+			Str  string
+			Ints []int
+		}
+		want := make([]T, 1000)
+		have := make([]T, 1000)
+		for i := range want {
+			want[i] = T{Int: i, Str: "abc", Ints: []int{i, i}}
+			have[i] = T{Int: i, Str: "abc", Ints: []int{i, i}}
+		}
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		var err error
+		for i := 0; i < b.N; i++ {
+			err = Equal(want, have)
+		}
+		benchEqual = err
+	})
+
+	b.Run("nested struct", func(b *testing.B) {
+		want := types.TNested{SInt: []int{1, 2}, MStrInt: map[string]int{"A": 1}}
+		have := types.TNested{SInt: []int{1, 2}, MStrInt: map[string]int{"A": 1}}
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		var err error
+		for i := 0; i < b.N; i++ {
+			err = Equal(want, have)
+		}
+		benchEqual = err
+	})
+}
+
+func BenchmarkEqualT(b *testing.B) {
+	b.Run("struct", func(b *testing.B) {
+		want := types.TIntStr{Int: 42, Str: "abc"}
+		have := types.TIntStr{Int: 42, Str: "abc"}
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		var err error
+		for i := 0; i < b.N; i++ {
+			err = EqualT(want, have)
+		}
+		benchEqual = err
+	})
+}
@@ -4,6 +4,7 @@
 package check
 
 import (
+	"reflect"
 	"time"
 
 	"github.com/ctx42/testing/pkg/notice"
@@ -46,3 +47,108 @@ func ChannelWillClose[C any](within any, c <-chan C, opts ...Option) error {
 		}
 	}
 }
+
+// ChannelClosed checks channel "ch" is closed. Returns nil if it is, otherwise
+// returns an error with a message indicating the channel length and capacity.
+// The check never blocks. A closed channel which still has buffered values is
+// not considered closed because receiving from it still yields values.
+//
+// To detect the closed channel, the non-blocking receive is performed, so for
+// an unbuffered channel, a value sent by a goroutine blocked on the send might
+// be consumed.
+func ChannelClosed(ch any, opts ...Option) error {
+	val, err := chanValue(ch, opts...)
+	if err != nil {
+		return err
+	}
+	if !val.IsNil() && val.Len() == 0 {
+		if got, ok := val.TryRecv(); !ok && got.IsValid() {
+			return nil
+		}
+	}
+	ops := DefaultOptions(opts...)
+	return chanNotice(val, "expected channel to be closed", ops)
+}
+
+// ChannelEmpty checks channel "ch" has no buffered values. Returns nil if it
+// has none, otherwise returns an error with a message indicating the channel
+// length and capacity. The nil channel is considered empty.
+func ChannelEmpty(ch any, opts ...Option) error {
+	val, err := chanValue(ch, opts...)
+	if err != nil {
+		return err
+	}
+	if val.Len() == 0 {
+		return nil
+	}
+	ops := DefaultOptions(opts...)
+	return chanNotice(val, "expected channel to be empty", ops)
+}
+
+// ChannelReceives checks a value equal to "want" is received from channel
+// "ch" within the "timeout" duration. The received value is compared using
+// the [Equal] checker. Returns nil if it was, otherwise returns an error with
+// a message indicating the expected and actual values.
+//
+// The "timeout" may represent duration in form of a string, int, int64 or
+// [time.Duration].
+func ChannelReceives(want, ch, timeout any, opts ...Option) error {
+	val, err := chanValue(ch, opts...)
+	if err != nil {
+		return err
+	}
+	dur, durStr, _, err := getDur(timeout, opts...)
+	if err != nil {
+		return notice.From(err, "timeout")
+	}
+
+	tim := time.NewTimer(dur)
+	defer tim.Stop()
+
+	cases := []reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: val},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(tim.C)},
+	}
+	idx, got, ok := reflect.Select(cases)
+
+	ops := DefaultOptions(opts...)
+	if idx == 1 {
+		hdr := "timeout waiting for value from channel"
+		return chanNotice(val, hdr, ops).Append("timeout", "%s", durStr)
+	}
+	if !ok {
+		hdr := "expected to receive value from open channel"
+		return chanNotice(val, hdr, ops)
+	}
+	if e := Equal(want, got.Interface(), opts...); e != nil {
+		hdr := "expected to receive value from channel"
+		return notice.From(e).SetHeader(hdr)
+	}
+	return nil
+}
+
+// chanValue returns [reflect.Value] of channel "ch". Returns an error if "ch"
+// is not a channel one can receive from.
+func chanValue(ch any, opts ...Option) (reflect.Value, error) {
+	val := reflect.ValueOf(ch)
+	knd := val.Kind()
+	if knd != reflect.Chan || val.Type().ChanDir()&reflect.RecvDir == 0 {
+		ops := DefaultOptions(opts...)
+		msg := notice.New("expected receive channel").
+			SetTrail(ops.Trail).
+			Append("got type", "%T", ch)
+		return reflect.Value{}, msg
+	}
+	return val, nil
+}
+
+// chanNotice returns notice with the channel length and capacity rows.
+func chanNotice(val reflect.Value, header string, ops Options) *notice.Notice {
+	msg := notice.New("%s", header).SetTrail(ops.Trail)
+	if val.IsNil() {
+		return msg.Append("channel", "nil")
+	}
+	return msg.
+		Append("len", "%d", val.Len()).
+		Append("cap", "%d", val.Cap())
+}
@@ -5,6 +5,7 @@ package check
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ctx42/testing/internal/affirm"
 )
@@ -68,3 +69,301 @@ func Test_ChannelWillClose(t *testing.T) {
 		affirm.Equal(t, wMsg, err.Error())
 	})
 }
+
+func Test_ChannelClosed(t *testing.T) {
+	t.Run("closed", func(t *testing.T) {
+		// --- Given ---
+		c := make(chan int)
+		close(c)
+
+		// --- When ---
+		err := ChannelClosed(c)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("closed receive only", func(t *testing.T) {
+		// --- Given ---
+		c := make(chan int)
+		close(c)
+
+		// --- When ---
+		err := ChannelClosed((<-chan int)(c))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - open", func(t *testing.T) {
+		// --- Given ---
+		c := make(chan int, 2)
+
+		// --- When ---
+		err := ChannelClosed(c)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected channel to be closed:\n" +
+			"  len: 0\n" +
+			"  cap: 2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - closed with buffered values", func(t *testing.T) {
+		// --- Given ---
+		c := make(chan int, 2)
+		c <- 1
+		close(c)
+
+		// --- When ---
+		err := ChannelClosed(c)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected channel to be closed:\n" +
+			"  len: 1\n" +
+			"  cap: 2"
+		affirm.Equal(t, wMsg, err.Error())
+		affirm.Equal(t, 1, len(c))
+	})
+
+	t.Run("error - nil channel", func(t *testing.T) {
+		// --- Given ---
+		var c chan int
+
+		// --- When ---
+		err := ChannelClosed(c)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected channel to be closed:\n" +
+			"  channel: nil"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - not a channel", func(t *testing.T) {
+		// --- When ---
+		err := ChannelClosed(123)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected receive channel:\n" +
+			"  got type: int"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - send only channel", func(t *testing.T) {
+		// --- Given ---
+		c := make(chan int)
+
+		// --- When ---
+		err := ChannelClosed((chan<- int)(c))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected receive channel:\n" +
+			"  got type: chan<- int"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		c := make(chan int)
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := ChannelClosed(c, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected channel to be closed:\n" +
+			"  trail: type.field\n" +
+			"    len: 0\n" +
+			"    cap: 0"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_ChannelEmpty(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		// --- Given ---
+		c := make(chan int, 1)
+
+		// --- When ---
+		err := ChannelEmpty(c)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("nil channel", func(t *testing.T) {
+		// --- Given ---
+		var c chan int
+
+		// --- When ---
+		err := ChannelEmpty(c)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - not a channel", func(t *testing.T) {
+		// --- When ---
+		err := ChannelEmpty("abc")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected receive channel:\n" +
+			"  got type: string"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		c := make(chan int, 3)
+		c <- 1
+		c <- 2
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := ChannelEmpty(c, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected channel to be empty:\n" +
+			"  trail: type.field\n" +
+			"    len: 2\n" +
+			"    cap: 3"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_ChannelReceives(t *testing.T) {
+	t.Run("buffered value", func(t *testing.T) {
+		// --- Given ---
+		c := make(chan int, 1)
+		c <- 42
+
+		// --- When ---
+		err := ChannelReceives(42, c, "1s")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("value sent later", func(t *testing.T) {
+		// --- Given ---
+		c := make(chan string)
+		go func() { c <- "abc" }()
+
+		// --- When ---
+		err := ChannelReceives("abc", c, time.Second)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - different value", func(t *testing.T) {
+		// --- Given ---
+		c := make(chan int, 1)
+		c <- 44
+
+		// --- When ---
+		err := ChannelReceives(42, c, "1s")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected to receive value from channel:\n" +
+			"  want: 42\n" +
+			"  have: 44"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - closed", func(t *testing.T) {
+		// --- Given ---
+		c := make(chan int, 1)
+		close(c)
+
+		// --- When ---
+		err := ChannelReceives(42, c, "1s")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected to receive value from open channel:\n" +
+			"  len: 0\n" +
+			"  cap: 1"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - timeout", func(t *testing.T) {
+		// --- Given ---
+		c := make(chan int, 1)
+
+		// --- When ---
+		err := ChannelReceives(42, c, "5ms")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"timeout waiting for value from channel:\n" +
+			"      len: 0\n" +
+			"      cap: 1\n" +
+			"  timeout: 5ms"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - invalid timeout", func(t *testing.T) {
+		// --- Given ---
+		c := make(chan int, 1)
+
+		// --- When ---
+		err := ChannelReceives(42, c, "abc")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "[timeout] failed to parse duration:\n  value: abc"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - not a channel", func(t *testing.T) {
+		// --- When ---
+		err := ChannelReceives(42, 42, "1s")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected receive channel:\n" +
+			"  got type: int"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		c := make(chan int, 1)
+		c <- 44
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := ChannelReceives(42, c, "1s", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected to receive value from channel:\n" +
+			"  trail: type.field\n" +
+			"   want: 42\n" +
+			"   have: 44"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
@@ -13,9 +13,11 @@ import (
 
 // Count checks there is "count" occurrences of "what" in "where". Returns nil
 // if it's, otherwise it returns an error with a message indicating the
-// expected and actual values.
+// expected and actual values and the indexes where "what" was found.
 //
-// Currently, only strings are supported.
+// Currently, strings, slices and arrays are supported. For strings, "what"
+// must be a string and non-overlapping substrings are counted. For slices and
+// arrays, the elements are compared with "what" using [Equal].
 func Count(count int, what, where any, opts ...Option) error {
 	if src, ok := where.(string); ok {
 		var ok bool
@@ -31,19 +33,84 @@ func Count(count int, what, where any, opts ...Option) error {
 		}
 
 		ops := DefaultOptions(opts...)
-		return notice.New("expected string to contain substrings").
+		msg := notice.New("expected string to contain substrings").
 			SetTrail(ops.Trail).
 			Append("want count", "%d", count).
-			Append("have count", "%d", haveCnt).
+			Append("have count", "%d", haveCnt)
+		if idxs := substrIndexes(src, subT); len(idxs) > 0 {
+			_ = msg.Append("indexes", "%s", joinInts(idxs))
+		}
+		return msg.
 			Append("what", "%q", what).
 			Append("where", "%q", where)
 	}
 
+	wVal := reflect.ValueOf(where)
+	if knd := wVal.Kind(); knd == reflect.Slice || knd == reflect.Array {
+		return countElements(count, what, wVal, opts...)
+	}
+
 	ops := DefaultOptions(opts...)
 	return notice.New("unsupported \"where\" type: %T", where).
 		SetTrail(ops.Trail)
 }
 
+// countElements counts elements of a slice or array equal to "what".
+func countElements(
+	count int,
+	what any,
+	where reflect.Value,
+	opts ...Option,
+) error {
+
+	ops := DefaultOptions(opts...)
+
+	// Comparisons of elements must not change the trail log.
+	cOps := ops
+	cOps.Trail = ""
+	cOps.TrailLog = nil
+	cOps.TrailSink = nil
+
+	var idxs []int
+	for i := 0; i < where.Len(); i++ {
+		elem := where.Index(i).Interface()
+		if Equal(what, elem, WithOptions(cOps)) == nil {
+			idxs = append(idxs, i)
+		}
+	}
+	if count == len(idxs) {
+		return nil
+	}
+
+	msg := notice.New("expected %s to contain elements", where.Kind()).
+		SetTrail(ops.Trail).
+		Append("want count", "%d", count).
+		Append("have count", "%d", len(idxs))
+	if len(idxs) > 0 {
+		_ = msg.Append("indexes", "%s", joinInts(idxs))
+	}
+	return msg.
+		Append("what", "%s", ops.Dumper.Any(what)).
+		Append("where", "%s", ops.Dumper.Value(where))
+}
+
+// substrIndexes returns indexes of non-overlapping instances of "sub" in
+// "str". Returns nil for an empty "sub".
+func substrIndexes(str, sub string) []int {
+	if sub == "" {
+		return nil
+	}
+	var idxs []int
+	for off := 0; ; {
+		idx := strings.Index(str[off:], sub)
+		if idx < 0 {
+			return idxs
+		}
+		idxs = append(idxs, off+idx)
+		off += idx + len(sub)
+	}
+}
+
 // Type checks that both arguments are of the same type. Returns nil if they
 // are, otherwise it returns an error with a message indicating the expected
 // and actual values.
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ctx42/testing/internal/affirm"
 	"github.com/ctx42/testing/internal/types"
+	"github.com/ctx42/testing/pkg/dump"
 )
 
 func Test_Count(t *testing.T) {
@@ -53,12 +54,103 @@ func Test_Count(t *testing.T) {
 			"       trail: type.field\n" +
 			"  want count: 2\n" +
 			"  have count: 3\n" +
+			"     indexes: 0, 4, 8\n" +
 			"        what: \"a\"\n" +
 			"       where: \"abc abc anc\""
 		affirm.Equal(t, wMsg, err.Error())
 	})
 }
 
+func Test_Count_slices_and_arrays(t *testing.T) {
+	t.Run("slice", func(t *testing.T) {
+		// --- When ---
+		err := Count(2, "a", []string{"a", "b", "a"})
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("array of structs", func(t *testing.T) {
+		// --- Given ---
+		where := [3]types.TA{{Int: 1}, {Int: 2}, {Int: 1}}
+
+		// --- When ---
+		err := Count(2, types.TA{Int: 1}, where)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// --- When ---
+		err := Count(0, 4, []int{1, 2, 3})
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - wrong count", func(t *testing.T) {
+		// --- When ---
+		err := Count(1, 1, []int{1, 2, 1}, WithDumper(dump.WithFlat))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected slice to contain elements:\n" +
+			"  want count: 1\n" +
+			"  have count: 2\n" +
+			"     indexes: 0, 2\n" +
+			"        what: 1\n" +
+			"       where: []int{1, 2, 1}"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - different types are not counted", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := Count(1, 1, [1]int64{1}, opt, WithDumper(dump.WithFlat))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected array to contain elements:\n" +
+			"       trail: type.field\n" +
+			"  want count: 1\n" +
+			"  have count: 0\n" +
+			"        what: 1\n" +
+			"       where: [1]int64{1}"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_substrIndexes_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		str  string
+		sub  string
+		want []int
+	}{
+		{"empty sub", "abc", "", nil},
+		{"not found", "abc", "x", nil},
+		{"one", "abc", "b", []int{1}},
+		{"many", "ab ab ab", "ab", []int{0, 3, 6}},
+		{"non overlapping", "aaaa", "aa", []int{0, 2}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := substrIndexes(tc.str, tc.sub)
+
+			// --- Then ---
+			affirm.DeepEqual(t, tc.want, have)
+		})
+	}
+}
+
 func Test_Count_success_tabular(t *testing.T) {
 	tt := []struct {
 		testN string
@@ -88,11 +180,19 @@ func Test_Count_error_tabular(t *testing.T) {
 
 		wantCnt int
 		haveCnt int
+		idxRow  string
 		what    any
 		where   any
 	}{
-		{"not existing", 1, 0, "gh", "ab cd ef"},
-		{"existing with wrong count", 2, 1, "ab", "ab cd ef"},
+		{"not existing", 1, 0, "", "gh", "ab cd ef"},
+		{
+			"existing with wrong count",
+			2,
+			1,
+			"     indexes: 0\n",
+			"ab",
+			"ab cd ef",
+		},
 	}
 
 	for _, tc := range tt {
@@ -106,9 +206,17 @@ func Test_Count_error_tabular(t *testing.T) {
 				"expected string to contain substrings:\n" +
 				"  want count: %d\n" +
 				"  have count: %d\n" +
+				"%s" +
 				"        what: %q\n" +
 				"       where: %q"
-			wMsg = fmt.Sprintf(wMsg, tc.wantCnt, tc.haveCnt, tc.what, tc.where)
+			wMsg = fmt.Sprintf(
+				wMsg,
+				tc.wantCnt,
+				tc.haveCnt,
+				tc.idxRow,
+				tc.what,
+				tc.where,
+			)
 			affirm.Equal(t, wMsg, err.Error())
 		})
 	}
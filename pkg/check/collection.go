@@ -4,8 +4,10 @@
 package check
 
 import (
+	"fmt"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/ctx42/testing/pkg/notice"
@@ -225,3 +227,93 @@ func MapsSubset[K comparable, V any](want, have []map[K]V, opts ...Option) error
 	}
 	return err
 }
+
+// SliceSorted checks the slice or array is sorted according to the "less"
+// function, which reports whether the element with index "i" should sort
+// before the element with index "j". Returns nil if it is, otherwise it
+// returns an error with a message indicating the first pair of elements which
+// are out of order. For slices of ordered types, see [Increasing] and
+// [Decreasing].
+func SliceSorted(have any, less func(i, j int) bool, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	hVal := reflect.ValueOf(have)
+	if knd := hVal.Kind(); knd != reflect.Slice && knd != reflect.Array {
+		return notice.New("expected slice or array").
+			SetTrail(ops.Trail).
+			Append("got type", "%T", have)
+	}
+
+	knd := fmt.Sprintf("%T", have)
+	for i := 1; i < hVal.Len(); i++ {
+		if less(i, i-1) {
+			iOps := ops.ArrTrail(knd, i)
+			return notice.New("expected a sorted slice").
+				SetTrail(iOps.Trail).
+				Append("indexes", "%d, %d", i-1, i).
+				Append("previous", "%s", ops.Dumper.Value(hVal.Index(i-1))).
+				Append("current", "%s", ops.Dumper.Value(hVal.Index(i)))
+		}
+	}
+	return nil
+}
+
+// Unique checks the slice or array has no duplicate elements. Elements are
+// compared using [Equal] or, when the [WithUniqueKey] option is used, by
+// comparing their keys. Returns nil if all elements are unique, otherwise it
+// returns an error with a message listing each duplicated value and the
+// indexes where it appears.
+func Unique(have any, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	hVal := reflect.ValueOf(have)
+	if knd := hVal.Kind(); knd != reflect.Slice && knd != reflect.Array {
+		return notice.New("expected slice or array").
+			SetTrail(ops.Trail).
+			Append("got type", "%T", have)
+	}
+
+	keys := make([]any, hVal.Len())
+	for i := range keys {
+		keys[i] = hVal.Index(i).Interface()
+		if ops.UniqueKey != nil {
+			keys[i] = ops.UniqueKey(keys[i])
+		}
+	}
+
+	// Comparisons of elements must not change the trail log.
+	cOps := ops
+	cOps.Trail = ""
+	cOps.TrailLog = nil
+	cOps.TrailSink = nil
+
+	seen := make([]bool, len(keys))
+	var lines []string
+	for i := range keys {
+		if seen[i] {
+			continue
+		}
+		idxs := []string{strconv.Itoa(i)}
+		for j := i + 1; j < len(keys); j++ {
+			if seen[j] {
+				continue
+			}
+			if Equal(keys[i], keys[j], WithOptions(cOps)) == nil {
+				seen[j] = true
+				idxs = append(idxs, strconv.Itoa(j))
+			}
+		}
+		if len(idxs) > 1 {
+			dmp := ops.Dumper
+			dmp.Flat = true
+			val := dmp.Value(hVal.Index(i))
+			at := strings.Join(idxs, ", ")
+			lines = append(lines, fmt.Sprintf("%s at indexes %s", val, at))
+		}
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+
+	return notice.New("expected slice to have unique values").
+		SetTrail(ops.Trail).
+		Append("duplicates", "%s", strings.Join(lines, "\n"))
+}
@@ -5,9 +5,12 @@ package check
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/internal/types"
+	"github.com/ctx42/testing/pkg/dump"
 	"github.com/ctx42/testing/pkg/notice"
 )
 
@@ -844,3 +847,191 @@ func Test_MapsSubset(t *testing.T) {
 		affirm.Equal(t, wMsg, err.Error())
 	})
 }
+
+func Test_SliceSorted(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		have := []int{1, 2, 2, 3}
+		less := func(i, j int) bool { return have[i] < have[j] }
+
+		// --- When ---
+		err := SliceSorted(have, less)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - empty slice", func(t *testing.T) {
+		// --- Given ---
+		var have []int
+		less := func(i, j int) bool { return have[i] < have[j] }
+
+		// --- When ---
+		err := SliceSorted(have, less)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - array of structs", func(t *testing.T) {
+		// --- Given ---
+		have := [2]types.TA{{Int: 1}, {Int: 2}}
+		less := func(i, j int) bool { return have[i].Int < have[j].Int }
+
+		// --- When ---
+		err := SliceSorted(have, less)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - not sorted", func(t *testing.T) {
+		// --- Given ---
+		have := []string{"a", "c", "b"}
+		less := func(i, j int) bool { return have[i] < have[j] }
+
+		// --- When ---
+		err := SliceSorted(have, less)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected a sorted slice:\n" +
+			"     trail: <[]string>[2]\n" +
+			"   indexes: 1, 2\n" +
+			"  previous: \"c\"\n" +
+			"   current: \"b\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - not slice", func(t *testing.T) {
+		// --- When ---
+		err := SliceSorted(123, func(i, j int) bool { return false })
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected slice or array:\n" +
+			"  got type: int"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		have := []int{2, 1}
+		less := func(i, j int) bool { return have[i] < have[j] }
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := SliceSorted(have, less, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected a sorted slice:\n" +
+			"     trail: type.field[1]\n" +
+			"   indexes: 0, 1\n" +
+			"  previous: 2\n" +
+			"   current: 1"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_Unique(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := Unique([]int{1, 2, 3})
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - empty", func(t *testing.T) {
+		// --- When ---
+		err := Unique([]int{})
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - duplicates", func(t *testing.T) {
+		// --- When ---
+		err := Unique([]string{"a", "b", "a", "c", "b", "a"})
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected slice to have unique values:\n" +
+			"  duplicates:\n" +
+			"              \"a\" at indexes 0, 2, 5\n" +
+			"              \"b\" at indexes 1, 4"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - duplicated structs", func(t *testing.T) {
+		// --- Given ---
+		have := [3]types.TA{{Int: 1}, {Int: 2}, {Int: 1}}
+		opt := WithDumper(dump.WithMaxDepth(1))
+
+		// --- When ---
+		err := Unique(have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		affirm.Equal(t, true, strings.Contains(err.Error(), "at indexes 0, 2"))
+	})
+
+	t.Run("with key function", func(t *testing.T) {
+		// --- Given ---
+		have := []types.TA{{Int: 1, Str: "a"}, {Int: 1, Str: "b"}}
+		opt := WithUniqueKey(func(elem any) any { return elem.(types.TA).Int })
+
+		// --- When ---
+		err := Unique(have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		affirm.Equal(t, true, strings.Contains(err.Error(), "at indexes 0, 1"))
+	})
+
+	t.Run("does not log trails", func(t *testing.T) {
+		// --- Given ---
+		trails := make([]string, 0)
+		opt := WithTrailLog(&trails)
+
+		// --- When ---
+		err := Unique([]int{1, 2}, opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, 0, len(trails))
+	})
+
+	t.Run("error - not slice", func(t *testing.T) {
+		// --- When ---
+		err := Unique(123)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected slice or array:\n" +
+			"  got type: int"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := Unique([]int{1, 2, 1}, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected slice to have unique values:\n" +
+			"       trail: type.field\n" +
+			"  duplicates: 1 at indexes 0, 2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
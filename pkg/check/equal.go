@@ -5,9 +5,14 @@ package check
 
 import (
 	"fmt"
+	"io"
+	"maps"
+	"math"
 	"reflect"
 	"slices"
-	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"unsafe"
 
 	"github.com/ctx42/testing/internal/core"
@@ -15,17 +20,65 @@ import (
 	"github.com/ctx42/testing/pkg/notice"
 )
 
+// CodeTypeMismatch is the [notice.Notice] code used when compared values have
+// different types. See [notice.CodeFrom].
+const CodeTypeMismatch = "equal.type-mismatch"
+
 // Equal recursively checks both values are equal. Returns nil if they are,
 // otherwise it returns an error with a message indicating the expected and
 // actual values.
 func Equal(want, have any, opts ...Option) error {
+	wVal := reflect.ValueOf(want)
+	hVal := reflect.ValueOf(have)
+	return EqualValues(wVal, hVal, opts...)
+}
+
+// EqualValues works like [Equal] but uses [reflect.Value] instances. It is
+// useful in custom checkers which already hold [reflect.Value] instances,
+// including ones representing unexported fields, which cannot be converted to
+// interfaces without panicking.
+func EqualValues(wVal, hVal reflect.Value, opts ...Option) error {
 	ops := DefaultOptions(opts...)
 	if _, ok := ops.Dumper.Dumpers[typByte]; !ok {
 		ops.Dumper.Dumpers[typByte] = dumpByte
 	}
-	wVal := reflect.ValueOf(want)
-	hVal := reflect.ValueOf(have)
-	return deepEqual(wVal, hVal, make(map[visit]bool), WithOptions(ops))
+	if ops.Verbosity != notice.Verbose || ops.TrailLog != nil {
+		return deepEqual(wVal, hVal, make(map[visit]bool), WithOptions(ops))
+	}
+
+	// Log trails to add them to the verbose failure message.
+	trails := make([]string, 0)
+	ops.TrailLog = &trails
+	err := deepEqual(wVal, hVal, make(map[visit]bool), WithOptions(ops))
+	if err == nil {
+		return nil
+	}
+	msg := notice.From(err)
+	return msg.AppendOptional("trails", "%s", strings.Join(trails, "\n"))
+}
+
+// EqualT checks both values are equal. Unlike [Equal], when no options are
+// given (including the ones set with [SetDefaultOptions]), it compares values
+// using the == operator first, bypassing reflection, and uses [Equal] only
+// when they are not equal to either confirm they are (for example, pointers
+// to equal values) or to build a detailed error message. When options are
+// given, it works exactly like [Equal], so options like [WithStrictZero] or
+// custom checkers are always respected. Returns nil if values are equal,
+// otherwise it returns an error with a message indicating the expected and
+// actual values.
+func EqualT[T comparable](want, have T, opts ...Option) error {
+	if len(opts) == 0 && len(defaultOpts) == 0 && equalOperator(want, have) {
+		return nil
+	}
+	return Equal(want, have, opts...)
+}
+
+// equalOperator returns true if values are equal using the == operator.
+// Returns false when the comparison panics, which happens for interface
+// values holding not comparable types.
+func equalOperator[T comparable](want, have T) (equal bool) {
+	defer func() { _ = recover() }()
+	return want == have
 }
 
 // NotEqual checks both values are not equal using. Returns nil if they are not,
@@ -47,9 +100,60 @@ type visit struct {
 	typ  reflect.Type
 }
 
-// deepEqual is the internal comparison function which is called recursively.
+// equalTask represents a pair of values to compare.
 //
-// nolint: gocognit, cyclop
+// Tasks don't carry [Options], which are shared by all tasks, only the trail
+// to the values, so pushing a task to the stack doesn't copy the options.
+type equalTask struct {
+	wVal  reflect.Value // The "want" value.
+	hVal  reflect.Value // The "have" value.
+	trail string        // The trail to the values.
+	err   error         // When set, the task reports the error.
+}
+
+// structMeta represents struct type metadata used during comparisons.
+type structMeta struct {
+	name   string   // Struct type name.
+	fields []string // Field names.
+	trails []string // Field trails when there is no trail yet.
+	redact []bool   // Fields redacted with the struct tag.
+}
+
+// isRedacted returns true if the field at index "i" is redacted with the
+// struct tag or its name is one of the "names" (see [dump.WithRedact]).
+func (meta *structMeta) isRedacted(i int, names []string) bool {
+	return meta.redact[i] || slices.Contains(names, meta.fields[i])
+}
+
+// structMetas is a cache of [structMeta] instances by [reflect.Type].
+var structMetas sync.Map
+
+// getStructMeta returns cached metadata for struct type "typ".
+func getStructMeta(typ reflect.Type) *structMeta {
+	if meta, ok := structMetas.Load(typ); ok {
+		return meta.(*structMeta) // nolint: forcetypeassert
+	}
+	meta := &structMeta{
+		name:   typ.Name(),
+		fields: make([]string, typ.NumField()),
+		trails: make([]string, typ.NumField()),
+		redact: make([]bool, typ.NumField()),
+	}
+	for i := range meta.fields {
+		fld := typ.Field(i)
+		meta.fields[i] = fld.Name
+		meta.trails[i] = structTrail("", meta.name, meta.fields[i])
+		meta.redact[i] = dump.Dump{}.IsRedacted(fld)
+	}
+	act, _ := structMetas.LoadOrStore(typ, meta)
+	return act.(*structMeta) // nolint: forcetypeassert
+}
+
+// deepEqual is the internal comparison function. To handle arbitrarily deep
+// data structures, instead of recursion, it uses an explicit stack of values
+// to compare. The nested values are pushed to the stack in reverse order, so
+// they are visited (and reported) in the same order as the recursive
+// depth-first traversal would.
 func deepEqual(
 	wVal, hVal reflect.Value,
 	visited map[visit]bool,
@@ -57,47 +161,193 @@ func deepEqual(
 ) error {
 
 	ops := DefaultOptions(opts...)
+	tsk := equalTask{wVal: wVal, hVal: hVal, trail: ops.Trail}
+	ers := equalRun(tsk, &ops, visited)
+	if len(ers) == 1 {
+		return ers[0]
+	}
+	return notice.Join(ers...)
+}
+
+// equalRun compares values of the task and all their nested values. Returns
+// the errors in the order the values were visited. When [Options.FailFast] is
+// set, it returns right after the first error.
+func equalRun(tsk equalTask, ops *Options, visited map[visit]bool) []error {
+	var ers []error
+	stack := []equalTask{tsk}
+	for len(stack) > 0 {
+		tsk = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		err := tsk.err
+		if err == nil {
+			stack, err = equalStep(tsk, ops, visited, stack)
+		}
+		if err != nil {
+			ers = append(ers, err)
+			if ops.FailFast {
+				return ers
+			}
+		}
+	}
+	return ers
+}
+
+// pushTasks pushes the tasks to the stack in reverse order, so they are
+// visited in the given order. When the [Options.Parallel] is set, the tasks
+// are compared right away by [equalParallel], and only the errors are pushed.
+func pushTasks(tasks []equalTask, ops *Options, stack []equalTask) []equalTask {
+	if ops.Parallel > 1 && len(tasks) > 1 {
+		return equalParallel(tasks, ops, stack)
+	}
+	for i := len(tasks) - 1; i >= 0; i-- {
+		stack = append(stack, tasks[i])
+	}
+	return stack
+}
+
+// equalParallel compares the tasks using a pool of [Options.Parallel]
+// workers. Each worker has its own visited set, and each task has its own
+// trail log. The logged trails are merged in the tasks order, and the errors
+// are pushed to the stack as tasks, so they are reported in the same order as
+// by the sequential comparison.
+func equalParallel(
+	tasks []equalTask,
+	ops *Options,
+	stack []equalTask,
+) []equalTask {
+
+	ers := make([][]error, len(tasks))
+	logs := make([][]string, len(tasks))
+	idx := make(chan int)
+
+	// Index of the first failed task. With the fail fast option, the tasks
+	// after it are not compared.
+	var failed atomic.Int64
+	failed.Store(int64(len(tasks)))
+
+	var wg sync.WaitGroup
+	for range min(ops.Parallel, len(tasks)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wops := *ops
+			wops.Parallel = 0
+			wops.TrailSink = nil
+			visited := make(map[visit]bool)
+			for i := range idx {
+				if int64(i) > failed.Load() {
+					continue
+				}
+				if ops.TrailLog != nil || ops.TrailSink != nil {
+					wops.TrailLog = &logs[i]
+				}
+				ers[i] = equalRun(tasks[i], &wops, visited)
+				if len(ers[i]) > 0 && ops.FailFast {
+					lowerTo(&failed, int64(i))
+				}
+			}
+		}()
+	}
+	for i := range tasks {
+		idx <- i
+	}
+	close(idx)
+	wg.Wait()
+
+	last := len(tasks) - 1
+	if ops.FailFast {
+		last = min(last, int(failed.Load()))
+	}
+	for i := last; i >= 0; i-- {
+		for j := len(ers[i]) - 1; j >= 0; j-- {
+			tsk := equalTask{trail: tasks[i].trail, err: ers[i][j]}
+			stack = append(stack, tsk)
+		}
+	}
+	for _, log := range logs[:last+1] {
+		for _, trail := range log {
+			logTrail(ops, trail)
+		}
+	}
+	return stack
+}
+
+// lowerTo atomically sets the "val" to "n" when "n" is lower than its value.
+func lowerTo(val *atomic.Int64, n int64) {
+	for cur := val.Load(); n < cur; cur = val.Load() {
+		if val.CompareAndSwap(cur, n) {
+			return
+		}
+	}
+}
+
+// equalStep compares values of a single task. The nested values which need to
+// be compared are pushed to the stack. Returns the stack and an error if the
+// values are not equal. The "ops" are shared by all the tasks and must not be
+// modified.
+//
+// nolint: gocognit, cyclop
+func equalStep(
+	tsk equalTask,
+	ops *Options,
+	visited map[visit]bool,
+	stack []equalTask,
+) ([]equalTask, error) {
+
+	wVal, hVal, trail := tsk.wVal, tsk.hVal, tsk.trail
 
 	// Return when the trail should be skipped.
-	if i := slices.Index(ops.SkipTrails, ops.Trail); i >= 0 {
-		ops.Trail += " <skipped>"
-		ops.LogTrail()
-		return nil
+	if ops.skipSet.has(trail) {
+		logTrail(ops, trail+" <skipped>")
+		return stack, nil
 	}
 
 	// Skip unexported fields if the option is turned on.
 	if wVal.IsValid() && !wVal.CanInterface() && ops.SkipUnexported {
-		trail := ops.Trail
-		ops.Trail += " <skipped>"
-		ops.LogTrail()
-		ops.Trail = trail
-		return nil
+		logTrail(ops, trail+" <skipped>")
+		return stack, nil
 	}
 
 	// Both are untyped nil value.
 	if !wVal.IsValid() && !hVal.IsValid() {
-		ops.LogTrail()
-		return nil
+		logTrail(ops, trail)
+		return stack, nil
 	}
 
 	// One of the values is untyped nil.
 	if !wVal.IsValid() || !hVal.IsValid() {
-		ops.LogTrail()
+		logTrail(ops, trail)
 		if wVal.IsValid() {
 			wStr := ops.Dumper.Value(wVal)
-			return notice.New("expected values to be equal").
-				SetTrail(ops.Trail).
+			return stack, notice.New("expected values to be equal").
+				SetTrail(trail).
 				Want("%s", wStr).
 				Have("%s", dump.ValNil)
 		}
 
 		hStr := ops.Dumper.Value(hVal)
-		return notice.New("expected values to be equal").
-			SetTrail(ops.Trail).
+		return stack, notice.New("expected values to be equal").
+			SetTrail(trail).
 			Want("%s", dump.ValNil).
 			Have("%s", hStr)
 	}
 
+	// Compare errors by their messages if the option is turned on.
+	if ops.ErrorsByMessage {
+		if wErr, hErr, ok := asErrors(wVal, hVal); ok {
+			logTrail(ops, trail)
+			if wErr.Error() == hErr.Error() {
+				return stack, nil
+			}
+			return stack, notice.New("expected error messages to be equal").
+				SetTrail(trail).
+				Want("%q", wErr.Error()).
+				Have("%q", hErr.Error()).
+				Append("want chain", "%s", errorChain(ops.Dumper, wErr)).
+				Append("have chain", "%s", errorChain(ops.Dumper, hErr))
+		}
+	}
+
 	// Check both types are the same.
 	wTyp := wVal.Type()
 	hTyp := hVal.Type()
@@ -109,13 +359,23 @@ func deepEqual(
 			if wOK && hOK {
 				wVal = reflect.ValueOf(wSmp)
 				hVal = reflect.ValueOf(hSmp)
-				return deepEqual(wVal, hVal, visited, WithOptions(ops))
+				tsk = equalTask{wVal: wVal, hVal: hVal, trail: trail}
+				return append(stack, tsk), nil
 			}
 		}
 
-		ops.LogTrail()
-		return notice.New("expected values to be equal").
-			SetTrail(ops.Trail).
+		// Compare named types against their underlying types.
+		if ops.CmpUnderlying &&
+			wTyp.Kind() == hTyp.Kind() && hTyp.ConvertibleTo(wTyp) {
+			hVal = hVal.Convert(wTyp)
+			tsk = equalTask{wVal: wVal, hVal: hVal, trail: trail}
+			return append(stack, tsk), nil
+		}
+
+		logTrail(ops, trail)
+		return stack, notice.New("expected values to be equal").
+			Code(CodeTypeMismatch).
+			SetTrail(trail).
 			Append("want type", "%s", wTyp).
 			Append("have type", "%s", hTyp)
 	}
@@ -126,61 +386,70 @@ func deepEqual(
 	if wPtr != nil && hPtr != nil {
 		v := visit{wPtr, hPtr, wTyp}
 		if visited[v] {
-			return nil
+			return stack, nil
 		}
 		visited[v] = true
 	}
 
 	var chk Checker
-	if chk = ops.TrailCheckers[ops.Trail]; chk == nil {
+	if chk = ops.TrailCheckers[trail]; chk == nil {
 		chk = ops.TypeCheckers[wTyp]
 	}
 
 	if chk != nil {
-		ops.LogTrail()
+		logTrail(ops, trail)
 		wItf, wOk := core.Value(wVal)
 		hItf, hOk := core.Value(hVal)
 		if !wOk || !hOk {
 			// TODO(rz): test this.
-			return notice.New("not able to compare using a custom checker").
-				SetTrail(ops.Trail).
+			msg := notice.New("not able to compare using a custom checker").
+				SetTrail(trail).
 				Append("want type", "%s", wTyp).
 				Append("have type", "%s", hTyp)
+			return stack, msg
 		}
-		return chk(wItf, hItf, WithOptions(ops))
+		return stack, chk(wItf, hItf, withTrail(ops, trail))
 	}
 
 	switch knd := wVal.Kind(); knd {
 	case reflect.Ptr:
 		if wVal.IsNil() && hVal.IsNil() {
-			ops.LogTrail()
-			return nil
+			logTrail(ops, trail)
+			return stack, nil
 		}
-		return deepEqual(wVal.Elem(), hVal.Elem(), visited, WithOptions(ops))
+		tsk = equalTask{wVal: wVal.Elem(), hVal: hVal.Elem(), trail: trail}
+		return append(stack, tsk), nil
 
 	case reflect.Struct:
-		var err error
-		for i := 0; i < wVal.NumField(); i++ {
+		meta := getStructMeta(wTyp)
+		for i := len(meta.fields) - 1; i >= 0; i-- {
 			wfVal := wVal.Field(i)
 			hfVal := hVal.Field(i)
 			if !wfVal.IsValid() {
 				continue
 			}
-			wSF := wVal.Type().Field(i)
-			typeName := wVal.Type().Name()
-			iOps := ops.StructTrail(typeName, wSF.Name)
-			if e := deepEqual(wfVal, hfVal, visited, WithOptions(iOps)); e != nil {
-				err = notice.Join(err, e)
+			fTrail := meta.trails[i]
+			if trail != "" {
+				fTrail = structTrail(trail, meta.name, meta.fields[i])
+			}
+			tsk = equalTask{wVal: wfVal, hVal: hfVal, trail: fTrail}
+			if meta.isRedacted(i, ops.Dumper.Redact) {
+				if len(equalRun(tsk, ops, visited)) == 0 {
+					continue
+				}
+				tsk = equalTask{trail: fTrail, err: redactedError(fTrail)}
 			}
+			stack = append(stack, tsk)
 		}
-		return err
+		return stack, nil
 
 	case reflect.Slice, reflect.Array:
 		if wVal.Len() != hVal.Len() {
-			ops.LogTrail()
-			wStr, hStr, diff := ops.Dumper.DiffValue(wVal, hVal)
-			return notice.New("expected values to be equal").
-				SetTrail(ops.Trail).
+			logTrail(ops, trail)
+			dmp := trailDumper(ops, trail, wVal.Type(), hVal.Type())
+			wStr, hStr, diff := dmp.DiffValue(wVal, hVal)
+			return stack, notice.New("expected values to be equal").
+				SetTrail(trail).
 				Prepend("have len", "%d", hVal.Len()).
 				Prepend("want len", "%d", wVal.Len()).
 				Want("%s", wStr).
@@ -188,26 +457,26 @@ func deepEqual(
 				Append("diff", "%s", diff)
 		}
 		if knd == reflect.Slice && wVal.Pointer() == hVal.Pointer() {
-			ops.LogTrail()
-			return nil
-		}
-		var err error
-		for i := 0; i < wVal.Len(); i++ {
-			wiVal := wVal.Index(i)
-			hiVal := hVal.Index(i)
-			iOps := ops.ArrTrail(knd.String(), i)
-			if e := deepEqual(wiVal, hiVal, visited, WithOptions(iOps)); e != nil {
-				err = notice.Join(err, e)
+			logTrail(ops, trail)
+			return stack, nil
+		}
+		tasks := make([]equalTask, wVal.Len())
+		for i := range tasks {
+			tasks[i] = equalTask{
+				wVal:  wVal.Index(i),
+				hVal:  hVal.Index(i),
+				trail: arrTrail(trail, knd.String(), i),
 			}
 		}
-		return err
+		return pushTasks(tasks, ops, stack), nil
 
 	case reflect.Map:
 		if wVal.Len() != hVal.Len() {
-			ops.LogTrail()
-			wStr, hStr, diff := ops.Dumper.DiffValue(wVal, hVal)
-			return notice.New("expected values to be equal").
-				SetTrail(ops.Trail).
+			logTrail(ops, trail)
+			dmp := trailDumper(ops, trail, wVal.Type(), hVal.Type())
+			wStr, hStr, diff := dmp.DiffValue(wVal, hVal)
+			return stack, notice.New("expected values to be equal").
+				SetTrail(trail).
 				Prepend("have len", "%d", hVal.Len()).
 				Prepend("want len", "%d", wVal.Len()).
 				Want("%s", wStr).
@@ -215,219 +484,273 @@ func deepEqual(
 				Append("diff", "%s", diff)
 		}
 		if wVal.Pointer() == hVal.Pointer() {
-			ops.LogTrail()
-			return nil
+			logTrail(ops, trail)
+			return stack, nil
 		}
 
 		keys := wVal.MapKeys()
-		sort.Slice(keys, func(i, j int) bool {
-			return valToString(keys[i]) < valToString(keys[j])
-		})
+		slices.SortStableFunc(keys, core.ValueCmp)
 
-		var err error
-		for _, key := range keys {
+		tasks := make([]equalTask, len(keys))
+		for i, key := range keys {
 			wkVal := wVal.MapIndex(key)
 			hkVal := hVal.MapIndex(key)
-			kOps := ops.MapTrail(valToString(key))
+			kTrail := mapTrail(trail, valToString(key))
 			if !hkVal.IsValid() {
 				hItf := hVal.Interface()
-				e := equalError(hItf, nil, WithOptions(kOps))
-				err = notice.Join(err, e)
+				e := equalError(hItf, nil, withTrail(ops, kTrail))
+				tasks[i] = equalTask{trail: kTrail, err: e}
 				continue
 			}
-			if e := deepEqual(wkVal, hkVal, visited, WithOptions(kOps)); e != nil {
-				err = notice.Join(err, e)
-			}
+			tasks[i] = equalTask{wVal: wkVal, hVal: hkVal, trail: kTrail}
 		}
-		return err
+		return pushTasks(tasks, ops, stack), nil
 
 	case reflect.Interface:
-		wElem := wVal.Elem()
-		hElem := hVal.Elem()
-		return deepEqual(wElem, hElem, visited, WithOptions(ops))
+		tsk = equalTask{wVal: wVal.Elem(), hVal: hVal.Elem(), trail: trail}
+		return append(stack, tsk), nil
 
 	case reflect.Bool:
-		ops.LogTrail()
+		logTrail(ops, trail)
 		w, h := wVal.Bool(), hVal.Bool()
 		if w == h {
-			return nil
+			return stack, nil
 		}
-		return equalError(w, h, WithOptions(ops))
+		return stack, equalError(w, h, withTrail(ops, trail))
 
 	case reflect.Int:
-		ops.LogTrail()
+		logTrail(ops, trail)
 		w, h := int(wVal.Int()), int(hVal.Int())
 		if w == h {
-			return nil
+			return stack, nil
 		}
-		return equalError(w, h, WithOptions(ops))
+		return stack, equalError(w, h, withTrail(ops, trail))
 
 	case reflect.Int8:
-		ops.LogTrail()
+		logTrail(ops, trail)
 		w, h := int8(wVal.Int()), int8(hVal.Int()) // nolint: gosec
 		if w == h {
-			return nil
+			return stack, nil
 		}
-		return equalError(w, h, WithOptions(ops))
+		return stack, equalError(w, h, withTrail(ops, trail))
 
 	case reflect.Int16:
-		ops.LogTrail()
+		logTrail(ops, trail)
 		w, h := int16(wVal.Int()), int16(hVal.Int()) // nolint: gosec
 		if w == h {
-			return nil
+			return stack, nil
 		}
-		return equalError(w, h, WithOptions(ops))
+		return stack, equalError(w, h, withTrail(ops, trail))
 
 	case reflect.Int32:
-		ops.LogTrail()
+		logTrail(ops, trail)
 		w, h := int32(wVal.Int()), int32(hVal.Int()) // nolint: gosec
 		if w == h {
-			return nil
+			return stack, nil
 		}
-		return equalError(w, h, WithOptions(ops))
+		return stack, equalError(w, h, withTrail(ops, trail))
 
 	case reflect.Int64:
-		ops.LogTrail()
+		logTrail(ops, trail)
 		w, h := wVal.Int(), hVal.Int()
 		if w == h {
-			return nil
+			return stack, nil
 		}
-		return equalError(w, h, WithOptions(ops))
+		return stack, equalError(w, h, withTrail(ops, trail))
 
 	case reflect.Uint:
-		ops.LogTrail()
+		logTrail(ops, trail)
 		w, h := uint(wVal.Uint()), uint(hVal.Uint())
 		if w == h {
-			return nil
+			return stack, nil
 		}
-		return equalError(w, h, WithOptions(ops))
+		return stack, equalError(w, h, withTrail(ops, trail))
 
 	case reflect.Uint8:
-		ops.LogTrail()
+		logTrail(ops, trail)
 		w, h := uint8(wVal.Uint()), uint8(hVal.Uint()) // nolint: gosec
 		if w == h {
-			return nil
+			return stack, nil
 		}
-		return equalError(w, h, WithOptions(ops))
+		return stack, equalError(w, h, withTrail(ops, trail))
 
 	case reflect.Uint16:
-		ops.LogTrail()
+		logTrail(ops, trail)
 		w, h := uint16(wVal.Uint()), uint16(hVal.Uint()) // nolint: gosec
 		if w == h {
-			return nil
+			return stack, nil
 		}
-		return equalError(w, h, WithOptions(ops))
+		return stack, equalError(w, h, withTrail(ops, trail))
 
 	case reflect.Uint32:
-		ops.LogTrail()
+		logTrail(ops, trail)
 		w, h := uint32(wVal.Uint()), uint32(hVal.Uint()) // nolint: gosec
 		if w == h {
-			return nil
+			return stack, nil
 		}
-		return equalError(w, h, WithOptions(ops))
+		return stack, equalError(w, h, withTrail(ops, trail))
 
 	case reflect.Uint64:
-		ops.LogTrail()
+		logTrail(ops, trail)
 		w, h := wVal.Uint(), hVal.Uint()
 		if w == h {
-			return nil
+			return stack, nil
 		}
-		return equalError(w, h, WithOptions(ops))
+		return stack, equalError(w, h, withTrail(ops, trail))
 
 	case reflect.Float32:
-		ops.LogTrail()
+		logTrail(ops, trail)
 		w, h := float32(wVal.Float()), float32(hVal.Float()) // nolint: gosec
-		if w == h {
-			return nil
+		if floatsEqual(float64(w), float64(h), ops) {
+			return stack, nil
 		}
-		return equalError(w, h, WithOptions(ops))
+		return stack, floatError(w, h, ops, trail)
 
 	case reflect.Float64:
-		ops.LogTrail()
+		logTrail(ops, trail)
 		w, h := wVal.Float(), hVal.Float()
-		if w == h {
-			return nil
+		if floatsEqual(w, h, ops) {
+			return stack, nil
 		}
-		return equalError(w, h, WithOptions(ops))
+		return stack, floatError(w, h, ops, trail)
 
 	case reflect.Complex64:
-		ops.LogTrail()
+		logTrail(ops, trail)
 		w, h := complex64(wVal.Complex()), complex64(hVal.Complex())
 		if w == h {
-			return nil
+			return stack, nil
 		}
-		return equalError(w, h, WithOptions(ops))
+		return stack, equalError(w, h, withTrail(ops, trail))
 
 	case reflect.Complex128:
-		ops.LogTrail()
+		logTrail(ops, trail)
 		w, h := wVal.Complex(), hVal.Complex()
 		if w == h {
-			return nil
+			return stack, nil
 		}
-		return equalError(w, h, WithOptions(ops))
+		return stack, equalError(w, h, withTrail(ops, trail))
 
 	case reflect.String:
-		ops.LogTrail()
+		logTrail(ops, trail)
 		w, h := wVal.String(), hVal.String()
-		if w == h {
-			return nil
+		equal, mode := stringsEqual(w, h, ops)
+		if equal {
+			return stack, nil
+		}
+		msg := equalError(w, h, withTrail(ops, trail))
+		if mode != "" {
+			_ = msg.Append("comparison", "%s", mode)
 		}
-		return equalError(w, h, WithOptions(ops))
+		return stack, msg
 
 	case reflect.Chan:
-		ops.LogTrail()
+		logTrail(ops, trail)
 		w, h := wVal.Pointer(), hVal.Pointer()
 		if w == h {
-			return nil
+			return stack, nil
 		}
-		err := notice.New("expected values to be equal").SetTrail(ops.Trail).
+		err := notice.New("expected values to be equal").SetTrail(trail).
 			Want("%s", dump.ChanDumper(ops.Dumper, 0, wVal)).
 			Have("%s", dump.ChanDumper(ops.Dumper, 0, hVal))
-		return err
+		return stack, err
 
 	case reflect.Func:
-		ops.LogTrail()
+		logTrail(ops, trail)
 		w, h := wVal.Pointer(), hVal.Pointer()
 		if w == h {
-			return nil
+			return stack, nil
 		}
-		err := notice.New("expected values to be equal").SetTrail(ops.Trail).
+		err := notice.New("expected values to be equal").SetTrail(trail).
 			Want("%s", dump.FuncDumper(ops.Dumper, 0, wVal)).
 			Have("%s", dump.FuncDumper(ops.Dumper, 0, hVal))
-		return err
+		return stack, err
 
 	case reflect.Uintptr:
-		ops.LogTrail()
+		logTrail(ops, trail)
 		w, h := wVal.Uint(), hVal.Uint()
 		if w == h {
-			return nil
+			return stack, nil
 		}
-		err := notice.New("expected values to be equal").SetTrail(ops.Trail).
+		err := notice.New("expected values to be equal").SetTrail(trail).
 			Want("%s", dump.HexPtrDumper(ops.Dumper, 0, wVal)).
 			Have("%s", dump.HexPtrDumper(ops.Dumper, 0, hVal))
-		return err
+		return stack, err
 
 	case reflect.UnsafePointer:
-		ops.LogTrail()
+		logTrail(ops, trail)
 		w, h := wVal.Pointer(), hVal.Pointer()
 		if w == h {
-			return nil
+			return stack, nil
 		}
-		err := notice.New("expected values to be equal").SetTrail(ops.Trail).
+		err := notice.New("expected values to be equal").SetTrail(trail).
 			Want("%s", dump.HexPtrDumper(ops.Dumper, 0, wVal)).
 			Have("%s", dump.HexPtrDumper(ops.Dumper, 0, hVal))
-		return err
+		return stack, err
 
 	default:
-		ops.LogTrail()
-		return notice.New("cannot compare values").
-			SetTrail(ops.Trail).
+		logTrail(ops, trail)
+		return stack, notice.New("cannot compare values").
+			SetTrail(trail).
 			Append("cause", "%s", "value cannot be used without panicking").
 			Append("hint", "%s", "use WithSkipTrail or WithSkipUnexported "+
 				"option to skip this field")
 	}
 }
 
+// logTrail logs non-empty "trail" to [Options.TrailLog] and
+// [Options.TrailSink].
+func logTrail(ops *Options, trail string) {
+	if trail == "" {
+		return
+	}
+	if ops.TrailLog != nil {
+		*ops.TrailLog = append(*ops.TrailLog, trail)
+	}
+	if ops.TrailSink != nil {
+		_, _ = io.WriteString(ops.TrailSink, trail+"\n")
+	}
+}
+
+// withTrail returns [Option] setting all the options to "ops" with the
+// [Options.Trail] set to "trail".
+func withTrail(ops *Options, trail string) Option {
+	return func(Options) Options {
+		cpy := *ops
+		cpy.Trail = trail
+		return cpy
+	}
+}
+
+// floatsEqual returns true if floating point numbers are equal considering the
+// [Options.StrictZero] and [Options.FloatRelativeDelta] options.
+func floatsEqual(want, have float64, ops *Options) bool {
+	if want == have {
+		if ops.StrictZero && want == 0 {
+			return math.Signbit(want) == math.Signbit(have)
+		}
+		return true
+	}
+	return withinRelDelta(want, have, ops.FloatRelativeDelta)
+}
+
+// floatError returns error for not equal floating point numbers.
+func floatError[T float32 | float64](
+	want, have T,
+	ops *Options,
+	trail string,
+) *notice.Notice {
+
+	msg := equalError(want, have, withTrail(ops, trail))
+	if want == have { // Zeros with different signs.
+		return msg.
+			Want("%+g", want).
+			Have("%+g", have).
+			Append("comparison", "%s", "strict zero")
+	}
+	w, h := float64(want), float64(have)
+	return relDeltaRows(msg, w, h, ops.FloatRelativeDelta)
+}
+
 // equalError returns error for not equal values.
 func equalError(want, have any, opts ...Option) *notice.Notice {
 	wTyp, hTyp := fmt.Sprintf("%T", want), fmt.Sprintf("%T", have)
@@ -440,26 +763,128 @@ func equalError(want, have any, opts ...Option) *notice.Notice {
 		ops.Dumper.Dumpers[typByte] = dumpByte
 	}
 
-	msg := notice.New("expected values to be equal").SetTrail(ops.Trail)
+	msg := notice.New("expected values to be equal").
+		SetTrail(ops.Trail).
+		MetaSet(notice.MetaWant, want).
+		MetaSet(notice.MetaHave, have)
 	if wTyp != "" {
 		_ = msg.
+			Code(CodeTypeMismatch).
 			Append("want type", "%s", wTyp).
 			Append("have type", "%s", hTyp)
 	}
 
-	wStr, hStr, diff := ops.Dumper.Diff(want, have)
-	_ = msg.Want("%s", wStr).Have("%s", hStr)
+	// Values are dumped only when the message is rendered.
+	dmp := trailDumper(
+		&ops,
+		ops.Trail,
+		reflect.TypeOf(want),
+		reflect.TypeOf(have),
+	)
+	dif := &lazyDiff{dmp: dmp, want: want, have: have}
+	_ = msg.
+		Want("%s", notice.Lazy(dif.wantStr)).
+		Have("%s", notice.Lazy(dif.haveStr))
 
 	var assignable bool
 	if want != nil && have != nil {
 		assignable = reflect.TypeOf(want).AssignableTo(reflect.TypeOf(have))
 	}
-	if diff != "" && assignable {
-		_ = msg.Append("diff", "%s", diff)
+	if assignable {
+		_ = msg.AppendOptional("diff", "%s", notice.Lazy(dif.diffStr))
 	}
 	return msg
 }
 
+// trailDumper returns the dumper to use for values at the "trail". When a
+// custom dumper was set for the trail with [WithTrailDumper], the returned
+// dumper uses it for the given types.
+func trailDumper(ops *Options, trail string, typs ...reflect.Type) dump.Dump {
+	fn, ok := ops.TrailDumpers[trail]
+	if !ok || fn == nil {
+		return ops.Dumper
+	}
+	dmp := ops.Dumper
+	dmp.Dumpers = maps.Clone(dmp.Dumpers)
+	if dmp.Dumpers == nil {
+		dmp.Dumpers = make(map[reflect.Type]dump.Dumper, len(typs))
+	}
+	for _, typ := range typs {
+		if typ != nil {
+			dmp.Dumpers[typ] = fn
+		}
+	}
+	return dmp
+}
+
+// redactedError returns an error for not equal values of the redacted struct
+// field. The values are not part of the message.
+func redactedError(trail string) error {
+	return notice.New("expected values to be equal").
+		SetTrail(trail).
+		Want("%s", dump.ValRedacted).
+		Have("%s", dump.ValRedacted)
+}
+
+// lazyDiff computes the [dump.Dump.Diff] of two values on the first use.
+type lazyDiff struct {
+	once sync.Once // Guards the diff computation.
+	dmp  dump.Dump // Dumper used to compute the diff.
+	want any       // The "want" value.
+	have any       // The "have" value.
+	wStr string    // The dumped "want" value.
+	hStr string    // The dumped "have" value.
+	diff string    // The diff between the values.
+}
+
+// compute computes the diff once.
+func (ld *lazyDiff) compute() {
+	ld.once.Do(func() {
+		ld.wStr, ld.hStr, ld.diff = ld.dmp.Diff(ld.want, ld.have)
+	})
+}
+
+// wantStr returns the dumped "want" value.
+func (ld *lazyDiff) wantStr() string { ld.compute(); return ld.wStr }
+
+// haveStr returns the dumped "have" value.
+func (ld *lazyDiff) haveStr() string { ld.compute(); return ld.hStr }
+
+// diffStr returns the diff between the values.
+func (ld *lazyDiff) diffStr() string { ld.compute(); return ld.diff }
+
+// asErrors returns "want" and "have" values as errors. Returns false if any of
+// the values doesn't implement the error interface or is nil.
+func asErrors(wVal, hVal reflect.Value) (error, error, bool) {
+	wErr, wOK := valueError(wVal)
+	hErr, hOK := valueError(hVal)
+	return wErr, hErr, wOK && hOK
+}
+
+// valueError returns value as an error. Returns false if the value doesn't
+// implement the error interface or is nil.
+func valueError(val reflect.Value) (error, bool) {
+	if !val.CanInterface() {
+		return nil, false
+	}
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice,
+		reflect.Func, reflect.Chan:
+		if val.IsNil() {
+			return nil, false
+		}
+	default:
+	}
+	err, ok := val.Interface().(error)
+	return err, ok
+}
+
+// errorChain returns a description of the error chain, including errors
+// joined with [errors.Join], using the [dump.ErrorDumper].
+func errorChain(dmp dump.Dump, err error) string {
+	return dump.ErrorDumper(dmp, 0, reflect.ValueOf(err))
+}
+
 // dumpByte is a custom bumper for bytes.
 func dumpByte(dmp dump.Dump, lvl int, val reflect.Value) string {
 	v := val.Interface().(byte) // nolint: forcetypeassert
@@ -1,9 +1,13 @@
 package check
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 	"unsafe"
@@ -13,6 +17,7 @@ import (
 	"github.com/ctx42/testing/internal/types"
 	"github.com/ctx42/testing/pkg/dump"
 	"github.com/ctx42/testing/pkg/must"
+	"github.com/ctx42/testing/pkg/notice"
 )
 
 func Test_Equal(t *testing.T) {
@@ -66,6 +71,868 @@ func Test_Equal(t *testing.T) {
 		// --- Then ---
 		affirm.Nil(t, err)
 	})
+
+	t.Run("WithUnderlyingTypes - named simple type", func(t *testing.T) {
+		// --- When ---
+		err := Equal(types.TStrType("a"), "a", WithUnderlyingTypes)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("WithUnderlyingTypes - named slice type", func(t *testing.T) {
+		// --- Given ---
+		type IDs []int
+
+		// --- When ---
+		err := Equal(IDs{1, 2}, []int{1, 2}, WithUnderlyingTypes)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("WithUnderlyingTypes - nested values", func(t *testing.T) {
+		// --- Given ---
+		m0 := map[string]any{"A": types.TIntType(42)}
+		m1 := map[string]any{"A": 42}
+
+		// --- When ---
+		err := Equal(m0, m1, WithUnderlyingTypes)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - WithUnderlyingTypes values not equal", func(t *testing.T) {
+		// --- When ---
+		err := Equal(types.TStrType("a"), "b", WithUnderlyingTypes)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"  want: \"a\"\n" +
+			"  have: \"b\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("WithCaseInsensitive", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ Str string }
+
+		// --- When ---
+		err := Equal(T{Str: "ABC"}, T{Str: "abc"}, WithCaseInsensitive)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - WithCaseInsensitive", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ Str string }
+
+		// --- When ---
+		err := Equal(T{Str: "ABC"}, T{Str: "xyz"}, WithCaseInsensitive)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"       trail: T.Str\n" +
+			"        want: \"ABC\"\n" +
+			"        have: \"xyz\"\n" +
+			"  comparison: case-insensitive"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("WithNormalizeWhitespace", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ Str string }
+
+		// --- When ---
+		err := Equal(T{Str: "a  b\n"}, T{Str: "a b"}, WithNormalizeWhitespace)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("WithErrorsByMessage", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ Err error }
+		want := T{Err: errors.New("ctx: base")}
+		have := T{Err: fmt.Errorf("ctx: %w", errors.New("base"))}
+
+		// --- When ---
+		err := Equal(want, have, WithErrorsByMessage)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("WithErrorsByMessage both nil errors", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ Err error }
+
+		// --- When ---
+		err := Equal(T{}, T{}, WithErrorsByMessage)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - WithErrorsByMessage", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ Err error }
+		want := T{Err: errors.New("ctx: base")}
+		have := T{Err: fmt.Errorf("ctx: %w", errors.New("other"))}
+
+		// --- When ---
+		err := Equal(want, have, WithErrorsByMessage)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected error messages to be equal:\n" +
+			"       trail: T.Err\n" +
+			"        want: \"ctx: base\"\n" +
+			"        have: \"ctx: other\"\n" +
+			"  want chain: *errors.errorString(\"ctx: base\")\n" +
+			"  have chain:\n" +
+			"              *fmt.wrapError(\"ctx: other\") {\n" +
+			"                *errors.errorString(\"other\"),\n" +
+			"              }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - WithErrorsByMessage joined errors", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ Err error }
+		want := T{Err: errors.New("a")}
+		have := T{Err: errors.Join(errors.New("a"), errors.New("b"))}
+
+		// --- When ---
+		err := Equal(want, have, WithErrorsByMessage)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected error messages to be equal:\n" +
+			"       trail: T.Err\n" +
+			"        want: \"a\"\n" +
+			"        have: \"a\\nb\"\n" +
+			"  want chain: *errors.errorString(\"a\")\n" +
+			"  have chain:\n" +
+			"              *errors.joinError(\"a\\nb\") {\n" +
+			"                *errors.errorString(\"a\"),\n" +
+			"                *errors.errorString(\"b\"),\n" +
+			"              }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - WithErrorsByMessage nil error", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ Err error }
+		want := T{Err: errors.New("base")}
+
+		// --- When ---
+		err := Equal(want, T{}, WithErrorsByMessage)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"  trail: T.Err\n" +
+			"   want: \"base\"\n" +
+			"   have: nil"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - WithUnderlyingTypes different kinds", func(t *testing.T) {
+		// --- When ---
+		err := Equal(types.TIntType(1), 1.0, WithUnderlyingTypes)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"  want type: types.TIntType\n" +
+			"  have type: float64"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_Equal_deep_structures(t *testing.T) {
+	type Node struct {
+		Val  int
+		Next *Node
+	}
+
+	list := func(n, last int) *Node {
+		head := &Node{}
+		cur := head
+		for i := 1; i < n; i++ {
+			cur.Next = &Node{Val: i}
+			cur = cur.Next
+		}
+		cur.Val = last
+		return head
+	}
+
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		want := list(10_000, 1)
+		have := list(10_000, 1)
+
+		// --- When ---
+		err := Equal(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - not equal", func(t *testing.T) {
+		// --- Given ---
+		want := list(10_000, 1)
+		have := list(10_000, 2)
+
+		// --- When ---
+		err := Equal(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wTrail := "Node" + strings.Repeat(".Next", 9_999) + ".Val"
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"  trail: " + wTrail + "\n" +
+			"   want: 1\n" +
+			"   have: 2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("errors are reported in order", func(t *testing.T) {
+		// --- Given ---
+		type T struct {
+			Slice []int
+			Map   map[string]int
+			Int   int
+		}
+		want := T{Slice: []int{1, 2}, Map: map[string]int{"A": 1, "B": 2}}
+		have := T{Slice: []int{3, 4}, Map: map[string]int{"A": 3, "C": 4}}
+
+		trail := make([]string, 0)
+
+		// --- When ---
+		err := Equal(want, have, WithTrailLog(&trail))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wTrail := []string{
+			"T.Slice[0]",
+			"T.Slice[1]",
+			"T.Map[\"A\"]",
+			"T.Int",
+		}
+		affirm.DeepEqual(t, wTrail, trail)
+		wMsg := "" +
+			"multiple expectations violated:\n" +
+			"      error: expected values to be equal\n" +
+			"      trail: T.Slice[0]\n" +
+			"       want: 1\n" +
+			"       have: 3\n" +
+			"          ---\n" +
+			"      error: expected values to be equal\n" +
+			"      trail: T.Slice[1]\n" +
+			"       want: 2\n" +
+			"       have: 4\n" +
+			"          ---\n" +
+			"      error: expected values to be equal\n" +
+			"      trail: T.Map[\"A\"]\n" +
+			"       want: 1\n" +
+			"       have: 3\n" +
+			"          ---\n" +
+			"      error: expected values to be equal\n" +
+			"      trail: T.Map[\"B\"]\n" +
+			"  want type: map[string]int\n" +
+			"  have type: <nil>\n" +
+			"       want:\n" +
+			"             map[string]int{\n" +
+			"               \"A\": 3,\n" +
+			"               \"C\": 4,\n" +
+			"             }\n" +
+			"       have: nil"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_Equal_parallel(t *testing.T) {
+	type T struct {
+		Slice []types.TA
+		Map   map[int][]int
+		Int   int
+	}
+
+	fixture := func(n int) T {
+		val := T{Slice: make([]types.TA, n), Map: make(map[int][]int, n)}
+		for i := range n {
+			val.Slice[i] = types.TA{Int: i, Str: strconv.Itoa(i)}
+			val.Map[i] = []int{i, i + 1}
+		}
+		return val
+	}
+
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		want := fixture(1_000)
+		have := fixture(1_000)
+
+		// --- When ---
+		err := Equal(want, have, WithParallel(4))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - same result as sequential comparison", func(t *testing.T) {
+		// --- Given ---
+		want := fixture(100)
+		have := fixture(100)
+		have.Slice[3].Int = -3
+		have.Slice[50].Str = "-50"
+		have.Slice[99] = types.TA{}
+		have.Map[7] = []int{7}
+		delete(have.Map, 42)
+		have.Map[-1] = nil
+		have.Int = 1
+
+		seqTrail := make([]string, 0)
+		parTrail := make([]string, 0)
+		parSink := &bytes.Buffer{}
+
+		// --- When ---
+		seqErr := Equal(want, have, WithTrailLog(&seqTrail))
+		parErr := Equal(
+			want,
+			have,
+			WithTrailLog(&parTrail),
+			WithTrailSink(parSink),
+			WithParallel(8),
+		)
+
+		// --- Then ---
+		affirm.NotNil(t, seqErr)
+		affirm.NotNil(t, parErr)
+		affirm.Equal(t, seqErr.Error(), parErr.Error())
+		affirm.DeepEqual(t, seqTrail, parTrail)
+		affirm.Equal(t, strings.Join(seqTrail, "\n")+"\n", parSink.String())
+	})
+
+	t.Run("single worker compares sequentially", func(t *testing.T) {
+		// --- Given ---
+		want := []int{1, 2}
+		have := []int{1, 3}
+
+		// --- When ---
+		err := Equal(want, have, WithParallel(1))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"  trail: <slice>[1]\n" +
+			"   want: 2\n" +
+			"   have: 3"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_EqualValues(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		wVal := reflect.ValueOf(types.TA{Int: 1})
+		hVal := reflect.ValueOf(types.TA{Int: 1})
+
+		// --- When ---
+		err := EqualValues(wVal, hVal)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal unexported fields", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ v []int }
+		wVal := reflect.ValueOf(T{v: []int{1, 2}}).Field(0)
+		hVal := reflect.ValueOf(T{v: []int{1, 2}}).Field(0)
+
+		// --- When ---
+		err := EqualValues(wVal, hVal)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - not equal unexported fields", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ v []int }
+		wVal := reflect.ValueOf(T{v: []int{1, 2}}).Field(0)
+		hVal := reflect.ValueOf(T{v: []int{1, 3}}).Field(0)
+
+		// --- When ---
+		err := EqualValues(wVal, hVal, WithTrail("T.v"))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"  trail: T.v[1]\n" +
+			"   want: 2\n" +
+			"   have: 3"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - verbose adds trails", func(t *testing.T) {
+		// --- Given ---
+		wVal := reflect.ValueOf(types.TA{Int: 1, Str: "abc"})
+		hVal := reflect.ValueOf(types.TA{Int: 2, Str: "abc"})
+
+		// --- When ---
+		err := EqualValues(wVal, hVal, WithVerbosity(notice.Verbose))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		have := err.Error()
+		affirm.Equal(t, true, strings.Contains(have, "  trails:\n"))
+		affirm.Equal(t, true, strings.Contains(have, "TA.Int\n"))
+		affirm.Equal(t, true, strings.Contains(have, "TA.Str"))
+	})
+
+	t.Run("error - verbose without trails", func(t *testing.T) {
+		// --- Given ---
+		wVal := reflect.ValueOf(1)
+		hVal := reflect.ValueOf(2)
+
+		// --- When ---
+		err := EqualValues(wVal, hVal, WithVerbosity(notice.Verbose))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"  want: 1\n" +
+			"  have: 2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - verbose with trail log", func(t *testing.T) {
+		// --- Given ---
+		wVal := reflect.ValueOf(types.TA{Int: 1})
+		hVal := reflect.ValueOf(types.TA{Int: 2})
+		trails := make([]string, 0)
+		opts := []Option{WithVerbosity(notice.Verbose), WithTrailLog(&trails)}
+
+		// --- When ---
+		err := EqualValues(wVal, hVal, opts...)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		affirm.Equal(t, false, strings.Contains(err.Error(), "trails"))
+		affirm.Equal(t, true, len(trails) > 0)
+	})
+
+	t.Run("error - bytes are dumped with characters", func(t *testing.T) {
+		// --- Given ---
+		wVal := reflect.ValueOf([]byte("ab"))
+		hVal := reflect.ValueOf([]byte("ac"))
+
+		// --- When ---
+		err := EqualValues(wVal, hVal)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"  trail: <slice>[1]\n" +
+			"   want: 0x62 ('b')\n" +
+			"   have: 0x63 ('c')"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_Equal_fail_fast(t *testing.T) {
+	type T struct {
+		Slice []int
+		Map   map[string]int
+		Int   int
+	}
+	want := T{Slice: []int{1, 2}, Map: map[string]int{"A": 1, "B": 2}}
+	have := T{Slice: []int{1, 4}, Map: map[string]int{"A": 3, "C": 4}, Int: 1}
+
+	t.Run("returns the first error", func(t *testing.T) {
+		// --- Given ---
+		trail := make([]string, 0)
+
+		// --- When ---
+		err := Equal(want, have, WithTrailLog(&trail), WithFailFast)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"  trail: T.Slice[1]\n" +
+			"   want: 2\n" +
+			"   have: 4"
+		affirm.Equal(t, wMsg, err.Error())
+		affirm.DeepEqual(t, []string{"T.Slice[0]", "T.Slice[1]"}, trail)
+	})
+
+	t.Run("parallel", func(t *testing.T) {
+		// --- Given ---
+		want := make([]int, 1_000)
+		have := make([]int, 1_000)
+		have[500], have[700] = 1, 2
+		trail := make([]string, 0)
+
+		// --- When ---
+		err := Equal(
+			want,
+			have,
+			WithTrailLog(&trail),
+			WithFailFast,
+			WithParallel(4),
+		)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"  trail: <slice>[500]\n" +
+			"   want: 0\n" +
+			"   have: 1"
+		affirm.Equal(t, wMsg, err.Error())
+		affirm.Equal(t, 501, len(trail))
+		affirm.Equal(t, "<slice>[500]", trail[500])
+	})
+
+	t.Run("equal", func(t *testing.T) {
+		// --- When ---
+		err := Equal(want, want, WithFailFast)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+}
+
+func Test_Equal_float_relative_delta(t *testing.T) {
+	t.Run("float64 within relative delta", func(t *testing.T) {
+		// --- Given ---
+		opt := WithFloatRelativeDelta(0.01)
+
+		// --- When ---
+		err := Equal([]float64{1e-9, 1e9}, []float64{1.001e-9, 1.001e9}, opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("float32 within relative delta", func(t *testing.T) {
+		// --- Given ---
+		opt := WithFloatRelativeDelta(0.01)
+
+		// --- When ---
+		err := Equal(float32(100), float32(100.5), opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - float64", func(t *testing.T) {
+		// --- Given ---
+		opts := []Option{WithFloatRelativeDelta(0.01), WithTrail("type.field")}
+
+		// --- When ---
+		err := Equal(100.0, 80.0, opts...)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"           trail: type.field\n" +
+			"            want: 100\n" +
+			"            have: 80\n" +
+			"  want rel delta: 0.01\n" +
+			"  have rel delta: 0.2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - float32", func(t *testing.T) {
+		// --- Given ---
+		opt := WithFloatRelativeDelta(0.01)
+
+		// --- When ---
+		err := Equal(float32(100), float32(80), opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"            want: 100\n" +
+			"            have: 80\n" +
+			"  want rel delta: 0.01\n" +
+			"  have rel delta: 0.2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_Equal_strict_zero(t *testing.T) {
+	negZero := math.Copysign(0, -1)
+
+	t.Run("zeros are equal by default", func(t *testing.T) {
+		// --- When ---
+		err := Equal(0.0, negZero)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("same sign zeros", func(t *testing.T) {
+		// --- Given ---
+		val := []float64{0, negZero}
+
+		// --- When ---
+		err := Equal(val, []float64{0, negZero}, WithStrictZero)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - float64", func(t *testing.T) {
+		// --- Given ---
+		opts := []Option{WithStrictZero, WithTrail("type.field")}
+
+		// --- When ---
+		err := Equal(0.0, negZero, opts...)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"       trail: type.field\n" +
+			"        want: +0\n" +
+			"        have: -0\n" +
+			"  comparison: strict zero"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - float32", func(t *testing.T) {
+		// --- When ---
+		err := Equal(float32(negZero), float32(0), WithStrictZero)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"        want: -0\n" +
+			"        have: +0\n" +
+			"  comparison: strict zero"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - with relative delta", func(t *testing.T) {
+		// --- Given ---
+		opts := []Option{WithStrictZero, WithFloatRelativeDelta(0.1)}
+
+		// --- When ---
+		err := Equal(0.0, negZero, opts...)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+	})
+
+	t.Run("error - EqualT", func(t *testing.T) {
+		// --- When ---
+		err := EqualT(0.0, negZero, WithStrictZero)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"        want: +0\n" +
+			"        have: -0\n" +
+			"  comparison: strict zero"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - EqualT with default options", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { SetDefaultOptions() })
+		SetDefaultOptions(WithStrictZero)
+
+		// --- When ---
+		err := EqualT(0.0, negZero)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+	})
+}
+
+func Test_Equal_trail_dumper(t *testing.T) {
+	mask := func(_ dump.Dump, _ int, val reflect.Value) string {
+		return strings.Repeat("*", val.Len())
+	}
+
+	t.Run("field", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrailDumper("TIntStr.Str", mask)
+		want := types.TIntStr{Int: 1, Str: "abc"}
+		have := types.TIntStr{Int: 2, Str: "abcd"}
+
+		// --- When ---
+		err := Equal(want, have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"multiple expectations violated:\n" +
+			"  error: expected values to be equal\n" +
+			"  trail: TIntStr.Int\n" +
+			"   want: 1\n" +
+			"   have: 2\n" +
+			"      ---\n" +
+			"  error: expected values to be equal\n" +
+			"  trail: TIntStr.Str\n" +
+			"   want: ***\n" +
+			"   have: ****"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("other values of the same type are not affected", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrailDumper("T.A", mask)
+		type T struct{ A, B string }
+		want := T{A: "abc", B: "abc"}
+		have := T{A: "xyz", B: "xyz"}
+
+		// --- When ---
+		err := Equal(want, have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"multiple expectations violated:\n" +
+			"  error: expected values to be equal\n" +
+			"  trail: T.A\n" +
+			"   want: ***\n" +
+			"   have: ***\n" +
+			"      ---\n" +
+			"  error: expected values to be equal\n" +
+			"  trail: T.B\n" +
+			"   want: \"abc\"\n" +
+			"   have: \"xyz\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("slice with different length", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrailDumper("T.Ints", mask)
+		type T struct{ Ints []int }
+		want := T{Ints: []int{1, 2}}
+		have := T{Ints: []int{1, 2, 3}}
+
+		// --- When ---
+		err := Equal(want, have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"     trail: T.Ints\n" +
+			"  want len: 2\n" +
+			"  have len: 3\n" +
+			"      want: **\n" +
+			"      have: ***\n" +
+			"      diff: "
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_EqualT(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- When ---
+		err := EqualT(42, 42)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal structs", func(t *testing.T) {
+		// --- Given ---
+		want := types.TIntStr{Int: 42, Str: "abc"}
+		have := types.TIntStr{Int: 42, Str: "abc"}
+
+		// --- When ---
+		err := EqualT(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("pointers to equal values", func(t *testing.T) {
+		// --- When ---
+		err := EqualT(&types.TA{Int: 42}, &types.TA{Int: 42})
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal with options", func(t *testing.T) {
+		// --- When ---
+		err := EqualT("abc", "ABC", WithCaseInsensitive)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not comparable interface values", func(t *testing.T) {
+		// --- Given ---
+		var want, have any = []int{1, 2}, []int{1, 2}
+
+		// --- When ---
+		err := EqualT(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := EqualT(42, 44, WithTrail("type.field"))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"  trail: type.field\n" +
+			"   want: 42\n" +
+			"   have: 44"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - not comparable interface values", func(t *testing.T) {
+		// --- Given ---
+		var want, have any = []int{1, 2}, []int{1, 3}
+
+		// --- When ---
+		err := EqualT(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"  trail: <slice>[1]\n" +
+			"   want: 2\n" +
+			"   have: 3"
+		affirm.Equal(t, wMsg, err.Error())
+	})
 }
 
 func Test_Equal_invalid_arguments(t *testing.T) {
@@ -192,6 +1059,34 @@ func Test_Equal_not_matching_types(t *testing.T) {
 		affirm.Equal(t, wMsg, err.Error())
 		affirm.DeepEqual(t, []string{"type.field"}, trail)
 	})
+
+	t.Run("code", func(t *testing.T) {
+		// --- When ---
+		err := Equal(123, "abc")
+
+		// --- Then ---
+		affirm.Equal(t, CodeTypeMismatch, notice.CodeFrom(err))
+	})
+
+	t.Run("code for nested values", func(t *testing.T) {
+		// --- Given ---
+		want := map[string]any{"A": 1}
+		have := map[string]any{"A": "a"}
+
+		// --- When ---
+		err := Equal(want, have)
+
+		// --- Then ---
+		affirm.Equal(t, CodeTypeMismatch, notice.CodeFrom(err))
+	})
+
+	t.Run("no code for matching types", func(t *testing.T) {
+		// --- When ---
+		err := Equal(123, 124)
+
+		// --- Then ---
+		affirm.Equal(t, "", notice.CodeFrom(err))
+	})
 }
 
 func Test_Equal_custom_trail_checkers(t *testing.T) {
@@ -741,6 +1636,128 @@ func Test_Equal_kind_Struct(t *testing.T) {
 		affirm.DeepEqual(t, wTrail, trail)
 	})
 
+	t.Run("trail sink", func(t *testing.T) {
+		// --- Given ---
+		buf := &bytes.Buffer{}
+		opts := []Option{
+			WithTrailSink(buf),
+			WithSkipTrail("TNested.STAp[0]"),
+			WithSkipUnexported,
+		}
+
+		want := types.TNested{STAp: []*types.TA{{Int: 42}}}
+		have := types.TNested{STAp: []*types.TA{{Int: 44}}}
+
+		// --- When ---
+		err := Equal(want, have, opts...)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		wTrail := "" +
+			"TNested.SInt\n" +
+			"TNested.STA\n" +
+			"TNested.STAp[0] <skipped>\n" +
+			"TNested.MStrInt\n" +
+			"TNested.MStrTyp\n" +
+			"TNested.MIntTyp\n"
+		affirm.Equal(t, wTrail, buf.String())
+	})
+
+	t.Run("skip trail skips the subtree", func(t *testing.T) {
+		// --- Given ---
+		trail := make([]string, 0)
+		opts := []Option{
+			WithTrailLog(&trail),
+			WithSkipTrail("TNested.STAp[0]"),
+			WithSkipUnexported,
+		}
+
+		want := types.TNested{STAp: []*types.TA{{TAp: &types.TA{Int: 42}}}}
+		have := types.TNested{STAp: []*types.TA{{TAp: &types.TA{Int: 44}}}}
+
+		// --- When ---
+		err := Equal(want, have, opts...)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		wTrail := []string{
+			"TNested.SInt",
+			"TNested.STA",
+			"TNested.STAp[0] <skipped>",
+			"TNested.MStrInt",
+			"TNested.MStrTyp",
+			"TNested.MIntTyp",
+		}
+		affirm.DeepEqual(t, wTrail, trail)
+	})
+
+	t.Run("skip trail parent of the starting trail", func(t *testing.T) {
+		// --- Given ---
+		trail := make([]string, 0)
+		opts := []Option{
+			WithTrail("type.field"),
+			WithTrailLog(&trail),
+			WithSkipTrail("type"),
+		}
+
+		// --- When ---
+		err := Equal(types.TA{Int: 42}, types.TA{Int: 44}, opts...)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.DeepEqual(t, []string{"type.field <skipped>"}, trail)
+	})
+
+	t.Run("redacted field equal", func(t *testing.T) {
+		// --- Given ---
+		want := types.TIntStr{Str: "abc"}
+		have := types.TIntStr{Str: "abc"}
+		opts := []Option{WithDumper(dump.WithRedact("Str"))}
+
+		// --- When ---
+		err := Equal(want, have, opts...)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - redacted field not equal", func(t *testing.T) {
+		// --- Given ---
+		want := types.TIntStr{Str: "abc"}
+		have := types.TIntStr{Str: "xyz"}
+		opts := []Option{WithDumper(dump.WithRedact("Str"))}
+
+		// --- When ---
+		err := Equal(want, have, opts...)
+
+		// --- Then ---
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"  trail: TIntStr.Str\n" +
+			"   want: <redacted>\n" +
+			"   have: <redacted>"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - field redacted with tag not equal", func(t *testing.T) {
+		// --- Given ---
+		type T struct {
+			User string
+			Pass string `dump:"redact"`
+		}
+
+		// --- When ---
+		err := Equal(T{"u", "p1"}, T{"u", "p2"})
+
+		// --- Then ---
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"  trail: T.Pass\n" +
+			"   want: <redacted>\n" +
+			"   have: <redacted>"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
 	t.Run("error - private int fields not equal", func(t *testing.T) {
 		// --- Given ---
 		trail := make([]string, 0)
@@ -990,6 +2007,42 @@ func Test_Equal_kind_Slice_and_Array(t *testing.T) {
 		affirm.DeepEqual(t, []string{"<slice>[0]", "<slice>[1]"}, trail)
 	})
 
+	t.Run("error - byte slices of different length hexdump", func(t *testing.T) {
+		// --- Given ---
+		opts := []Option{WithDumper(dump.WithHexDump(2))}
+
+		want := []byte("abc")
+		have := []byte("abcd")
+
+		// --- When ---
+		err := Equal(want, have, opts...)
+
+		// --- Then ---
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"  want len: 3\n" +
+			"  have len: 4\n" +
+			"      want:\n" +
+			"            []uint8{\n" +
+			"              00000000  61 62 63" +
+			"                                          |abc|\n" +
+			"            }\n" +
+			"      have:\n" +
+			"            []uint8{\n" +
+			"              00000000  61 62 63 64" +
+			"                                       |abcd|\n" +
+			"            }\n" +
+			"      diff:\n" +
+			"            @@ -1,3 +1,3 @@\n" +
+			"             []uint8{\n" +
+			"            -  00000000  61 62 63 64" +
+			"                                       |abcd|\n" +
+			"            +  00000000  61 62 63" +
+			"                                          |abc|\n" +
+			"             }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
 	t.Run("not equal array value", func(t *testing.T) {
 		// --- Given ---
 		trail := make([]string, 0)
@@ -1094,6 +2147,60 @@ func Test_Equal_kind_Map(t *testing.T) {
 		affirm.DeepEqual(t, []string{"map[1]"}, trail)
 	})
 
+	t.Run("integer keys are visited in numeric order", func(t *testing.T) {
+		// --- Given ---
+		trail := make([]string, 0)
+		opts := []Option{WithTrailLog(&trail)}
+
+		want := map[int]int{10: 1, 2: 2, -1: 3}
+		have := map[int]int{10: 1, 2: 2, -1: 3}
+
+		// --- When ---
+		err := Equal(want, have, opts...)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.DeepEqual(t, []string{"map[-1]", "map[2]", "map[10]"}, trail)
+	})
+
+	t.Run("float keys are visited in numeric order", func(t *testing.T) {
+		// --- Given ---
+		trail := make([]string, 0)
+		opts := []Option{WithTrailLog(&trail)}
+
+		want := map[float64]int{10.5: 1, 2.5: 2}
+		have := map[float64]int{10.5: 1, 2.5: 2}
+
+		// --- When ---
+		err := Equal(want, have, opts...)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.DeepEqual(t, []string{"map[2.5]", "map[10.5]"}, trail)
+	})
+
+	t.Run("string keys are visited in lexical order", func(t *testing.T) {
+		// --- Given ---
+		trail := make([]string, 0)
+		opts := []Option{WithTrailLog(&trail)}
+
+		want := map[string]int{"2": 1, "10": 2, "b": 3, "a": 4}
+		have := map[string]int{"2": 1, "10": 2, "b": 3, "a": 4}
+
+		// --- When ---
+		err := Equal(want, have, opts...)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		wTrail := []string{
+			`map["10"]`,
+			`map["2"]`,
+			`map["a"]`,
+			`map["b"]`,
+		}
+		affirm.DeepEqual(t, wTrail, trail)
+	})
+
 	t.Run("equal same map", func(t *testing.T) {
 		// --- Given ---
 		trail := make([]string, 0)
@@ -1227,6 +2334,31 @@ func Test_Equal_kind_Map(t *testing.T) {
 		affirm.Equal(t, wMsg, err.Error())
 		affirm.DeepEqual(t, []string{"type.field[1]", "type.field[2]"}, trail)
 	})
+
+	t.Run("cyclic pointer keys", func(t *testing.T) {
+		// --- Given ---
+		type node struct {
+			Val  int
+			Next *node
+		}
+		a, b := &node{Val: 1}, &node{Val: 2}
+		a.Next, b.Next = b, a
+
+		want := map[*node]int{a: 1, b: 2}
+		have := map[*node]int{a: 1, b: 3}
+
+		// --- When ---
+		err := Equal(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"  trail: map[*check.node]\n" +
+			"   want: 2\n" +
+			"   have: 3"
+		affirm.Equal(t, wMsg, err.Error())
+	})
 }
 
 func Test_Equal_kind_Interface(t *testing.T) {
@@ -1694,6 +2826,41 @@ func Test_NotEqual(t *testing.T) {
 }
 
 func Test_equalError(t *testing.T) {
+	t.Run("values are dumped only when rendered", func(t *testing.T) {
+		// --- Given ---
+		var calls int
+		fn := func(_ dump.Dump, _ int, val reflect.Value) string {
+			calls++
+			return strconv.FormatInt(val.Int(), 10)
+		}
+		ops := DefaultOptions(WithDumper(dump.WithDumper(types.TIntType(0), fn)))
+		w, h := types.TIntType(1), types.TIntType(2)
+
+		// --- When ---
+		err := equalError(w, h, WithOptions(ops))
+
+		// --- Then ---
+		affirm.Equal(t, 0, calls)
+		wMsg := "expected values to be equal:\n" +
+			"  want: 1\n" +
+			"  have: 2"
+		affirm.Equal(t, wMsg, err.Error())
+		rendered := calls
+		affirm.Equal(t, true, rendered > 0)
+		affirm.Equal(t, wMsg, err.Error())
+		affirm.Equal(t, rendered, calls)
+	})
+
+	t.Run("raw values at the trail in metadata", func(t *testing.T) {
+		// --- When ---
+		err := Equal(map[string]int{"A": 1}, map[string]int{"A": 2})
+
+		// --- Then ---
+		meta := notice.MetaFrom(err)
+		wMeta := map[string]any{notice.MetaWant: 1, notice.MetaHave: 2}
+		affirm.DeepEqual(t, wMeta, meta)
+	})
+
 	t.Run("without trail", func(t *testing.T) {
 		// --- Given ---
 		ops := DefaultOptions()
@@ -1886,3 +3053,77 @@ func Test_dumpByte(t *testing.T) {
 		affirm.Equal(t, "      0x01", have)
 	})
 }
+
+func Test_getStructMeta(t *testing.T) {
+	t.Run("named struct", func(t *testing.T) {
+		// --- When ---
+		have := getStructMeta(reflect.TypeOf(types.TA{}))
+
+		// --- Then ---
+		affirm.Equal(t, "TA", have.name)
+		wFields := []string{"Int", "Str", "Tim", "Dur", "Loc", "TAp", "private"}
+		affirm.DeepEqual(t, wFields, have.fields)
+		affirm.Equal(t, "TA.Int", have.trails[0])
+		affirm.Equal(t, "TA.private", have.trails[6])
+		affirm.Equal(t, 7, len(have.redact))
+	})
+
+	t.Run("redacted with tag", func(t *testing.T) {
+		// --- Given ---
+		val := struct {
+			A int
+			B int `dump:"redact"`
+		}{}
+
+		// --- When ---
+		have := getStructMeta(reflect.TypeOf(val))
+
+		// --- Then ---
+		affirm.DeepEqual(t, []bool{false, true}, have.redact)
+	})
+
+	t.Run("anonymous struct", func(t *testing.T) {
+		// --- Given ---
+		val := struct{ A, B int }{}
+
+		// --- When ---
+		have := getStructMeta(reflect.TypeOf(val))
+
+		// --- Then ---
+		affirm.Equal(t, "", have.name)
+		affirm.DeepEqual(t, []string{"A", "B"}, have.fields)
+		affirm.DeepEqual(t, []string{"A", "B"}, have.trails)
+	})
+
+	t.Run("cached", func(t *testing.T) {
+		// --- Given ---
+		typ := reflect.TypeOf(types.TB{})
+
+		// --- When ---
+		have0 := getStructMeta(typ)
+		have1 := getStructMeta(typ)
+
+		// --- Then ---
+		affirm.Equal(t, true, have0 == have1)
+	})
+}
+
+func Test_structMeta_isRedacted(t *testing.T) {
+	// --- Given ---
+	val := struct {
+		A int
+		B int `dump:"redact"`
+		C int
+	}{}
+	meta := getStructMeta(reflect.TypeOf(val))
+
+	// --- When ---
+	have0 := meta.isRedacted(0, nil)
+	have1 := meta.isRedacted(1, nil)
+	have2 := meta.isRedacted(2, []string{"C"})
+
+	// --- Then ---
+	affirm.Equal(t, false, have0)
+	affirm.Equal(t, true, have1)
+	affirm.Equal(t, true, have2)
+}
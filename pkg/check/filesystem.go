@@ -4,6 +4,8 @@
 package check
 
 import (
+	"errors"
+	"io/fs"
 	"os"
 	"strings"
 
@@ -12,17 +14,18 @@ import (
 
 // FileExist checks "pth" points to an existing file. Returns an error if the
 // path points to a filesystem entry which is not a file or there is an error
-// when trying to check the path. On success, it returns nil.
+// when trying to check the path. On success, it returns nil. Use [WithFS]
+// option to check the path in a filesystem other than the OS one.
 func FileExist(pth string, opts ...Option) error {
-	inf, err := os.Lstat(pth)
+	inf, fn, err := lstat(optFS(opts), pth)
 	if err != nil {
 		ops := DefaultOptions(opts...)
-		if os.IsNotExist(err) {
+		if errors.Is(err, fs.ErrNotExist) {
 			return notice.New("expected path to an existing file").
 				SetTrail(ops.Trail).
 				Append("path", "%s", pth)
 		}
-		return notice.New("expected os.Lstat to succeed").
+		return notice.New("expected %s to succeed", fn).
 			SetTrail(ops.Trail).
 			Append("path", "%s", pth).
 			Append("error", "%s", err)
@@ -38,14 +41,15 @@ func FileExist(pth string, opts ...Option) error {
 
 // NoFileExist checks "pth" points to not existing file. Returns an error if
 // the path points to an existing filesystem entry. On success, it returns nil.
+// Use [WithFS] option to check the path in a filesystem other than the OS one.
 func NoFileExist(pth string, opts ...Option) error {
-	inf, err := os.Lstat(pth)
+	inf, fn, err := lstat(optFS(opts), pth)
 	if err != nil {
-		if os.IsNotExist(err) {
+		if errors.Is(err, fs.ErrNotExist) {
 			return nil
 		}
 		ops := DefaultOptions(opts...)
-		return notice.New("expected os.Lstat to succeed").
+		return notice.New("expected %s to succeed", fn).
 			SetTrail(ops.Trail).
 			Append("path", "%s", pth).
 			Append("error", "%s", err)
@@ -56,7 +60,6 @@ func NoFileExist(pth string, opts ...Option) error {
 			SetTrail(ops.Trail).
 			Append("path", "%s", pth)
 	}
-
 	ops := DefaultOptions(opts...)
 	return notice.New("expected path to not existing file").
 		SetTrail(ops.Trail).
@@ -73,8 +76,9 @@ type Content interface {
 // file or there is an error reading the file. The file is read in full then
 // [strings.Contains] is used to check it contains "want" string. When it fails
 // it returns an error with a message indicating the expected and actual values.
+// Use [WithFS] option to read the file from a filesystem other than the OS one.
 func FileContain[T Content](want T, pth string, opts ...Option) error {
-	content, err := os.ReadFile(pth)
+	content, err := readFile(optFS(opts), pth)
 	if err != nil {
 		ops := DefaultOptions(opts...)
 		return notice.New("expected no error reading file").
@@ -85,7 +89,6 @@ func FileContain[T Content](want T, pth string, opts ...Option) error {
 	if strings.Contains(string(content), string(want)) {
 		return nil
 	}
-
 	ops := DefaultOptions(opts...)
 	return notice.New("expected file to contain string").
 		SetTrail(ops.Trail).
@@ -93,22 +96,83 @@ func FileContain[T Content](want T, pth string, opts ...Option) error {
 		Want("%q", want)
 }
 
+// FileEqual checks file at "pth" can be read and its content is equal to
+// "want". It fails if the path points to a filesystem entry which is not a
+// file or there is an error reading the file. When it fails it returns an
+// error with a message indicating the expected and actual values, for
+// multiline content, the message includes the diff. Use [WithFS] option to
+// read the file from a filesystem other than the OS one.
+func FileEqual[T Content](want T, pth string, opts ...Option) error {
+	content, err := readFile(optFS(opts), pth)
+	if err != nil {
+		ops := DefaultOptions(opts...)
+		return notice.New("expected no error reading file").
+			SetTrail(ops.Trail).
+			Append("path", "%s", pth).
+			Append("error", "%s", err)
+	}
+	if string(content) == string(want) {
+		return nil
+	}
+
+	ops := DefaultOptions(opts...)
+	wStr, hStr, diff := ops.Dumper.Diff(string(want), string(content))
+	msg := notice.New("expected file content to be equal").
+		SetTrail(ops.Trail).
+		Append("path", "%s", pth).
+		Want("%s", wStr).
+		Have("%s", hStr)
+	if diff != "" {
+		_ = msg.Append("diff", "%s", diff)
+	}
+	return msg
+}
+
+// FileMode checks "pth" points to an existing filesystem entry with "want"
+// permission bits. Only the permission bits ([fs.ModePerm]) are compared.
+// When it fails it returns an error with a message indicating the expected
+// and actual values. Use [WithFS] option to check the path in a filesystem
+// other than the OS one.
+func FileMode(want fs.FileMode, pth string, opts ...Option) error {
+	inf, fn, err := lstat(optFS(opts), pth)
+	if err != nil {
+		ops := DefaultOptions(opts...)
+		if errors.Is(err, fs.ErrNotExist) {
+			return notice.New("expected path to an existing entry").
+				SetTrail(ops.Trail).
+				Append("path", "%s", pth)
+		}
+		return notice.New("expected %s to succeed", fn).
+			SetTrail(ops.Trail).
+			Append("path", "%s", pth).
+			Append("error", "%s", err)
+	}
+	if inf.Mode().Perm() == want.Perm() {
+		return nil
+	}
+	ops := DefaultOptions(opts...)
+	return notice.New("expected path to have permissions").
+		SetTrail(ops.Trail).
+		Append("path", "%s", pth).
+		Want("%s", want.Perm()).
+		Have("%s", inf.Mode().Perm())
+}
+
 // DirExist checks "pth" points to an existing directory. It fails if the path
 // points to a filesystem entry which is not a directory or there is an error
 // when trying to check the path. When it fails it returns an error with a
-// detailed message indicating the expected and actual values.
+// detailed message indicating the expected and actual values. Use [WithFS]
+// option to check the path in a filesystem other than the OS one.
 func DirExist(pth string, opts ...Option) error {
-	inf, err := os.Lstat(pth)
+	inf, fn, err := lstat(optFS(opts), pth)
 	if err != nil {
-		if os.IsNotExist(err) {
-			ops := DefaultOptions(opts...)
+		ops := DefaultOptions(opts...)
+		if errors.Is(err, fs.ErrNotExist) {
 			return notice.New("expected path to an existing directory").
 				SetTrail(ops.Trail).
 				Append("path", "%s", pth)
 		}
-
-		ops := DefaultOptions(opts...)
-		return notice.New("expected os.Lstat to succeed").
+		return notice.New("expected %s to succeed", fn).
 			SetTrail(ops.Trail).
 			Append("path", "%s", pth).
 			Append("error", "%s", err)
@@ -125,15 +189,15 @@ func DirExist(pth string, opts ...Option) error {
 // NoDirExist checks "pth" points to not existing directory. It fails if the
 // path points to an existing filesystem entry. When it fails it returns an
 // error with a detailed message indicating the expected and actual values.
+// Use [WithFS] option to check the path in a filesystem other than the OS one.
 func NoDirExist(pth string, opts ...Option) error {
-	inf, err := os.Lstat(pth)
+	inf, fn, err := lstat(optFS(opts), pth)
 	if err != nil {
-		if os.IsNotExist(err) {
+		if errors.Is(err, fs.ErrNotExist) {
 			return nil
 		}
-
 		ops := DefaultOptions(opts...)
-		return notice.New("expected os.Lstat to succeed").
+		return notice.New("expected %s to succeed", fn).
 			SetTrail(ops.Trail).
 			Append("path", "%s", pth).
 			Append("error", "%s", err)
@@ -144,9 +208,38 @@ func NoDirExist(pth string, opts ...Option) error {
 			SetTrail(ops.Trail).
 			Append("path", "%s", pth)
 	}
-
 	ops := DefaultOptions(opts...)
 	return notice.New("expected path to not existing directory").
 		SetTrail(ops.Trail).
 		Append("path", "%s", pth)
 }
+
+// optFS returns the filesystem set with the [WithFS] option or nil when none
+// was set. When there are no options, it returns nil without building them.
+func optFS(opts []Option) fs.FS {
+	if len(opts) == 0 && len(defaultOpts) == 0 {
+		return nil
+	}
+	return DefaultOptions(opts...).FS
+}
+
+// lstat returns [fs.FileInfo] describing the "pth". When "fsys" is nil the
+// [os.Lstat] is used, otherwise the [fs.Stat]. The second return value is the
+// name of the function used.
+func lstat(fsys fs.FS, pth string) (fs.FileInfo, string, error) {
+	if fsys == nil {
+		inf, err := os.Lstat(pth)
+		return inf, "os.Lstat", err
+	}
+	inf, err := fs.Stat(fsys, pth)
+	return inf, "fs.Stat", err
+}
+
+// readFile reads the file at "pth". When "fsys" is nil the [os.ReadFile] is
+// used, otherwise the [fs.ReadFile].
+func readFile(fsys fs.FS, pth string) ([]byte, error) {
+	if fsys == nil {
+		return os.ReadFile(pth)
+	}
+	return fs.ReadFile(fsys, pth)
+}
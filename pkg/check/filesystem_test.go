@@ -4,9 +4,14 @@
 package check
 
 import (
+	"io/fs"
+	"reflect"
 	"testing"
+	"testing/fstest"
 
 	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/internal/types"
+	"github.com/ctx42/testing/pkg/dump"
 )
 
 func Test_FileExist(t *testing.T) {
@@ -47,6 +52,31 @@ func Test_FileExist(t *testing.T) {
 			"   path: testdata/dir"
 		affirm.Equal(t, wMsg, err.Error())
 	})
+
+	t.Run("exists in fs.FS", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{"dir/file.txt": &fstest.MapFile{}}
+
+		// --- When ---
+		err := FileExist("dir/file.txt", WithFS(fsys))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - does not exist in fs.FS", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{"dir/file.txt": &fstest.MapFile{}}
+
+		// --- When ---
+		err := FileExist("file.txt", WithFS(fsys))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected path to an existing file:\n" +
+			"  path: file.txt"
+		affirm.Equal(t, wMsg, err.Error())
+	})
 }
 
 func Test_NoFileExist(t *testing.T) {
@@ -83,6 +113,17 @@ func Test_NoFileExist(t *testing.T) {
 			"   path: testdata/dir"
 		affirm.Equal(t, wMsg, err.Error())
 	})
+
+	t.Run("does not exist in fs.FS", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{"dir/file.txt": &fstest.MapFile{}}
+
+		// --- When ---
+		err := NoFileExist("file.txt", WithFS(fsys))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
 }
 
 func Test_FileContain(t *testing.T) {
@@ -157,6 +198,152 @@ func Test_FileContain(t *testing.T) {
 			"  error: read testdata/dir: is a directory"
 		affirm.Equal(t, wMsg, err.Error())
 	})
+
+	t.Run("contains string in fs.FS", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{"file.txt": &fstest.MapFile{Data: []byte("abc")}}
+
+		// --- When ---
+		err := FileContain("b", "file.txt", WithFS(fsys))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+}
+
+func Test_FileEqual(t *testing.T) {
+	t.Run("equal string", func(t *testing.T) {
+		// --- When ---
+		err := FileEqual("abc def ghi\njkl mno pqr", "testdata/file.txt")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal byte slice", func(t *testing.T) {
+		// --- Given ---
+		want := []byte("abc def ghi\njkl mno pqr")
+
+		// --- When ---
+		err := FileEqual(want, "testdata/file.txt")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal in fs.FS", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{"file.txt": &fstest.MapFile{Data: []byte("abc")}}
+
+		// --- When ---
+		err := FileEqual("abc", "file.txt", WithFS(fsys))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - not equal single line", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{"file.txt": &fstest.MapFile{Data: []byte("abc")}}
+
+		// --- When ---
+		err := FileEqual("xyz", "file.txt", WithFS(fsys))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected file content to be equal:\n" +
+			"  path: file.txt\n" +
+			"  want: \"xyz\"\n" +
+			"  have: \"abc\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - not equal multi line", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := FileEqual("abc def ghi\njkl", "testdata/file.txt", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected file content to be equal:\n" +
+			"  trail: type.field\n" +
+			"   path: testdata/file.txt\n" +
+			"   want: \"abc def ghi\\njkl\"\n" +
+			"   have: \"abc def ghi\\njkl mno pqr\"\n" +
+			"   diff:\n" +
+			"         @@ -1,2 +1,2 @@\n" +
+			"          abc def ghi\n" +
+			"         -jkl mno pqr\n" +
+			"         +jkl"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - file does not exist", func(t *testing.T) {
+		// --- When ---
+		err := FileEqual("abc", "testdata/not_existing.txt")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected no error reading file:\n" +
+			"   path: testdata/not_existing.txt\n" +
+			"  error: open testdata/not_existing.txt: no such file or directory"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_FileMode(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{"file.txt": &fstest.MapFile{Mode: 0640}}
+
+		// --- When ---
+		err := FileMode(0640, "file.txt", WithFS(fsys))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("only permission bits are compared", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{"dir": &fstest.MapFile{Mode: fs.ModeDir | 0750}}
+
+		// --- When ---
+		err := FileMode(0750, "dir", WithFS(fsys))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - different permissions", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{"file.txt": &fstest.MapFile{Mode: 0644}}
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := FileMode(0600, "file.txt", WithFS(fsys), opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected path to have permissions:\n" +
+			"  trail: type.field\n" +
+			"   path: file.txt\n" +
+			"   want: -rw-------\n" +
+			"   have: -rw-r--r--"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - does not exist", func(t *testing.T) {
+		// --- When ---
+		err := FileMode(0600, "testdata/not_existing.txt")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected path to an existing entry:\n" +
+			"  path: testdata/not_existing.txt"
+		affirm.Equal(t, wMsg, err.Error())
+	})
 }
 
 func Test_DirExist(t *testing.T) {
@@ -197,6 +384,17 @@ func Test_DirExist(t *testing.T) {
 			"   path: testdata/file.txt"
 		affirm.Equal(t, wMsg, err.Error())
 	})
+
+	t.Run("exists in fs.FS", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{"dir/file.txt": &fstest.MapFile{}}
+
+		// --- When ---
+		err := DirExist("dir", WithFS(fsys))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
 }
 
 func Test_NoDirExist(t *testing.T) {
@@ -237,4 +435,86 @@ func Test_NoDirExist(t *testing.T) {
 			"   path: testdata/file.txt"
 		affirm.Equal(t, wMsg, err.Error())
 	})
+
+	t.Run("does not exist in fs.FS", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{"dir/file.txt": &fstest.MapFile{}}
+
+		// --- When ---
+		err := NoDirExist("other", WithFS(fsys))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+}
+
+func Test_optFS(t *testing.T) {
+	t.Run("no options", func(t *testing.T) {
+		// --- When ---
+		have := optFS(nil)
+
+		// --- Then ---
+		affirm.Nil(t, have)
+	})
+
+	t.Run("with FS", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{"file.txt": &fstest.MapFile{}}
+
+		// --- When ---
+		have := optFS([]Option{WithTrail("type.field"), WithFS(fsys)})
+
+		// --- Then ---
+		affirm.DeepEqual(t, fs.FS(fsys), have)
+	})
+
+	t.Run("with options writing to maps", func(t *testing.T) {
+		// --- Given ---
+		fn := func(dump.Dump, int, reflect.Value) string { return "" }
+		opt := WithDumper(dump.WithDumper(types.TIntStr{}, fn))
+
+		// --- When ---
+		have := optFS([]Option{opt})
+
+		// --- Then ---
+		affirm.Nil(t, have)
+	})
+
+	t.Run("with default FS", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { SetDefaultOptions() })
+		fsys := fstest.MapFS{"file.txt": &fstest.MapFile{}}
+		SetDefaultOptions(WithFS(fsys))
+
+		// --- When ---
+		have := optFS(nil)
+
+		// --- Then ---
+		affirm.DeepEqual(t, fs.FS(fsys), have)
+	})
+}
+
+func Test_lstat(t *testing.T) {
+	t.Run("os", func(t *testing.T) {
+		// --- When ---
+		inf, fn, err := lstat(nil, "testdata/file.txt")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, "os.Lstat", fn)
+		affirm.Equal(t, "file.txt", inf.Name())
+	})
+
+	t.Run("fs.FS", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{"dir/file.txt": &fstest.MapFile{}}
+
+		// --- When ---
+		inf, fn, err := lstat(fsys, "dir/file.txt")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, "fs.Stat", fn)
+		affirm.Equal(t, "file.txt", inf.Name())
+	})
 }
@@ -18,6 +18,7 @@ var (
 	typTime    = reflect.TypeOf(time.Time{})
 	typZone    = reflect.TypeOf(time.Location{})
 	typZonePtr = reflect.TypeOf(&time.Location{})
+	typDur     = reflect.TypeOf(time.Duration(0))
 	typByte    = reflect.TypeOf(byte(0))
 )
 
@@ -95,3 +96,12 @@ func valToString(key reflect.Value) string {
 		return "<invalid>"
 	}
 }
+
+// joinInts returns a comma-separated list of integers.
+func joinInts(ints []int) string {
+	strs := make([]string, len(ints))
+	for i, v := range ints {
+		strs[i] = strconv.Itoa(v)
+	}
+	return strings.Join(strs, ", ")
+}
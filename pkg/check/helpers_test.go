@@ -120,3 +120,9 @@ func Test_valToString_tabular(t *testing.T) {
 		})
 	}
 }
+
+func Test_joinInts(t *testing.T) {
+	affirm.Equal(t, "", joinInts(nil))
+	affirm.Equal(t, "1", joinInts([]int{1}))
+	affirm.Equal(t, "1, 2, 3", joinInts([]int{1, 2, 3}))
+}
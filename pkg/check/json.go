@@ -5,10 +5,18 @@ package check
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/ctx42/testing/pkg/notice"
 )
 
+// CodeJSONParse is the [notice.Notice] code used when a JSON document cannot
+// be parsed. See [notice.CodeFrom].
+const CodeJSONParse = "json.parse-error"
+
 // JSON checks that two JSON strings are equivalent. Returns nil if they are,
 // otherwise it returns an error with a message indicating the expected and
 // actual values.
@@ -22,15 +30,19 @@ func JSON(want, have string, opts ...Option) error {
 	ops := DefaultOptions(opts...)
 	if err := json.Unmarshal([]byte(want), &wantItf); err != nil {
 		return notice.New("did not expect the unmarshalling error").
+			Code(CodeJSONParse).
 			SetTrail(ops.Trail).
 			Append("argument", "want").
-			Append("error", "%s", err)
+			Append("error", "%s", err).
+			Cause(err)
 	}
 	if err := json.Unmarshal([]byte(have), &haveItf); err != nil {
 		return notice.New("did not expect the unmarshalling error").
+			Code(CodeJSONParse).
 			SetTrail(ops.Trail).
 			Append("argument", "have").
-			Append("error", "%s", err)
+			Append("error", "%s", err).
+			Cause(err)
 	}
 
 	if err := Equal(wantItf, haveItf, WithOptions(ops)); err != nil {
@@ -43,3 +55,193 @@ func JSON(want, have string, opts ...Option) error {
 	}
 	return nil
 }
+
+// JSONPath checks the value at "path" in "jsonDoc" is equal to "want". Returns
+// nil if it is, otherwise it returns an error with a message indicating the
+// expected and actual values.
+//
+// The "jsonDoc" may be a JSON string, a byte slice or an already decoded JSON
+// document (for example map[string]any). The "want" is converted to its
+// decoded JSON representation before it is compared with the [Equal] checker,
+// so numbers may be given as any numeric type and structs are compared using
+// their JSON form.
+//
+// The path is a list of object keys separated by dots, with array indexes and
+// keys containing special characters given in square brackets. The path may
+// start with the "$" representing the document root.
+//
+// Example:
+//
+//	check.JSONPath(`$.users[0].name`, "Bob", doc)
+//	check.JSONPath(`meta["content-type"]`, "text/plain", doc)
+func JSONPath(path string, want, jsonDoc any, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+
+	var doc any
+	switch val := jsonDoc.(type) {
+	case string:
+		if err := json.Unmarshal([]byte(val), &doc); err != nil {
+			return notice.New("did not expect the unmarshalling error").
+				Code(CodeJSONParse).
+				SetTrail(ops.Trail).
+				Append("argument", "jsonDoc").
+				Append("error", "%s", err).
+				Cause(err)
+		}
+	case []byte:
+		if err := json.Unmarshal(val, &doc); err != nil {
+			return notice.New("did not expect the unmarshalling error").
+				Code(CodeJSONParse).
+				SetTrail(ops.Trail).
+				Append("argument", "jsonDoc").
+				Append("error", "%s", err).
+				Cause(err)
+		}
+	default:
+		doc = jsonDoc
+	}
+
+	segs, err := parseJSONPath(path)
+	if err != nil {
+		return notice.New("expected valid JSON path").
+			SetTrail(ops.Trail).
+			Append("path", "%s", path).
+			Append("error", "%s", err)
+	}
+
+	have, found := jsonPathLookup(doc, segs)
+	if found != len(segs) {
+		return notice.New("expected JSON path to exist").
+			SetTrail(ops.Trail).
+			Append("path", "%s", path).
+			Append("missing", "%s", jsonPathString(segs[:found+1]))
+	}
+
+	var wantItf any
+	data, err := json.Marshal(want)
+	if err == nil {
+		err = json.Unmarshal(data, &wantItf)
+	}
+	if err != nil {
+		return notice.New("did not expect the marshalling error").
+			SetTrail(ops.Trail).
+			Append("argument", "want").
+			Append("error", "%s", err)
+	}
+
+	if e := Equal(wantItf, have, WithOptions(ops)); e != nil {
+		return notice.From(e).
+			SetHeader("expected JSON path value to be equal").
+			Prepend("path", "%s", path)
+	}
+	return nil
+}
+
+// jsonPathSeg represents a JSON path segment.
+type jsonPathSeg struct {
+	key   string // Object key.
+	idx   int    // Array index.
+	isIdx bool   // True when the segment represents array index.
+}
+
+// parseJSONPath parses JSON path.
+func parseJSONPath(path string) ([]jsonPathSeg, error) {
+	pth := strings.TrimPrefix(path, "$")
+	if pth == "" {
+		return nil, nil
+	}
+
+	var segs []jsonPathSeg
+	first := len(pth) == len(path)
+	for pth != "" {
+		switch {
+		case pth[0] == '[':
+			end := strings.IndexByte(pth, ']')
+			if end == -1 {
+				return nil, errors.New("unterminated bracket")
+			}
+			seg, err := parseJSONPathBracket(pth[1:end])
+			if err != nil {
+				return nil, err
+			}
+			segs = append(segs, seg)
+			pth = pth[end+1:]
+
+		case pth[0] == '.' || first:
+			if pth[0] == '.' {
+				pth = pth[1:]
+			}
+			end := strings.IndexAny(pth, ".[")
+			if end == -1 {
+				end = len(pth)
+			}
+			if end == 0 {
+				return nil, errors.New("empty key")
+			}
+			segs = append(segs, jsonPathSeg{key: pth[:end]})
+			pth = pth[end:]
+
+		default:
+			return nil, fmt.Errorf("unexpected character %q", pth[0])
+		}
+		first = false
+	}
+	return segs, nil
+}
+
+// parseJSONPathBracket parses JSON path segment given in square brackets.
+func parseJSONPathBracket(seg string) (jsonPathSeg, error) {
+	if len(seg) >= 2 && (seg[0] == '"' || seg[0] == '\'') {
+		if seg[len(seg)-1] != seg[0] {
+			return jsonPathSeg{}, errors.New("unterminated quoted key")
+		}
+		return jsonPathSeg{key: seg[1 : len(seg)-1]}, nil
+	}
+	idx, err := strconv.Atoi(seg)
+	if err != nil || idx < 0 {
+		return jsonPathSeg{}, fmt.Errorf("invalid array index %q", seg)
+	}
+	return jsonPathSeg{idx: idx, isIdx: true}, nil
+}
+
+// jsonPathLookup returns the value at path represented by "segs" in the
+// decoded JSON document. The second return value is the number of segments
+// found, it is equal to len(segs) when the value was found.
+func jsonPathLookup(doc any, segs []jsonPathSeg) (any, int) {
+	cur := doc
+	for i, seg := range segs {
+		if seg.isIdx {
+			arr, ok := cur.([]any)
+			if !ok || seg.idx >= len(arr) {
+				return nil, i
+			}
+			cur = arr[seg.idx]
+			continue
+		}
+		obj, ok := cur.(map[string]any)
+		if !ok {
+			return nil, i
+		}
+		if cur, ok = obj[seg.key]; !ok {
+			return nil, i
+		}
+	}
+	return cur, len(segs)
+}
+
+// jsonPathString returns string representation of JSON path segments.
+func jsonPathString(segs []jsonPathSeg) string {
+	var buf strings.Builder
+	buf.WriteString("$")
+	for _, seg := range segs {
+		switch {
+		case seg.isIdx:
+			buf.WriteString("[" + strconv.Itoa(seg.idx) + "]")
+		case strings.ContainsAny(seg.key, ".[]\"'") || seg.key == "":
+			buf.WriteString("[" + strconv.Quote(seg.key) + "]")
+		default:
+			buf.WriteString("." + seg.key)
+		}
+	}
+	return buf.String()
+}
@@ -4,9 +4,12 @@
 package check
 
 import (
+	"encoding/json"
+	"errors"
 	"testing"
 
 	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/notice"
 )
 
 func Test_JSON(t *testing.T) {
@@ -79,4 +82,223 @@ func Test_JSON(t *testing.T) {
 			"object key string"
 		affirm.Equal(t, wMsg, err.Error())
 	})
+
+	t.Run("unmarshalling error is the cause", func(t *testing.T) {
+		// --- When ---
+		err := JSON(`{"hello": "world"}`, `{!!!}`)
+
+		// --- Then ---
+		var e *json.SyntaxError
+		affirm.Equal(t, true, errors.As(err, &e))
+		affirm.Equal(t, true, errors.Is(err, notice.ErrNotice))
+		affirm.Equal(t, CodeJSONParse, notice.CodeFrom(err))
+	})
+}
+
+func Test_JSONPath(t *testing.T) {
+	doc := `{
+		"users": [
+			{"name": "Alice", "age": 42, "tags": ["a", "b"]},
+			{"name": "Bob", "age": 44, "meta": {"content-type": "text"}}
+		],
+		"total": 2
+	}`
+
+	t.Run("string document", func(t *testing.T) {
+		// --- When ---
+		err := JSONPath("users[1].name", "Bob", doc)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("byte slice document", func(t *testing.T) {
+		// --- When ---
+		err := JSONPath("$.users[0].age", 42, []byte(doc))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("decoded document", func(t *testing.T) {
+		// --- Given ---
+		decoded := map[string]any{"a": map[string]any{"b": 1.0}}
+
+		// --- When ---
+		err := JSONPath("a.b", 1, decoded)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("root", func(t *testing.T) {
+		// --- When ---
+		err := JSONPath("$", map[string]int{"a": 1}, `{"a": 1}`)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("array value", func(t *testing.T) {
+		// --- When ---
+		err := JSONPath("users[0].tags", []string{"a", "b"}, doc)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("quoted key", func(t *testing.T) {
+		// --- When ---
+		err := JSONPath(`users[1].meta["content-type"]`, "text", doc)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - not equal", func(t *testing.T) {
+		// --- When ---
+		err := JSONPath("users[1].name", "Alice", doc)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected JSON path value to be equal:\n" +
+			"  path: users[1].name\n" +
+			"  want: \"Alice\"\n" +
+			"  have: \"Bob\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - path does not exist", func(t *testing.T) {
+		// --- When ---
+		err := JSONPath("users[2].name", "Alice", doc)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected JSON path to exist:\n" +
+			"     path: users[2].name\n" +
+			"  missing: $.users[2]"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - invalid path", func(t *testing.T) {
+		// --- When ---
+		err := JSONPath("users[a]", "Alice", doc)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected valid JSON path:\n" +
+			"   path: users[a]\n" +
+			"  error: invalid array index \"a\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - invalid document", func(t *testing.T) {
+		// --- When ---
+		err := JSONPath("a", "Alice", `{!!!}`)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"did not expect the unmarshalling error:\n" +
+			"  argument: jsonDoc\n" +
+			"     error: invalid character '!' looking for beginning of " +
+			"object key string"
+		affirm.Equal(t, wMsg, err.Error())
+		var e *json.SyntaxError
+		affirm.Equal(t, true, errors.As(err, &e))
+		affirm.Equal(t, CodeJSONParse, notice.CodeFrom(err))
+	})
+
+	t.Run("error - want cannot be marshalled", func(t *testing.T) {
+		// --- When ---
+		err := JSONPath("total", make(chan int), doc)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"did not expect the marshalling error:\n" +
+			"  argument: want\n" +
+			"     error: json: unsupported type: chan int"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := JSONPath("total", 3, doc, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected JSON path value to be equal:\n" +
+			"  trail: type.field\n" +
+			"   path: total\n" +
+			"   want: 3\n" +
+			"   have: 2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_parseJSONPath_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		path string
+		want string
+	}{
+		{"empty", "", "$"},
+		{"root", "$", "$"},
+		{"key", "a", "$.a"},
+		{"root key", "$.a", "$.a"},
+		{"leading dot", ".a", "$.a"},
+		{"nested", "a.b.c", "$.a.b.c"},
+		{"index", "a[1]", "$.a[1]"},
+		{"root index", "$[1]", "$[1]"},
+		{"nested index", "a[1][2].b", "$.a[1][2].b"},
+		{"double quoted key", `a["b.c"]`, `$.a["b.c"]`},
+		{"single quoted key", `a['b']`, `$.a.b`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := parseJSONPath(tc.path)
+
+			// --- Then ---
+			affirm.Nil(t, err)
+			affirm.Equal(t, tc.want, jsonPathString(have))
+		})
+	}
+}
+
+func Test_parseJSONPath_error_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		path string
+		want string
+	}{
+		{"unterminated bracket", "a[1", "unterminated bracket"},
+		{"empty key", "a..b", "empty key"},
+		{"negative index", "a[-1]", `invalid array index "-1"`},
+		{"unterminated quote", `a["b]`, "unterminated quoted key"},
+		{"unexpected character", "a[0]b", `unexpected character 'b'`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := parseJSONPath(tc.path)
+
+			// --- Then ---
+			affirm.NotNil(t, err)
+			affirm.Equal(t, tc.want, err.Error())
+			affirm.Nil(t, have)
+		})
+	}
 }
@@ -4,9 +4,12 @@
 package check
 
 import (
+	"cmp"
 	"fmt"
 	"math"
+	"reflect"
 	"strconv"
+	"strings"
 
 	"github.com/ctx42/testing/internal/constraints"
 	"github.com/ctx42/testing/pkg/notice"
@@ -15,6 +18,8 @@ import (
 // Greater checks the "want" value is greater than the "have" value. Returns
 // nil if the condition is met, otherwise it returns an error with a message
 // indicating the expected and actual values.
+//
+//	want > have
 func Greater[T constraints.Ordered](want, have T, opts ...Option) error {
 	if want > have {
 		return nil
@@ -29,6 +34,8 @@ func Greater[T constraints.Ordered](want, have T, opts ...Option) error {
 // GreaterOrEqual checks the "want" value is greater or equal than the "have"
 // value. Returns nil if the condition is met, otherwise it returns an error
 // with a message indicating the expected and actual values.
+//
+//	want >= have
 func GreaterOrEqual[T constraints.Ordered](want, have T, opts ...Option) error {
 	if want >= have {
 		return nil
@@ -43,6 +50,8 @@ func GreaterOrEqual[T constraints.Ordered](want, have T, opts ...Option) error {
 // Smaller checks the "want" value is smaller than the "have" value. Returns
 // nil if the condition is met, otherwise it returns an error with a message
 // indicating the expected and actual values.
+//
+//	want < have
 func Smaller[T constraints.Ordered](want, have T, opts ...Option) error {
 	if want < have {
 		return nil
@@ -57,6 +66,8 @@ func Smaller[T constraints.Ordered](want, have T, opts ...Option) error {
 // SmallerOrEqual checks the "want" value is smaller or equal than the "have"
 // value. Returns nil if the condition is met, otherwise it returns an error
 // with a message indicating the expected and actual values.
+//
+//	want <= have
 func SmallerOrEqual[T constraints.Ordered](want, have T, opts ...Option) error {
 	if want <= have {
 		return nil
@@ -70,7 +81,8 @@ func SmallerOrEqual[T constraints.Ordered](want, have T, opts ...Option) error {
 
 // Delta checks the both values are within the given delta. Returns nil if they
 // are, otherwise it returns an error with a message indicating the expected
-// and actual values.
+// and actual values. Use the [WithFloatRelativeDelta] option to also accept
+// values within the relative delta.
 //
 //	|w-h| <= delta
 func Delta[T, E constraints.Number](
@@ -87,17 +99,51 @@ func Delta[T, E constraints.Number](
 	}
 
 	ops := DefaultOptions(opts...)
+	if withinRelDelta(fWant, fHave, ops.FloatRelativeDelta) {
+		return nil
+	}
 
 	wantFmt := strconv.FormatFloat(fWant, 'f', -1, 64)
 	haveFmt := strconv.FormatFloat(fHave, 'f', -1, 64)
 	wDeltaFmt := strconv.FormatFloat(fwDelta, 'f', -1, 64)
 	hDeltaFmt := strconv.FormatFloat(fhDelta, 'f', -1, 64)
-	return notice.New("expected numbers to be within the given delta").
+	msg := notice.New("expected numbers to be within the given delta").
 		SetTrail(ops.Trail).
 		Want("%s", wantFmt).
 		Have("%s", haveFmt).
 		Append("want delta", "%s", wDeltaFmt).
 		Append("have delta", "%s", hDeltaFmt)
+	return relDeltaRows(msg, fWant, fHave, ops.FloatRelativeDelta)
+}
+
+// relDelta returns the relative difference between two numbers:
+//
+//	|w-h| / max(|w|, |h|)
+func relDelta(want, have float64) float64 {
+	diff := math.Abs(want - have)
+	if diff == 0 {
+		return 0
+	}
+	return diff / math.Max(math.Abs(want), math.Abs(have))
+}
+
+// withinRelDelta returns true when the relative difference between two
+// numbers is within the "rel" delta. Returns false when "rel" is not positive.
+func withinRelDelta(want, have, rel float64) bool {
+	return rel > 0 && relDelta(want, have) <= rel
+}
+
+// relDeltaRows appends to the message rows describing the relative delta
+// tolerance when the "rel" delta is positive.
+func relDeltaRows(msg *notice.Notice, want, have, rel float64) *notice.Notice {
+	if rel <= 0 {
+		return msg
+	}
+	wRelFmt := strconv.FormatFloat(rel, 'f', -1, 64)
+	hRelFmt := strconv.FormatFloat(relDelta(want, have), 'f', -1, 64)
+	return msg.
+		Append("want rel delta", "%s", wRelFmt).
+		Append("have rel delta", "%s", hRelFmt)
 }
 
 // DeltaSlice checks values are within the given delta for all respective
@@ -301,3 +347,180 @@ func NotDecreasing[T constraints.Ordered](seq []T, opts ...Option) error {
 	return notice.New("expected a not decreasing sequence").
 		Append("mode", "%s", mode)
 }
+
+// Between checks the "have" value is within the closed range of "minimum"
+// and "maximum" values. The arguments may be of any integer, unsigned integer
+// or float kinds, also mixed. Returns nil if the condition is met, otherwise
+// it returns an error with a message indicating the expected and actual
+// values.
+//
+//	minimum <= have <= maximum
+func Between(minimum, maximum, have any, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	cMin, okMin := numCmp(have, minimum)
+	cMax, okMax := numCmp(have, maximum)
+	if !okMin || !okMax {
+		return numArgsError(ops, minimum, maximum, have)
+	}
+	if cMin >= 0 && cMax <= 0 {
+		return nil
+	}
+	return notice.New("expected value to be within range").
+		SetTrail(ops.Trail).
+		Append("min", "%v", minimum).
+		Append("max", "%v", maximum).
+		Have("%v", have)
+}
+
+// GreaterThan checks the "have" value is greater than the "limit" value. The
+// arguments may be of any integer, unsigned integer or float kinds, also mixed.
+// Returns nil if the condition is met, otherwise it returns an error with a
+// message indicating the expected and actual values.
+//
+// Unlike the generic [Greater] and [Smaller] family, which checks the "want"
+// value against the "have" value, the reflection-based [Between],
+// [GreaterThan] and [LessOrEqual] check the "have" value against the limits.
+// For values of the same type, GreaterThan(limit, have) is the same as
+// Smaller(limit, have).
+//
+//	have > limit
+func GreaterThan(limit, have any, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	c, ok := numCmp(have, limit)
+	if !ok {
+		return numArgsError(ops, limit, have)
+	}
+	if c > 0 {
+		return nil
+	}
+	return notice.New("expected value to be greater").
+		SetTrail(ops.Trail).
+		Append("greater than", "%v", limit).
+		Have("%v", have)
+}
+
+// LessOrEqual checks the "have" value is less or equal to the "limit" value.
+// The arguments may be of any integer, unsigned integer or float kinds, also
+// mixed. Returns nil if the condition is met, otherwise it returns an error
+// with a message indicating the expected and actual values.
+//
+// For values of the same type, LessOrEqual(limit, have) is the same as
+// GreaterOrEqual(limit, have) (see [GreaterThan]).
+//
+//	have <= limit
+func LessOrEqual(limit, have any, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	c, ok := numCmp(have, limit)
+	if !ok {
+		return numArgsError(ops, limit, have)
+	}
+	if c <= 0 {
+		return nil
+	}
+	return notice.New("expected value to be smaller or equal").
+		SetTrail(ops.Trail).
+		Append("smaller or equal than", "%v", limit).
+		Have("%v", have)
+}
+
+// Positive checks the "have" value is greater than zero. Returns nil if it is,
+// otherwise it returns an error with a message indicating the actual value.
+func Positive[T constraints.Number](have T, opts ...Option) error {
+	if have > 0 {
+		return nil
+	}
+	ops := DefaultOptions(opts...)
+	return notice.New("expected value to be positive").
+		SetTrail(ops.Trail).
+		Have("%v", have)
+}
+
+// Negative checks the "have" value is less than zero. Returns nil if it is,
+// otherwise it returns an error with a message indicating the actual value.
+func Negative[T constraints.Number](have T, opts ...Option) error {
+	if have < 0 {
+		return nil
+	}
+	ops := DefaultOptions(opts...)
+	return notice.New("expected value to be negative").
+		SetTrail(ops.Trail).
+		Have("%v", have)
+}
+
+// numArgsError returns an error for arguments which are not numbers.
+func numArgsError(ops Options, args ...any) error {
+	typs := make([]string, len(args))
+	for i, arg := range args {
+		typs[i] = fmt.Sprintf("%T", arg)
+	}
+	return notice.New("expected numeric arguments").
+		SetTrail(ops.Trail).
+		Append("types", "%s", strings.Join(typs, ", "))
+}
+
+// numCmp compares two numbers of any integer, unsigned integer or float kind.
+// It returns -1 if "a" is less than "b", 0 if they are equal and +1 if "a" is
+// greater than "b". The second return value is false when any of the
+// arguments is not a number.
+//
+// nolint: cyclop
+func numCmp(a, b any) (int, bool) {
+	aVal, bVal := reflect.ValueOf(a), reflect.ValueOf(b)
+	aKnd, bKnd := numKind(aVal), numKind(bVal)
+	if aKnd == reflect.Invalid || bKnd == reflect.Invalid {
+		return 0, false
+	}
+
+	switch {
+	case aKnd == reflect.Int && bKnd == reflect.Int:
+		return cmp.Compare(aVal.Int(), bVal.Int()), true
+
+	case aKnd == reflect.Uint && bKnd == reflect.Uint:
+		return cmp.Compare(aVal.Uint(), bVal.Uint()), true
+
+	case aKnd == reflect.Int && bKnd == reflect.Uint:
+		if aVal.Int() < 0 {
+			return -1, true
+		}
+		return cmp.Compare(uint64(aVal.Int()), bVal.Uint()), true
+
+	case aKnd == reflect.Uint && bKnd == reflect.Int:
+		if bVal.Int() < 0 {
+			return 1, true
+		}
+		return cmp.Compare(aVal.Uint(), uint64(bVal.Int())), true
+
+	default:
+		return cmp.Compare(numFloat(aVal), numFloat(bVal)), true
+	}
+}
+
+// numKind returns [reflect.Int] for all signed integers, [reflect.Uint] for
+// all unsigned integers, [reflect.Float64] for floats and [reflect.Invalid]
+// for other kinds.
+func numKind(val reflect.Value) reflect.Kind {
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		return reflect.Int
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		return reflect.Uint
+	case reflect.Float32, reflect.Float64:
+		return reflect.Float64
+	default:
+		return reflect.Invalid
+	}
+}
+
+// numFloat returns a number represented by the value as float64.
+func numFloat(val reflect.Value) float64 {
+	switch numKind(val) {
+	case reflect.Int:
+		return float64(val.Int())
+	case reflect.Uint:
+		return float64(val.Uint())
+	default:
+		return val.Float()
+	}
+}
@@ -4,6 +4,7 @@
 package check
 
 import (
+	"math"
 	"testing"
 
 	"github.com/ctx42/testing/internal/affirm"
@@ -269,6 +270,76 @@ func Test_Delta(t *testing.T) {
 			"  have delta: 5"
 		affirm.Equal(t, wMsg, err.Error())
 	})
+
+	t.Run("success - within relative delta", func(t *testing.T) {
+		// --- Given ---
+		opt := WithFloatRelativeDelta(0.01)
+
+		// --- When ---
+		err := Delta(1e9, 1, 1.001e9, opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - within absolute delta", func(t *testing.T) {
+		// --- Given ---
+		opt := WithFloatRelativeDelta(0.01)
+
+		// --- When ---
+		err := Delta(0.001, 0.01, 0.002, opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - with relative delta", func(t *testing.T) {
+		// --- Given ---
+		opt := WithFloatRelativeDelta(0.01)
+
+		// --- When ---
+		err := Delta(100.0, 1, 80.0, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected numbers to be within the given delta:\n" +
+			"            want: 100\n" +
+			"            have: 80\n" +
+			"      want delta: 1\n" +
+			"      have delta: 20\n" +
+			"  want rel delta: 0.01\n" +
+			"  have rel delta: 0.2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_relDelta_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		want float64
+		have float64
+		exp  float64
+	}{
+		{"both zero", 0, 0, 0},
+		{"equal", 42, 42, 0},
+		{"want zero", 0, 2, 1},
+		{"have zero", 2, 0, 1},
+		{"positive", 100, 80, 0.2},
+		{"negative", -100, -80, 0.2},
+		{"symmetric", 80, 100, 0.2},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := relDelta(tc.want, tc.have)
+
+			// --- Then ---
+			affirm.Equal(t, tc.exp, have)
+		})
+	}
 }
 
 func Test_DeltaSlice(t *testing.T) {
@@ -722,3 +793,291 @@ func Test_NotDecreasing(t *testing.T) {
 		affirm.Equal(t, wMsg, err.Error())
 	})
 }
+
+func Test_Between(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := Between(1, 3, 2)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - range is inclusive", func(t *testing.T) {
+		affirm.Nil(t, Between(1, 3, 1))
+		affirm.Nil(t, Between(1, 3, 3))
+	})
+
+	t.Run("success - mixed kinds", func(t *testing.T) {
+		// --- When ---
+		err := Between(int8(-1), uint64(10), 2.5)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - below range", func(t *testing.T) {
+		// --- When ---
+		err := Between(1, 3, 0)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected value to be within range:\n" +
+			"   min: 1\n" +
+			"   max: 3\n" +
+			"  have: 0"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - above range", func(t *testing.T) {
+		// --- When ---
+		err := Between(1.5, 3.5, 4)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected value to be within range:\n" +
+			"   min: 1.5\n" +
+			"   max: 3.5\n" +
+			"  have: 4"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - not numeric", func(t *testing.T) {
+		// --- When ---
+		err := Between(1, 3, "2")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected numeric arguments:\n" +
+			"  types: int, int, string"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := Between(1, 3, 4, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected value to be within range:\n" +
+			"  trail: type.field\n" +
+			"    min: 1\n" +
+			"    max: 3\n" +
+			"   have: 4"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_GreaterThan(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := GreaterThan(1, uint8(2))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - equal", func(t *testing.T) {
+		// --- When ---
+		err := GreaterThan(2, 2.0)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected value to be greater:\n" +
+			"  greater than: 2\n" +
+			"          have: 2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - not numeric", func(t *testing.T) {
+		// --- When ---
+		err := GreaterThan(1, true)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected numeric arguments:\n" +
+			"  types: int, bool"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := GreaterThan(2, 1, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected value to be greater:\n" +
+			"         trail: type.field\n" +
+			"  greater than: 2\n" +
+			"          have: 1"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_LessOrEqual(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		affirm.Nil(t, LessOrEqual(2, 1))
+		affirm.Nil(t, LessOrEqual(uint(2), int64(2)))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := LessOrEqual(2, 2.5)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected value to be smaller or equal:\n" +
+			"  smaller or equal than: 2\n" +
+			"                   have: 2.5"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - not numeric", func(t *testing.T) {
+		// --- When ---
+		err := LessOrEqual(nil, 1)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected numeric arguments:\n" +
+			"  types: <nil>, int"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := LessOrEqual(1, 2, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected value to be smaller or equal:\n" +
+			"                  trail: type.field\n" +
+			"  smaller or equal than: 1\n" +
+			"                   have: 2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_Positive(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		affirm.Nil(t, Positive(1))
+		affirm.Nil(t, Positive(uint8(1)))
+		affirm.Nil(t, Positive(0.1))
+	})
+
+	t.Run("error - zero", func(t *testing.T) {
+		// --- When ---
+		err := Positive(0)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected value to be positive:\n" +
+			"  have: 0"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := Positive(-1.5, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected value to be positive:\n" +
+			"  trail: type.field\n" +
+			"   have: -1.5"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_Negative(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		affirm.Nil(t, Negative(-1))
+		affirm.Nil(t, Negative(-0.1))
+	})
+
+	t.Run("error - zero", func(t *testing.T) {
+		// --- When ---
+		err := Negative(uint(0))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected value to be negative:\n" +
+			"  have: 0"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := Negative(1, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected value to be negative:\n" +
+			"  trail: type.field\n" +
+			"   have: 1"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_numCmp_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		a    any
+		b    any
+		want int
+		ok   bool
+	}{
+		{"int less", 1, 2, -1, true},
+		{"int equal", int8(2), int64(2), 0, true},
+		{"uint greater", uint(3), uint16(2), 1, true},
+		{"negative int less than uint", -1, uint64(0), -1, true},
+		{"int greater than uint", 5, uint(3), 1, true},
+		{"uint greater than negative int", uint(0), -1, 1, true},
+		{"uint less than int", uint(1), 2, -1, true},
+		{"large uint64", uint64(math.MaxUint64), math.MaxInt64, 1, true},
+		{"float and int", 1.5, 1, 1, true},
+		{"float32 and uint", float32(0.5), uint(1), -1, true},
+		{"not numeric a", "1", 1, 0, false},
+		{"not numeric b", 1, nil, 0, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, ok := numCmp(tc.a, tc.b)
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+			affirm.Equal(t, tc.ok, ok)
+		})
+	}
+}
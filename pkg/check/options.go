@@ -5,14 +5,19 @@ package check
 
 import (
 	"fmt"
+	"io"
+	"io/fs"
 	"log"
 	"maps"
+	"math"
 	"os"
 	"reflect"
+	"slices"
 	"strconv"
 	"time"
 
 	"github.com/ctx42/testing/pkg/dump"
+	"github.com/ctx42/testing/pkg/notice"
 )
 
 // globLog is a global logger used package-wide.
@@ -32,6 +37,10 @@ const (
 	// DefaultDumpDepth is default depth when dumping values recursively in log
 	// messages.
 	DefaultDumpDepth = 6
+
+	// DefaultDumpHexDump is default length above which byte slices and arrays
+	// are dumped as hexdump in log messages.
+	DefaultDumpHexDump = 32
 )
 
 // Package-wide configuration.
@@ -47,6 +56,10 @@ var (
 
 	// DumpDepth is a configurable depth when dumping values in log messages.
 	DumpDepth = DefaultDumpDepth
+
+	// DumpHexDump is a configurable length above which byte slices and arrays
+	// are dumped as hexdump in log messages.
+	DumpHexDump = DefaultDumpHexDump
 )
 
 // Checker is signature for generic check function comparing two arguments
@@ -75,6 +88,34 @@ func RegisterTypeChecker(typ any, chk Checker) {
 	typeCheckers[rt] = chk
 }
 
+// defaultOpts is the global list of options set with [SetDefaultOptions].
+var defaultOpts []Option
+
+// SetDefaultOptions sets global options applied by [DefaultOptions] before
+// the options passed to it, so project-wide conventions like time deltas,
+// skipping unexported fields, type checkers, or the dumper configuration
+// apply to all checks without repeating them. The options passed to checks
+// take precedence. It's typically called from the TestMain function, and it's
+// not safe to call it concurrently with checks. Calling it without arguments
+// removes the default options.
+//
+// Example:
+//
+//	func TestMain(m *testing.M) {
+//	    check.SetDefaultOptions(
+//	        check.WithSkipUnexported,
+//	        check.WithDurationDelta(time.Millisecond),
+//	    )
+//	    os.Exit(m.Run())
+//	}
+func SetDefaultOptions(opts ...Option) {
+	if len(opts) == 0 {
+		defaultOpts = nil
+		return
+	}
+	defaultOpts = slices.Clone(opts)
+}
+
 // Option represents a [Checker] option.
 type Option func(Options) Options
 
@@ -95,6 +136,16 @@ func WithTrailLog(list *[]string) Option {
 	}
 }
 
+// WithTrailSink is a [Checker] option turning on a collection of checked
+// fields/elements/keys. The trails are written to the provided writer, one
+// trail per line. It can be used together with [WithTrailLog].
+func WithTrailSink(w io.Writer) Option {
+	return func(ops Options) Options {
+		ops.TrailSink = w
+		return ops
+	}
+}
+
 // WithTimeFormat is a [Checker] option setting time format when parsing dates.
 func WithTimeFormat(format string) Option {
 	return func(ops Options) Options {
@@ -168,7 +219,22 @@ func WithTrailChecker(trail string, chk Checker) Option {
 	}
 }
 
-// WithSkipTrail is a [Checker] option setting trails to skip.
+// WithTrailDumper is a [Checker] option setting a custom dumper used to render
+// values at a given trail in error messages. Unlike dumpers set with
+// [dump.WithDumper], it doesn't affect other values of the same type, so it
+// can be used to mask, truncate or otherwise format a single field.
+func WithTrailDumper(trail string, fn dump.Dumper) Option {
+	return func(ops Options) Options {
+		if ops.TrailDumpers == nil {
+			ops.TrailDumpers = make(map[string]dump.Dumper)
+		}
+		ops.TrailDumpers[trail] = fn
+		return ops
+	}
+}
+
+// WithSkipTrail is a [Checker] option setting trails to skip. Skipping a trail
+// skips the whole subtree of values under it.
 func WithSkipTrail(skip ...string) Option {
 	return func(ops Options) Options {
 		ops.SkipTrails = append(ops.SkipTrails, skip...)
@@ -240,6 +306,184 @@ func WithCmpBaseTypes(ops Options) Options {
 	return ops
 }
 
+// WithUnderlyingTypes is a [Checker] option turning on comparisons of named
+// types against their underlying types.
+//
+// During a normal operation, when comparing values with different types, the
+// error is returned. When this option is used, and both values have the same
+// kind and the "have" value is convertible to the type of "want" value, the
+// "have" value is converted and compared. Unlike [WithCmpBaseTypes], it is not
+// limited to simple types, so it works with named slices, maps and structs.
+//
+// Example:
+//
+//	// --- Given ---
+//	type MyString string
+//
+//	// --- When ---
+//	err := Equal(MyString("a"), "a", WithUnderlyingTypes)
+//
+//	// --- Then ---
+//	assert.NoError(t, err)
+func WithUnderlyingTypes(ops Options) Options {
+	ops.CmpUnderlying = true
+	return ops
+}
+
+// WithCaseInsensitive is a [Checker] option making all string comparisons,
+// including nested ones, case-insensitive using [strings.EqualFold].
+func WithCaseInsensitive(ops Options) Options {
+	ops.CaseInsensitive = true
+	return ops
+}
+
+// WithNormalizeWhitespace is a [Checker] option making all string
+// comparisons, including nested ones, ignore differences in whitespace. Before
+// comparing, leading and trailing whitespace is trimmed, and all runs of
+// whitespace characters (including line endings) are collapsed to a single
+// space. See [EqualTrimmed] for a standalone checker.
+func WithNormalizeWhitespace(ops Options) Options {
+	ops.NormalizeWhitespace = true
+	return ops
+}
+
+// WithErrorsByMessage is a [Checker] option making [Equal] compare any two
+// values implementing the error interface by the strings returned from their
+// Error methods. It is useful when structs contain wrapped errors, which are
+// distinct instances even when they describe the same failure. When messages
+// differ, the error message includes both error chains.
+func WithErrorsByMessage(ops Options) Options {
+	ops.ErrorsByMessage = true
+	return ops
+}
+
+// WithFailFast is a [Checker] option making [Equal] return the first found
+// difference right away, instead of comparing all the values and returning
+// all the differences. It is useful when comparing very large structures.
+func WithFailFast(ops Options) Options {
+	ops.FailFast = true
+	return ops
+}
+
+// WithParallel is a [Checker] option making [Equal] compare slice, array and
+// map elements using a pool of "workers" goroutines. Elements are compared
+// independently, and the results are merged in the same order as they would
+// be without the option, so error messages and logged trails are
+// deterministic. Only the outermost collection is compared in parallel,
+// nested ones are compared sequentially by the workers. Custom checkers may
+// be called concurrently. Values less than two disable parallel comparison.
+func WithParallel(workers int) Option {
+	return func(ops Options) Options {
+		ops.Parallel = workers
+		return ops
+	}
+}
+
+// WithUniqueKey is a [Checker] option used by [Unique] check setting a
+// function returning a key for a slice element. When set, elements are
+// considered duplicates when their keys are equal.
+func WithUniqueKey(fn func(elem any) any) Option {
+	return func(ops Options) Options {
+		ops.UniqueKey = fn
+		return ops
+	}
+}
+
+// WithStrictZero is a [Checker] option making [Equal] treat negative and
+// positive floating point zeros as different values. By default, they are
+// equal according to the IEEE 754 standard.
+func WithStrictZero(ops Options) Options {
+	ops.StrictZero = true
+	return ops
+}
+
+// WithFloatRelativeDelta is a [Checker] option setting the maximum relative
+// difference between floating point numbers compared by [Equal], and numbers
+// compared by [Delta]. The relative difference is computed as:
+//
+//	|w-h| / max(|w|, |h|)
+//
+// The [Delta] checker passes when numbers are within the absolute delta or
+// the relative delta.
+func WithFloatRelativeDelta(rel float64) Option {
+	return func(ops Options) Options {
+		ops.FloatRelativeDelta = rel
+		return ops
+	}
+}
+
+// WithDurationDelta is a [Checker] option setting the maximum difference
+// between durations compared with [Duration] for them to be considered equal.
+// Since [Duration] is the default checker for [time.Duration] type, the
+// option also applies to durations compared by [Equal].
+func WithDurationDelta(delta time.Duration) Option {
+	return func(ops Options) Options {
+		ops.DurationDelta = delta
+		return ops
+	}
+}
+
+// WithFS is a [Checker] option setting the filesystem used by the filesystem
+// checkers. By default, the operating system filesystem is used.
+func WithFS(fsys fs.FS) Option {
+	return func(ops Options) Options {
+		ops.FS = fsys
+		return ops
+	}
+}
+
+// WithMsg is a [Checker] option setting a user message describing the context
+// of the check, for example, why a particular equality matters. The message
+// is formatted using [fmt.Sprintf] if arguments are provided. Assertions
+// prepend it to the header of the failure message (see [Report]).
+//
+// Example:
+//
+//	assert.Equal(t, want, have, check.WithMsg("creating user %d", id))
+func WithMsg(format string, args ...any) Option {
+	if len(args) > 0 {
+		format = fmt.Sprintf(format, args...)
+	}
+	return func(ops Options) Options {
+		ops.Msg = format
+		return ops
+	}
+}
+
+// WithVerbosity is a [Checker] option setting the level of details in
+// failure messages. With [notice.Quiet], assertions report a one-line summary
+// (see [Report]). With [notice.Verbose], values are dumped without the depth
+// and the number of items limits, and [Equal] adds the "trails" row listing
+// all visited trails. Use [SetDefaultOptions] to set it for all checks.
+func WithVerbosity(v notice.Verbosity) Option {
+	return func(ops Options) Options {
+		ops.Verbosity = v
+		return ops
+	}
+}
+
+// Report prepares the error returned by a check to be reported in the test
+// log. It prepends the message set with [WithMsg] to the header of the first
+// notice in the "err" chain, in the same format [notice.From] uses for
+// prefixes, and sets the verbosity level set with [WithVerbosity]. When "err"
+// is not a notice and there is something to set, it's wrapped in one.
+// Returns nil when "err" is nil.
+func Report(err error, opts ...Option) error {
+	if err == nil {
+		return nil
+	}
+	ops := DefaultOptions(opts...)
+	if ops.Msg == "" && ops.Verbosity == notice.Normal {
+		return err
+	}
+	msg := notice.From(err)
+	if ops.Msg != "" {
+		head := msg.Head()
+		head.Header = fmt.Sprintf("[%s] %s", ops.Msg, head.Header)
+	}
+	return msg.SetVerbosity(ops.Verbosity)
+}
+
 // WithOptions is a [Checker] option which passes all options.
 func WithOptions(src Options) Option {
 	return func(ops Options) Options {
@@ -249,13 +493,29 @@ func WithOptions(src Options) Option {
 		ops.Recent = src.Recent
 		ops.Trail = src.Trail
 		ops.TrailLog = src.TrailLog
+		ops.TrailSink = src.TrailSink
 		ops.TypeCheckers = src.TypeCheckers
 		ops.TrailCheckers = src.TrailCheckers
+		ops.TrailDumpers = src.TrailDumpers
 		ops.SkipTrails = src.SkipTrails
 		ops.SkipUnexported = src.SkipUnexported
 		ops.CmpSimpleType = src.CmpSimpleType
+		ops.CmpUnderlying = src.CmpUnderlying
 		ops.IncreaseSoft = src.IncreaseSoft
 		ops.DecreaseSoft = src.DecreaseSoft
+		ops.CaseInsensitive = src.CaseInsensitive
+		ops.NormalizeWhitespace = src.NormalizeWhitespace
+		ops.UniqueKey = src.UniqueKey
+		ops.DurationDelta = src.DurationDelta
+		ops.FloatRelativeDelta = src.FloatRelativeDelta
+		ops.StrictZero = src.StrictZero
+		ops.FS = src.FS
+		ops.ErrorsByMessage = src.ErrorsByMessage
+		ops.Parallel = src.Parallel
+		ops.FailFast = src.FailFast
+		ops.Msg = src.Msg
+		ops.Verbosity = src.Verbosity
+		ops.skipSet = src.skipSet
 		ops.now = src.now
 		return ops
 	}
@@ -282,12 +542,19 @@ type Options struct {
 	// The skipped trails have "<skipped>" suffix.
 	TrailLog *[]string
 
+	// Writer visited trails are written to, one trail per line.
+	// The skipped trails have "<skipped>" suffix.
+	TrailSink io.Writer
+
 	// Custom checks to run for a given type.
 	TypeCheckers map[reflect.Type]Checker
 
 	// Custom checker for given trail.
 	TrailCheckers map[string]Checker
 
+	// Custom dumpers for given trails.
+	TrailDumpers map[string]dump.Dumper
+
 	// List of trails to skip.
 	SkipTrails []string
 
@@ -297,23 +564,67 @@ type Options struct {
 	// See [WithCmpBaseTypes].
 	CmpSimpleType bool
 
+	// See [WithUnderlyingTypes].
+	CmpUnderlying bool
+
 	// Option for [Increasing] allowing consecutive values to be equal.
 	IncreaseSoft bool
 
 	// Option for [Decreasing] allowing consecutive values to be equal.
 	DecreaseSoft bool
 
+	// See [WithCaseInsensitive].
+	CaseInsensitive bool
+
+	// See [WithNormalizeWhitespace].
+	NormalizeWhitespace bool
+
+	// See [WithUniqueKey].
+	UniqueKey func(elem any) any
+
+	// See [WithDurationDelta].
+	DurationDelta time.Duration
+
+	// See [WithFloatRelativeDelta].
+	FloatRelativeDelta float64
+
+	// See [WithStrictZero].
+	StrictZero bool
+
+	// See [WithFS].
+	FS fs.FS
+
+	// See [WithErrorsByMessage].
+	ErrorsByMessage bool
+
+	// See [WithParallel].
+	Parallel int
+
+	// See [WithFailFast].
+	FailFast bool
+
+	// See [WithMsg].
+	Msg string
+
+	// See [WithVerbosity].
+	Verbosity notice.Verbosity
+
+	// Index of the [Options.SkipTrails] built by [DefaultOptions].
+	skipSet trailSet
+
 	// Function used to get current time. Used preliminary to inject a clock in
 	// tests of checks and assertions using [time.Now].
 	now func() time.Time
 }
 
-// DefaultOptions returns default [Options].
+// DefaultOptions returns default [Options] with the options set by
+// [SetDefaultOptions] and "opts" applied.
 func DefaultOptions(opts ...Option) Options {
 	ops := Options{
 		Dumper: dump.New(
 			dump.WithTimeFormat(DumpTimeFormat),
 			dump.WithMaxDepth(DumpDepth),
+			dump.WithHexDump(DumpHexDump),
 		),
 		Recent:       RecentDuration,
 		TimeFormat:   ParseTimeFormat,
@@ -321,8 +632,18 @@ func DefaultOptions(opts ...Option) Options {
 		TypeCheckers: maps.Clone(typeCheckers),
 		now:          time.Now,
 	}
+	ops = ops.set(defaultOpts)
 	ops = ops.set(opts)
 
+	if ops.Verbosity == notice.Verbose {
+		ops.Dumper.MaxDepth = math.MaxInt
+		ops.Dumper.MaxItems = 0
+	}
+
+	if !slices.Equal(ops.skipSet.src, ops.SkipTrails) {
+		ops.skipSet = newTrailSet(ops.SkipTrails)
+	}
+
 	if ops.TypeCheckers == nil {
 		ops.TypeCheckers = make(map[reflect.Type]Checker)
 	}
@@ -335,9 +656,50 @@ func DefaultOptions(opts ...Option) Options {
 	if _, ok := ops.TypeCheckers[typZone]; !ok {
 		ops.TypeCheckers[typZone] = Zone
 	}
+	if _, ok := ops.TypeCheckers[typDur]; !ok {
+		ops.TypeCheckers[typDur] = durationChecker
+	}
 	return ops
 }
 
+// trailSet is a set of trails used for constant time trail lookups.
+type trailSet struct {
+	src    []string            // Copy of the trails the set was built from.
+	trails map[string]struct{} // The set of trails.
+}
+
+// newTrailSet returns a new [trailSet] built from a slice of trails.
+func newTrailSet(trails []string) trailSet {
+	set := trailSet{src: slices.Clone(trails)}
+	if len(trails) > 0 {
+		set.trails = make(map[string]struct{}, len(trails))
+		for _, trail := range trails {
+			set.trails[trail] = struct{}{}
+		}
+	}
+	return set
+}
+
+// has returns true if the set contains the "trail" or any of its parent
+// trails. A trail is a parent when the "trail" starts with it, and it's
+// followed by a field (.) or an index ([) separator.
+func (set trailSet) has(trail string) bool {
+	if len(set.trails) == 0 {
+		return false
+	}
+	if _, ok := set.trails[trail]; ok {
+		return true
+	}
+	for i := len(trail) - 1; i > 0; i-- {
+		if trail[i] == '.' || trail[i] == '[' {
+			if _, ok := set.trails[trail[:i]]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // set sets [Options] from a slice of [Option] functions.
 func (ops Options) set(opts []Option) Options {
 	dst := ops
@@ -347,11 +709,10 @@ func (ops Options) set(opts []Option) Options {
 	return dst
 }
 
-// LogTrail logs non-empty [Options.Trail] to [Options.TrailLog].
+// LogTrail logs non-empty [Options.Trail] to [Options.TrailLog] and
+// [Options.TrailSink].
 func (ops Options) LogTrail() Options {
-	if ops.TrailLog != nil && ops.Trail != "" {
-		*ops.TrailLog = append(*ops.TrailLog, ops.Trail)
-	}
+	logTrail(&ops, ops.Trail)
 	return ops
 }
 
@@ -365,20 +726,7 @@ func (ops Options) LogTrail() Options {
 //	Type.Field[1].Field
 //	Type.Field["A"].Field
 func (ops Options) StructTrail(typeName, fldName string) Options {
-	left := ops.Trail
-	if typeName != "" && ops.Trail == "" {
-		left = typeName
-	}
-	if left != "" && fldName != "" {
-		ops.Trail = left + "." + fldName
-		return ops
-	}
-	if left == "" && fldName != "" {
-		ops.Trail = fldName
-		return ops
-	}
-
-	ops.Trail = left
+	ops.Trail = structTrail(ops.Trail, typeName, fldName)
 	return ops
 }
 
@@ -392,14 +740,7 @@ func (ops Options) StructTrail(typeName, fldName string) Options {
 //	[1]map["A"]
 //	field["A"]
 func (ops Options) MapTrail(key string) Options {
-	next := ops.Trail
-	if ops.Trail == "" {
-		next = "map"
-	}
-	if next[len(next)-1] == ']' {
-		next += "map"
-	}
-	ops.Trail = next + "[" + key + "]"
+	ops.Trail = mapTrail(ops.Trail, key)
 	return ops
 }
 
@@ -411,12 +752,46 @@ func (ops Options) MapTrail(key string) Options {
 //	arr[1]
 //	[1]
 func (ops Options) ArrTrail(kind string, idx int) Options {
-	next := ops.Trail
+	ops.Trail = arrTrail(ops.Trail, kind, idx)
+	return ops
+}
+
+// structTrail returns "trail" updated with a struct type and/or field name.
+// See [Options.StructTrail].
+func structTrail(trail, typeName, fldName string) string {
+	left := trail
+	if typeName != "" && trail == "" {
+		left = typeName
+	}
+	if left != "" && fldName != "" {
+		return left + "." + fldName
+	}
+	if left == "" && fldName != "" {
+		return fldName
+	}
+	return left
+}
+
+// mapTrail returns "trail" updated with the map key. See [Options.MapTrail].
+func mapTrail(trail, key string) string {
+	next := trail
+	if trail == "" {
+		next = "map"
+	}
+	if next[len(next)-1] == ']' {
+		next += "map"
+	}
+	return next + "[" + key + "]"
+}
+
+// arrTrail returns "trail" updated with slice or array index. See
+// [Options.ArrTrail].
+func arrTrail(trail, kind string, idx int) string {
+	next := trail
 	if next == "" && kind != "" {
 		next = "<" + kind + ">"
 	}
-	ops.Trail = next + "[" + strconv.Itoa(idx) + "]"
-	return ops
+	return next + "[" + strconv.Itoa(idx) + "]"
 }
 
 // FieldName returns a helper function which updates [Options.Trail].
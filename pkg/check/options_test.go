@@ -7,15 +7,18 @@ import (
 	"bytes"
 	"errors"
 	"log"
+	"math"
 	"reflect"
 	"strings"
 	"testing"
+	"testing/fstest"
 	"time"
 
 	"github.com/ctx42/testing/internal/affirm"
 	"github.com/ctx42/testing/internal/core"
 	"github.com/ctx42/testing/pkg/dump"
 	"github.com/ctx42/testing/pkg/must"
+	"github.com/ctx42/testing/pkg/notice"
 )
 
 func Test_RegisterTypeChecker(t *testing.T) {
@@ -74,6 +77,58 @@ func Test_RegisterTypeChecker(t *testing.T) {
 	})
 }
 
+func Test_SetDefaultOptions(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { SetDefaultOptions() })
+
+		// --- When ---
+		SetDefaultOptions(WithSkipUnexported, WithTrail("type.field"))
+
+		// --- Then ---
+		affirm.Equal(t, 2, len(defaultOpts))
+	})
+
+	t.Run("without options removes defaults", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { SetDefaultOptions() })
+		SetDefaultOptions(WithSkipUnexported)
+
+		// --- When ---
+		SetDefaultOptions()
+
+		// --- Then ---
+		affirm.Nil(t, defaultOpts)
+	})
+
+	t.Run("options slice is cloned", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { SetDefaultOptions() })
+		opts := []Option{WithSkipUnexported}
+
+		// --- When ---
+		SetDefaultOptions(opts...)
+
+		// --- Then ---
+		opts[0] = WithStrictZero
+		have := DefaultOptions()
+		affirm.Equal(t, true, have.SkipUnexported)
+		affirm.Equal(t, false, have.StrictZero)
+	})
+
+	t.Run("used by checks", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { SetDefaultOptions() })
+		SetDefaultOptions(WithDurationDelta(time.Second))
+
+		// --- When ---
+		err := Equal(time.Second, 1500*time.Millisecond)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+}
+
 func Test_WithTrail(t *testing.T) {
 	// --- Given ---
 	ops := Options{}
@@ -98,6 +153,19 @@ func Test_WithTrailLog(t *testing.T) {
 	affirm.Equal(t, true, core.Same(&buf, have.TrailLog))
 }
 
+func Test_WithTrailSink(t *testing.T) {
+	// --- Given ---
+	buf := &bytes.Buffer{}
+	ops := Options{}
+
+	// --- When ---
+	have := WithTrailSink(buf)(ops)
+
+	// --- Then ---
+	affirm.Nil(t, ops.TrailSink)
+	affirm.Equal(t, true, core.Same(buf, have.TrailSink))
+}
+
 func Test_WithTimeFormat(t *testing.T) {
 	// --- Given ---
 	ops := Options{}
@@ -201,6 +269,20 @@ func Test_WithTrailChecker(t *testing.T) {
 	affirm.Equal(t, true, core.Same(chk, haveChk))
 }
 
+func Test_WithTrailDumper(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+	fn := func(dump.Dump, int, reflect.Value) string { return "" }
+
+	// --- When ---
+	have := WithTrailDumper("type.field", fn)(ops)
+
+	// --- Then ---
+	affirm.Equal(t, true, ops.TrailDumpers == nil)
+	haveFn, _ := have.TrailDumpers["type.field"]
+	affirm.Equal(t, true, core.Same(fn, haveFn))
+}
+
 func Test_WithSkipTrail(t *testing.T) {
 	// --- Given ---
 	ops := Options{}
@@ -247,41 +329,372 @@ func Test_WithDecreasingSoft(t *testing.T) {
 	affirm.Equal(t, true, have.DecreaseSoft)
 }
 
+func Test_WithUnderlyingTypes(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithUnderlyingTypes(ops)
+
+	// --- Then ---
+	affirm.Equal(t, false, ops.CmpUnderlying)
+	affirm.Equal(t, true, have.CmpUnderlying)
+}
+
+func Test_WithCaseInsensitive(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithCaseInsensitive(ops)
+
+	// --- Then ---
+	affirm.Equal(t, false, ops.CaseInsensitive)
+	affirm.Equal(t, true, have.CaseInsensitive)
+}
+
+func Test_WithNormalizeWhitespace(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithNormalizeWhitespace(ops)
+
+	// --- Then ---
+	affirm.Equal(t, false, ops.NormalizeWhitespace)
+	affirm.Equal(t, true, have.NormalizeWhitespace)
+}
+
+func Test_WithErrorsByMessage(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithErrorsByMessage(ops)
+
+	// --- Then ---
+	affirm.Equal(t, false, ops.ErrorsByMessage)
+	affirm.Equal(t, true, have.ErrorsByMessage)
+}
+
+func Test_WithFailFast(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithFailFast(ops)
+
+	// --- Then ---
+	affirm.Equal(t, false, ops.FailFast)
+	affirm.Equal(t, true, have.FailFast)
+}
+
+func Test_WithParallel(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithParallel(4)(ops)
+
+	// --- Then ---
+	affirm.Equal(t, 0, ops.Parallel)
+	affirm.Equal(t, 4, have.Parallel)
+}
+
+func Test_WithUniqueKey(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+	fn := func(elem any) any { return elem }
+
+	// --- When ---
+	have := WithUniqueKey(fn)(ops)
+
+	// --- Then ---
+	affirm.Equal(t, true, ops.UniqueKey == nil)
+	affirm.Equal(t, true, core.Same(fn, have.UniqueKey))
+}
+
+func Test_WithStrictZero(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithStrictZero(ops)
+
+	// --- Then ---
+	affirm.Equal(t, false, ops.StrictZero)
+	affirm.Equal(t, true, have.StrictZero)
+}
+
+func Test_WithFloatRelativeDelta(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithFloatRelativeDelta(0.01)(ops)
+
+	// --- Then ---
+	affirm.Equal(t, 0.0, ops.FloatRelativeDelta)
+	affirm.Equal(t, 0.01, have.FloatRelativeDelta)
+}
+
+func Test_WithDurationDelta(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithDurationDelta(time.Second)(ops)
+
+	// --- Then ---
+	affirm.Equal(t, time.Duration(0), ops.DurationDelta)
+	affirm.Equal(t, time.Second, have.DurationDelta)
+}
+
+func Test_WithFS(t *testing.T) {
+	// --- Given ---
+	fsys := fstest.MapFS{}
+	ops := Options{}
+
+	// --- When ---
+	have := WithFS(fsys)(ops)
+
+	// --- Then ---
+	affirm.Nil(t, ops.FS)
+	affirm.DeepEqual(t, fsys, have.FS)
+}
+
+func Test_WithMsg(t *testing.T) {
+	t.Run("message", func(t *testing.T) {
+		// --- Given ---
+		ops := Options{}
+
+		// --- When ---
+		have := WithMsg("creating user")(ops)
+
+		// --- Then ---
+		affirm.Equal(t, "creating user", have.Msg)
+	})
+
+	t.Run("message with arguments", func(t *testing.T) {
+		// --- Given ---
+		ops := Options{}
+
+		// --- When ---
+		have := WithMsg("creating user %d", 42)(ops)
+
+		// --- Then ---
+		affirm.Equal(t, "creating user 42", have.Msg)
+	})
+
+	t.Run("percent without arguments", func(t *testing.T) {
+		// --- Given ---
+		ops := Options{}
+
+		// --- When ---
+		have := WithMsg("100%")(ops)
+
+		// --- Then ---
+		affirm.Equal(t, "100%", have.Msg)
+	})
+}
+
+func Test_WithVerbosity(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithVerbosity(notice.Quiet)(ops)
+
+	// --- Then ---
+	affirm.Equal(t, notice.Quiet, have.Verbosity)
+}
+
+func Test_Report(t *testing.T) {
+	t.Run("notice", func(t *testing.T) {
+		// --- Given ---
+		err := notice.New("header").Want("%d", 1)
+
+		// --- When ---
+		have := Report(err, WithMsg("creating user %d", 42))
+
+		// --- Then ---
+		affirm.Equal(t, true, core.Same(err, have))
+		wMsg := "[creating user 42] header:\n" +
+			"  want: 1"
+		affirm.Equal(t, wMsg, have.Error())
+	})
+
+	t.Run("joined notices", func(t *testing.T) {
+		// --- Given ---
+		err := notice.Join(notice.New("first"), notice.New("second"))
+
+		// --- When ---
+		have := Report(err, WithMsg("msg"))
+
+		// --- Then ---
+		msg := notice.From(have)
+		affirm.Equal(t, "[msg] first", msg.Head().Header)
+		affirm.Equal(t, "second", msg.Header)
+	})
+
+	t.Run("not notice", func(t *testing.T) {
+		// --- Given ---
+		err := errors.New("test")
+
+		// --- When ---
+		have := Report(err, WithMsg("msg"))
+
+		// --- Then ---
+		affirm.Equal(t, "[msg] assertion error", notice.From(have).Header)
+		affirm.Equal(t, true, errors.Is(have, err))
+	})
+
+	t.Run("message not set", func(t *testing.T) {
+		// --- Given ---
+		err := notice.New("header")
+
+		// --- When ---
+		have := Report(err)
+
+		// --- Then ---
+		affirm.Equal(t, true, core.Same(err, have))
+		affirm.Equal(t, "header", err.Header)
+	})
+
+	t.Run("quiet", func(t *testing.T) {
+		// --- Given ---
+		err := notice.New("header").SetTrail("type.field").Want("%d", 1)
+
+		// --- When ---
+		have := Report(err, WithVerbosity(notice.Quiet))
+
+		// --- Then ---
+		affirm.Equal(t, "header (trail: type.field)", have.Error())
+	})
+
+	t.Run("quiet with message", func(t *testing.T) {
+		// --- Given ---
+		err := notice.New("header").Want("%d", 1)
+		opts := []Option{WithMsg("msg"), WithVerbosity(notice.Quiet)}
+
+		// --- When ---
+		have := Report(err, opts...)
+
+		// --- Then ---
+		affirm.Equal(t, "[msg] header", have.Error())
+	})
+
+	t.Run("quiet not notice", func(t *testing.T) {
+		// --- Given ---
+		err := errors.New("test")
+
+		// --- When ---
+		have := Report(err, WithVerbosity(notice.Quiet))
+
+		// --- Then ---
+		affirm.Equal(t, "assertion error", have.Error())
+		affirm.Equal(t, true, errors.Is(have, err))
+	})
+
+	t.Run("quiet from default options", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { SetDefaultOptions() })
+		SetDefaultOptions(WithVerbosity(notice.Quiet))
+		err := notice.New("header").Want("%d", 1)
+
+		// --- When ---
+		have := Report(err)
+
+		// --- Then ---
+		affirm.Equal(t, "header", have.Error())
+	})
+
+	t.Run("nil error", func(t *testing.T) {
+		// --- When ---
+		have := Report(nil, WithMsg("msg"))
+
+		// --- Then ---
+		affirm.Nil(t, have)
+	})
+}
+
 func Test_WithOptions(t *testing.T) {
 	// --- Given ---
 	waw := must.Value(time.LoadLocation("Europe/Warsaw"))
 	trailLog := make([]string, 0)
 	ops := Options{
 		Dumper: dump.Dump{
-			Flat:           true,
-			FlatStrings:    100,
-			Compact:        true,
-			TimeFormat:     time.Kitchen,
-			DurationFormat: "DurAsString",
-			PtrAddr:        true,
-			PrintType:      true,
-			PrintPrivate:   true,
-			UseAny:         true,
+			Flat:             true,
+			FlatStrings:      100,
+			FlatCompact:      3,
+			MaxStringLen:     50,
+			RawStrings:       true,
+			Compact:          true,
+			TimeFormat:       time.Kitchen,
+			DurationFormat:   "DurAsString",
+			PtrAddr:          true,
+			PrintType:        true,
+			PrintPrivate:     true,
+			UnsafeUnexported: true,
+			SharedPtr:        true,
+			ErrorChain:       true,
+			SyncState:        true,
+			ChanLen:          true,
+			FuncNames:        true,
+			NilFormat:        dump.NilTyped,
+			FloatFormat:      'e',
+			FloatPrec:        3,
+			HTTPHeaders:      []string{"A"},
+			HTTPBody:         16,
+			UseAny:           true,
+			DynamicTypes:     true,
 			Dumpers: map[reflect.Type]dump.Dumper{
 				reflect.TypeOf(123): dump.Dumper(nil),
 			},
-			MaxDepth: 6,
-			Indent:   2,
-			TabWidth: 4,
+			MaxDepth:     6,
+			MaxItems:     10,
+			Color:        true,
+			GoSyntax:     true,
+			FormatYAML:   true,
+			Stringer:     true,
+			StringerSkip: []reflect.Type{reflect.TypeOf(123)},
+			Redact:       []string{"A"},
+			Tags:         []string{"json"},
+			HexDump:      16,
+			MaxWidth:     80,
+			KeySort:      func(a, b reflect.Value) bool { return false },
+			Indent:       2,
+			TabWidth:     4,
 		},
-		TimeFormat:     time.RFC3339,
-		Zone:           waw,
-		Recent:         123,
-		Trail:          "trail",
-		TrailLog:       &trailLog,
-		TypeCheckers:   make(map[reflect.Type]Checker),
-		TrailCheckers:  make(map[string]Checker),
-		SkipTrails:     make([]string, 0),
-		SkipUnexported: true,
-		CmpSimpleType:  true,
-		IncreaseSoft:   true,
-		DecreaseSoft:   true,
-		now:            time.Now,
+		TimeFormat:          time.RFC3339,
+		Zone:                waw,
+		Recent:              123,
+		Trail:               "trail",
+		TrailLog:            &trailLog,
+		TrailSink:           &bytes.Buffer{},
+		TypeCheckers:        make(map[reflect.Type]Checker),
+		TrailCheckers:       make(map[string]Checker),
+		TrailDumpers:        make(map[string]dump.Dumper),
+		SkipTrails:          make([]string, 0),
+		SkipUnexported:      true,
+		CmpSimpleType:       true,
+		CmpUnderlying:       true,
+		IncreaseSoft:        true,
+		DecreaseSoft:        true,
+		CaseInsensitive:     true,
+		NormalizeWhitespace: true,
+		UniqueKey:           func(any) any { return nil },
+		DurationDelta:       123,
+		FloatRelativeDelta:  0.1,
+		StrictZero:          true,
+		FS:                  fstest.MapFS{},
+		ErrorsByMessage:     true,
+		Parallel:            4,
+		FailFast:            true,
+		Msg:                 "msg",
+		Verbosity:           notice.Verbose,
+		skipSet:             newTrailSet([]string{"a"}),
+		now:                 time.Now,
 	}
 
 	// --- When ---
@@ -289,20 +702,28 @@ func Test_WithOptions(t *testing.T) {
 
 	// --- Then ---
 	affirm.Equal(t, true, core.Same(ops.Dumper.Dumpers, have.Dumper.Dumpers))
+	affirm.Equal(t, true, core.Same(ops.Dumper.KeySort, have.Dumper.KeySort))
 	affirm.Equal(t, true, core.Same(ops.Zone, have.Zone))
 	affirm.Equal(t, true, core.Same(ops.TrailLog, have.TrailLog))
+	affirm.Equal(t, true, core.Same(ops.TrailSink, have.TrailSink))
 	affirm.Equal(t, true, core.Same(ops.TypeCheckers, have.TypeCheckers))
 	affirm.Equal(t, true, core.Same(ops.TrailCheckers, have.TrailCheckers))
+	affirm.Equal(t, true, core.Same(ops.TrailDumpers, have.TrailDumpers))
 	affirm.Equal(t, true, core.Same(ops.SkipTrails, have.SkipTrails))
+	affirm.Equal(t, true, core.Same(ops.UniqueKey, have.UniqueKey))
 	affirm.Equal(t, true, core.Same(ops.now, have.now))
 
+	ops.Dumper.KeySort = nil
+	have.Dumper.KeySort = nil
+	ops.UniqueKey = nil
+	have.UniqueKey = nil
 	ops.now = nil
 	have.now = nil
 	affirm.Equal(t, true, reflect.DeepEqual(ops, have))
 
 	// When those fail, add fields above.
-	affirm.Equal(t, 14, reflect.ValueOf(have.Dumper).NumField())
-	affirm.Equal(t, 14, reflect.ValueOf(have).NumField())
+	affirm.Equal(t, 43, reflect.ValueOf(have.Dumper).NumField())
+	affirm.Equal(t, 30, reflect.ValueOf(have).NumField())
 }
 
 func Test_DefaultOptions(t *testing.T) {
@@ -313,24 +734,92 @@ func Test_DefaultOptions(t *testing.T) {
 		// --- Then ---
 		affirm.Equal(t, false, have.Dumper.PtrAddr)
 		affirm.Equal(t, DefaultDumpTimeFormat, have.Dumper.TimeFormat)
+		affirm.Equal(t, DefaultDumpHexDump, have.Dumper.HexDump)
 
 		affirm.Equal(t, DefaultParseTimeFormat, have.TimeFormat)
 		affirm.Nil(t, have.Zone)
 		affirm.Equal(t, DefaultRecentDuration, have.Recent)
 		affirm.Equal(t, "", have.Trail)
 		affirm.Equal(t, true, have.TrailLog == nil)
+		affirm.Nil(t, have.TrailSink)
 		affirm.Equal(t, false, have.TypeCheckers == nil)
 		affirm.Equal(t, true, have.TrailCheckers == nil)
 		affirm.Equal(t, true, core.Same(Time, have.TypeCheckers[typTime]))
 		affirm.Equal(t, true, core.Same(Zone, have.TypeCheckers[typZone]))
 		affirm.Equal(t, true, core.Same(Zone, have.TypeCheckers[typZonePtr]))
+		durChk := have.TypeCheckers[typDur]
+		affirm.Equal(t, true, core.Same(durationChecker, durChk))
+		affirm.Equal(t, true, have.TrailDumpers == nil)
 		affirm.Equal(t, true, have.SkipTrails == nil)
 		affirm.Equal(t, false, have.SkipUnexported)
 		affirm.Equal(t, false, have.CmpSimpleType)
+		affirm.Equal(t, false, have.CmpUnderlying)
 		affirm.Equal(t, false, have.IncreaseSoft)
 		affirm.Equal(t, false, have.DecreaseSoft)
+		affirm.Equal(t, false, have.CaseInsensitive)
+		affirm.Equal(t, false, have.NormalizeWhitespace)
+		affirm.Equal(t, true, have.UniqueKey == nil)
+		affirm.Equal(t, time.Duration(0), have.DurationDelta)
+		affirm.Equal(t, 0.0, have.FloatRelativeDelta)
+		affirm.Equal(t, false, have.StrictZero)
+		affirm.Nil(t, have.FS)
+		affirm.Equal(t, false, have.ErrorsByMessage)
+		affirm.Equal(t, 0, have.Parallel)
+		affirm.Equal(t, false, have.FailFast)
+		affirm.Equal(t, "", have.Msg)
+		affirm.Equal(t, notice.Normal, have.Verbosity)
+		affirm.Equal(t, 0, len(have.skipSet.src))
 		affirm.Equal(t, true, core.Same(time.Now, have.now))
-		affirm.Equal(t, 14, reflect.ValueOf(have).NumField())
+		affirm.Equal(t, 30, reflect.ValueOf(have).NumField())
+	})
+
+	t.Run("with default options", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { SetDefaultOptions() })
+		SetDefaultOptions(WithSkipUnexported, WithTrail("type.field"))
+
+		// --- When ---
+		have := DefaultOptions()
+
+		// --- Then ---
+		affirm.Equal(t, true, have.SkipUnexported)
+		affirm.Equal(t, "type.field", have.Trail)
+	})
+
+	t.Run("options take precedence over default options", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { SetDefaultOptions() })
+		SetDefaultOptions(WithTrail("type.field"))
+
+		// --- When ---
+		have := DefaultOptions(WithTrail("other"))
+
+		// --- Then ---
+		affirm.Equal(t, "other", have.Trail)
+	})
+
+	t.Run("verbose", func(t *testing.T) {
+		// --- When ---
+		have := DefaultOptions(WithVerbosity(notice.Verbose))
+
+		// --- Then ---
+		affirm.Equal(t, notice.Verbose, have.Verbosity)
+		affirm.Equal(t, math.MaxInt, have.Dumper.MaxDepth)
+		affirm.Equal(t, 0, have.Dumper.MaxItems)
+	})
+
+	t.Run("verbose with dumper options", func(t *testing.T) {
+		// --- Given ---
+		opts := []Option{
+			WithDumper(dump.WithMaxItems(2)),
+			WithVerbosity(notice.Verbose),
+		}
+
+		// --- When ---
+		have := DefaultOptions(opts...)
+
+		// --- Then ---
+		affirm.Equal(t, 0, have.Dumper.MaxItems)
 	})
 
 	t.Run("with options", func(t *testing.T) {
@@ -346,18 +835,34 @@ func Test_DefaultOptions(t *testing.T) {
 		affirm.Equal(t, DefaultRecentDuration, have.Recent)
 		affirm.Equal(t, "type.field", have.Trail)
 		affirm.Equal(t, true, have.TrailLog == nil)
+		affirm.Nil(t, have.TrailSink)
 		affirm.Equal(t, true, have.TrailCheckers == nil)
 		affirm.Equal(t, true, core.Same(Time, have.TypeCheckers[typTime]))
 		affirm.Equal(t, true, core.Same(Zone, have.TypeCheckers[typZone]))
 		affirm.Equal(t, true, core.Same(Zone, have.TypeCheckers[typZonePtr]))
 		affirm.Equal(t, true, have.TrailCheckers == nil)
+		affirm.Equal(t, true, have.TrailDumpers == nil)
 		affirm.Equal(t, true, have.SkipTrails == nil)
 		affirm.Equal(t, false, have.SkipUnexported)
 		affirm.Equal(t, false, have.CmpSimpleType)
+		affirm.Equal(t, false, have.CmpUnderlying)
 		affirm.Equal(t, false, have.IncreaseSoft)
 		affirm.Equal(t, false, have.DecreaseSoft)
+		affirm.Equal(t, false, have.CaseInsensitive)
+		affirm.Equal(t, false, have.NormalizeWhitespace)
+		affirm.Equal(t, true, have.UniqueKey == nil)
+		affirm.Equal(t, time.Duration(0), have.DurationDelta)
+		affirm.Equal(t, 0.0, have.FloatRelativeDelta)
+		affirm.Equal(t, false, have.StrictZero)
+		affirm.Nil(t, have.FS)
+		affirm.Equal(t, false, have.ErrorsByMessage)
+		affirm.Equal(t, 0, have.Parallel)
+		affirm.Equal(t, false, have.FailFast)
+		affirm.Equal(t, "", have.Msg)
+		affirm.Equal(t, notice.Normal, have.Verbosity)
+		affirm.Equal(t, 0, len(have.skipSet.src))
 		affirm.Equal(t, true, core.Same(time.Now, have.now))
-		affirm.Equal(t, 14, reflect.ValueOf(have).NumField())
+		affirm.Equal(t, 30, reflect.ValueOf(have).NumField())
 	})
 
 	t.Run("TypeCheckers field is a clone of a global map", func(t *testing.T) {
@@ -399,6 +904,18 @@ func Test_DefaultOptions(t *testing.T) {
 		affirm.Equal(t, true, core.Same(chk, ops.TypeCheckers[typZone]))
 	})
 
+	t.Run("the duration check is not overwritten when set", func(t *testing.T) {
+		// --- Given ---
+		chk := func(_, _ any, _ ...Option) error { return nil }
+		opt := WithTypeChecker(time.Duration(0), chk)
+
+		// --- When ---
+		ops := DefaultOptions(opt)
+
+		// --- Then ---
+		affirm.Equal(t, true, core.Same(chk, ops.TypeCheckers[typDur]))
+	})
+
 	t.Run("timezone ptr check is not overwritten when set", func(t *testing.T) {
 		// --- Given ---
 		chk := func(_, _ any, _ ...Option) error { return nil }
@@ -410,6 +927,101 @@ func Test_DefaultOptions(t *testing.T) {
 		// --- Then ---
 		affirm.Equal(t, true, core.Same(chk, ops.TypeCheckers[typZonePtr]))
 	})
+
+	t.Run("skip trails are indexed", func(t *testing.T) {
+		// --- When ---
+		ops := DefaultOptions(WithSkipTrail("type.field1", "type.field2"))
+
+		// --- Then ---
+		affirm.Equal(t, 2, len(ops.skipSet.src))
+		affirm.Equal(t, true, ops.skipSet.has("type.field1"))
+		affirm.Equal(t, true, ops.skipSet.has("type.field2"))
+	})
+
+	t.Run("skip trails index is rebuilt when changed", func(t *testing.T) {
+		// --- Given ---
+		ops := DefaultOptions(WithSkipTrail("type.field1"))
+
+		// --- When ---
+		have := DefaultOptions(WithOptions(ops), WithSkipTrail("type.field2"))
+
+		// --- Then ---
+		affirm.Equal(t, 2, len(have.skipSet.src))
+		affirm.Equal(t, true, have.skipSet.has("type.field1"))
+		affirm.Equal(t, true, have.skipSet.has("type.field2"))
+	})
+
+	t.Run("skip trails index is rebuilt when edited", func(t *testing.T) {
+		// --- Given ---
+		ops := DefaultOptions(WithSkipTrail("type.field1"))
+		ops.SkipTrails[0] = "type.field2"
+
+		// --- When ---
+		have := DefaultOptions(WithOptions(ops))
+
+		// --- Then ---
+		affirm.Equal(t, false, have.skipSet.has("type.field1"))
+		affirm.Equal(t, true, have.skipSet.has("type.field2"))
+	})
+}
+
+func Test_newTrailSet(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		// --- When ---
+		have := newTrailSet(nil)
+
+		// --- Then ---
+		affirm.Equal(t, 0, len(have.src))
+		affirm.Equal(t, true, have.trails == nil)
+	})
+
+	t.Run("with trails", func(t *testing.T) {
+		// --- When ---
+		have := newTrailSet([]string{"a.b", "a.c", "a.b"})
+
+		// --- Then ---
+		affirm.DeepEqual(t, []string{"a.b", "a.c", "a.b"}, have.src)
+		affirm.Equal(t, 2, len(have.trails))
+	})
+}
+
+func Test_trailSet_has_tabular(t *testing.T) {
+	set := newTrailSet([]string{"T.A", "T.M[\"k\"]", "T.S[1]"})
+
+	tt := []struct {
+		testN string
+
+		trail string
+		want  bool
+	}{
+		{"empty", "", false},
+		{"exact field", "T.A", true},
+		{"exact map key", "T.M[\"k\"]", true},
+		{"exact index", "T.S[1]", true},
+		{"child field", "T.A.B", true},
+		{"child index", "T.A[0]", true},
+		{"deep child", "T.A[0].B.C", true},
+		{"child of map key", "T.M[\"k\"].B", true},
+		{"child of index", "T.S[1].B", true},
+		{"parent", "T", false},
+		{"sibling", "T.B", false},
+		{"same prefix", "T.AB", false},
+		{"other index", "T.S[10]", false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := set.has(tc.trail)
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+
+	t.Run("empty set", func(t *testing.T) {
+		affirm.Equal(t, false, trailSet{}.has("T.A"))
+	})
 }
 
 func Test_Options_LogTrail(t *testing.T) {
@@ -441,6 +1053,19 @@ func Test_Options_LogTrail(t *testing.T) {
 		affirm.DeepEqual(t, have, ops)
 	})
 
+	t.Run("sink", func(t *testing.T) {
+		// --- Given ---
+		buf := &bytes.Buffer{}
+		ops := Options{Trail: "abc", TrailSink: buf}
+
+		// --- When ---
+		have := ops.LogTrail()
+
+		// --- Then ---
+		affirm.Equal(t, "abc\n", buf.String())
+		affirm.DeepEqual(t, have, ops)
+	})
+
 	t.Run("does not panic when nil", func(t *testing.T) {
 		// --- Given ---
 		ops := Options{Trail: "abc"}
@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"cmp"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// rxSemVer matches semantic version as defined by https://semver.org.
+var rxSemVer = regexp.MustCompile(
+	`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+		`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)` +
+		`(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+		`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`,
+)
+
+// SemVer checks "have" is a valid semantic version as defined by
+// https://semver.org (for example "1.2.3", "1.2.3-rc.1+build.5"). Returns nil
+// if it is, otherwise it returns an error with a message indicating the
+// expected and actual values.
+func SemVer(have string, opts ...Option) error {
+	if _, ok := parseSemVer(have); ok {
+		return nil
+	}
+	ops := DefaultOptions(opts...)
+	return notice.New("expected valid semantic version").
+		SetTrail(ops.Trail).
+		Have("%q", have)
+}
+
+// SemVerConstraint checks "have" semantic version satisfies the "constraint".
+// Returns nil if it does, otherwise it returns an error with a message
+// indicating the expected and actual values.
+//
+// The constraint is a comma separated list of comparisons, all of which must
+// be satisfied. Each comparison is an operator (=, !=, >, >=, <, <=) followed
+// by a semantic version. When the operator is omitted, the "=" is assumed.
+// Versions are compared according to the semantic versioning precedence
+// rules, so build metadata is ignored.
+//
+// Example:
+//
+//	check.SemVerConstraint(">= 1.2.0, < 2.0.0", "1.4.2")
+func SemVerConstraint(constraint, have string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	cs, ok := parseSemVerConstraint(constraint)
+	if !ok {
+		return notice.New("expected valid semantic version constraint").
+			SetTrail(ops.Trail).
+			Append("constraint", "%q", constraint)
+	}
+	hVer, ok := parseSemVer(have)
+	if !ok {
+		return notice.New("expected valid semantic version").
+			SetTrail(ops.Trail).
+			Have("%q", have)
+	}
+	for _, c := range cs {
+		if !c.match(hVer) {
+			return notice.New("expected version to satisfy constraint").
+				SetTrail(ops.Trail).
+				Append("constraint", "%s", constraint).
+				Have("%s", have)
+		}
+	}
+	return nil
+}
+
+// semVer represents parsed semantic version.
+type semVer struct {
+	major, minor, patch uint64
+	pre                 []string // Pre-release identifiers.
+}
+
+// parseSemVer parses semantic version. Returns false if "str" is not a valid
+// semantic version.
+func parseSemVer(str string) (semVer, bool) {
+	m := rxSemVer.FindStringSubmatch(str)
+	if m == nil {
+		return semVer{}, false
+	}
+	var ver semVer
+	var err error
+	if ver.major, err = strconv.ParseUint(m[1], 10, 64); err != nil {
+		return semVer{}, false
+	}
+	if ver.minor, err = strconv.ParseUint(m[2], 10, 64); err != nil {
+		return semVer{}, false
+	}
+	if ver.patch, err = strconv.ParseUint(m[3], 10, 64); err != nil {
+		return semVer{}, false
+	}
+	if m[4] != "" {
+		ver.pre = strings.Split(m[4], ".")
+	}
+	return ver, true
+}
+
+// compare compares semantic versions according to the precedence rules.
+// Returns -1 if "v" is lower than "o", 0 if they are equal and +1 if "v" is
+// greater than "o".
+func (v semVer) compare(o semVer) int {
+	if c := cmp.Compare(v.major, o.major); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(v.minor, o.minor); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(v.patch, o.patch); c != 0 {
+		return c
+	}
+
+	// A version without pre-release has higher precedence.
+	switch {
+	case len(v.pre) == 0 && len(o.pre) == 0:
+		return 0
+	case len(v.pre) == 0:
+		return 1
+	case len(o.pre) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(v.pre) && i < len(o.pre); i++ {
+		if c := comparePreRelease(v.pre[i], o.pre[i]); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(v.pre), len(o.pre))
+}
+
+// comparePreRelease compares pre-release identifiers. Numeric identifiers are
+// compared numerically and have lower precedence than alphanumeric ones.
+func comparePreRelease(a, b string) int {
+	aNum, aErr := strconv.ParseUint(a, 10, 64)
+	bNum, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		return cmp.Compare(aNum, bNum)
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// semVerCmp represents a single semantic version comparison.
+type semVerCmp struct {
+	op  string
+	ver semVer
+}
+
+// match returns true if "ver" satisfies the comparison.
+func (c semVerCmp) match(ver semVer) bool {
+	res := ver.compare(c.ver)
+	switch c.op {
+	case "!=":
+		return res != 0
+	case ">":
+		return res > 0
+	case ">=":
+		return res >= 0
+	case "<":
+		return res < 0
+	case "<=":
+		return res <= 0
+	default:
+		return res == 0
+	}
+}
+
+// parseSemVerConstraint parses comma separated list of semantic version
+// comparisons. Returns false if the constraint is not valid.
+func parseSemVerConstraint(constraint string) ([]semVerCmp, bool) {
+	var cs []semVerCmp
+	for _, part := range strings.Split(constraint, ",") {
+		part = strings.TrimSpace(part)
+		op := "="
+		for _, o := range []string{">=", "<=", "!=", ">", "<", "="} {
+			if strings.HasPrefix(part, o) {
+				op = o
+				part = strings.TrimSpace(part[len(o):])
+				break
+			}
+		}
+		ver, ok := parseSemVer(part)
+		if !ok {
+			return nil, false
+		}
+		cs = append(cs, semVerCmp{op: op, ver: ver})
+	}
+	return cs, true
+}
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+)
+
+func Test_SemVer(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := SemVer("1.2.3-rc.1+build.5")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := SemVer("v1.2")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected valid semantic version:\n" +
+			"  have: \"v1.2\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := SemVer("01.2.3", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected valid semantic version:\n" +
+			"  trail: type.field\n" +
+			"   have: \"01.2.3\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_SemVer_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		have string
+		ok   bool
+	}{
+		{"release", "1.2.3", true},
+		{"zeros", "0.0.0", true},
+		{"pre-release", "1.0.0-alpha.1", true},
+		{"build metadata", "1.0.0+20130313144700", true},
+		{"pre-release and build", "1.0.0-beta+exp.sha.5114f85", true},
+		{"empty", "", false},
+		{"missing patch", "1.2", false},
+		{"v prefix", "v1.2.3", false},
+		{"leading zero", "1.02.3", false},
+		{"leading zero pre-release", "1.2.3-01", false},
+		{"empty pre-release", "1.2.3-", false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := SemVer(tc.have)
+
+			// --- Then ---
+			affirm.Equal(t, tc.ok, err == nil)
+		})
+	}
+}
+
+func Test_SemVerConstraint(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := SemVerConstraint(">= 1.2.0, < 2.0.0", "1.4.2")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - not satisfied", func(t *testing.T) {
+		// --- When ---
+		err := SemVerConstraint(">= 1.2.0", "1.1.9")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected version to satisfy constraint:\n" +
+			"  constraint: >= 1.2.0\n" +
+			"        have: 1.1.9"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - invalid constraint", func(t *testing.T) {
+		// --- When ---
+		err := SemVerConstraint(">= 1.2", "1.1.9")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected valid semantic version constraint:\n" +
+			"  constraint: \">= 1.2\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - invalid version", func(t *testing.T) {
+		// --- When ---
+		err := SemVerConstraint(">= 1.2.0", "abc")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected valid semantic version:\n" +
+			"  have: \"abc\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := SemVerConstraint("< 1.0.0", "1.0.0", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected version to satisfy constraint:\n" +
+			"       trail: type.field\n" +
+			"  constraint: < 1.0.0\n" +
+			"        have: 1.0.0"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_SemVerConstraint_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		constraint string
+		have       string
+		ok         bool
+	}{
+		{"implicit equal", "1.2.3", "1.2.3", true},
+		{"equal", "= 1.2.3", "1.2.3", true},
+		{"equal ignores build", "=1.2.3", "1.2.3+build", true},
+		{"not equal", "!= 1.2.3", "1.2.4", true},
+		{"greater", "> 1.2.3", "1.3.0", true},
+		{"greater equal", ">= 1.2.3", "1.2.3", true},
+		{"less", "< 1.2.3", "1.2.2", true},
+		{"less equal", "<= 1.2.3", "1.2.3", true},
+		{"pre-release is lower", "< 1.0.0", "1.0.0-rc.1", true},
+		{"numeric pre-release", "< 1.0.0-rc.10", "1.0.0-rc.2", true},
+		{"alpha pre-release", "> 1.0.0-alpha", "1.0.0-beta", true},
+		{"numeric lower than alpha", "< 1.0.0-alpha", "1.0.0-1", true},
+		{"longer pre-release", "> 1.0.0-alpha", "1.0.0-alpha.1", true},
+		{"range", ">= 1.0.0, < 2.0.0", "1.9.9", true},
+
+		{"not equal fails", "!= 1.2.3", "1.2.3", false},
+		{"greater fails", "> 1.2.3", "1.2.3", false},
+		{"range fails", ">= 1.0.0, < 2.0.0", "2.0.0", false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := SemVerConstraint(tc.constraint, tc.have)
+
+			// --- Then ---
+			affirm.Equal(t, tc.ok, err == nil)
+		})
+	}
+}
@@ -5,6 +5,7 @@ package check
 
 import (
 	"strings"
+	"unicode/utf8"
 
 	"github.com/ctx42/testing/pkg/notice"
 )
@@ -36,3 +37,172 @@ func NotContain(want, have string, opts ...Option) error {
 	}
 	return nil
 }
+
+// EqualFold checks "want" and "have" strings are equal under simple Unicode
+// case-folding. Returns nil if they are, otherwise returns an error with a
+// message indicating the expected and actual values.
+func EqualFold(want, have string, opts ...Option) error {
+	if strings.EqualFold(want, have) {
+		return nil
+	}
+	ops := DefaultOptions(opts...)
+	return notice.New("expected strings to be equal ignoring case").
+		SetTrail(ops.Trail).
+		Want("%q", want).
+		Have("%q", have).
+		Append("comparison", "%s", "case-insensitive")
+}
+
+// EqualTrimmed checks "want" and "have" strings are equal after normalizing
+// whitespace. Before comparing, leading and trailing whitespace is trimmed,
+// and all runs of whitespace characters (including line endings) are collapsed
+// to a single space. Returns nil if they are equal, otherwise returns an error
+// with a message indicating the expected and actual values.
+func EqualTrimmed(want, have string, opts ...Option) error {
+	if normalizeWhitespace(want) == normalizeWhitespace(have) {
+		return nil
+	}
+	ops := DefaultOptions(opts...)
+	return equalError(want, have, WithOptions(ops)).
+		SetHeader("expected strings to be equal ignoring whitespace").
+		Append("comparison", "%s", "whitespace-normalized")
+}
+
+// stringsEqual compares strings using string comparison modes set in options.
+// Returns true if strings are equal and the description of the modes which
+// were used, the description is empty for the exact comparison.
+func stringsEqual(want, have string, ops *Options) (bool, string) {
+	var modes []string
+	if ops.NormalizeWhitespace {
+		want, have = normalizeWhitespace(want), normalizeWhitespace(have)
+		modes = append(modes, "whitespace-normalized")
+	}
+	if ops.CaseInsensitive {
+		modes = append(modes, "case-insensitive")
+		return strings.EqualFold(want, have), strings.Join(modes, ", ")
+	}
+	return want == have, strings.Join(modes, ", ")
+}
+
+// normalizeWhitespace trims the string and collapses all runs of whitespace
+// characters to a single space.
+func normalizeWhitespace(str string) string {
+	return strings.Join(strings.Fields(str), " ")
+}
+
+// HasPrefix checks "have" string starts with the "prefix". Returns nil if it
+// does, otherwise returns an error with a message indicating the expected and
+// actual values. The message highlights the longest common prefix.
+func HasPrefix(prefix, have string, opts ...Option) error {
+	if strings.HasPrefix(have, prefix) {
+		return nil
+	}
+	ops := DefaultOptions(opts...)
+	msg := notice.New("expected string to have prefix").
+		SetTrail(ops.Trail).
+		Append("string", "%s", ops.Dumper.Any(have)).
+		Append("prefix", "%s", ops.Dumper.Any(prefix))
+	if n := commonPrefix(prefix, have); n > 0 {
+		hl := highlight(have, 0, n)
+		_ = msg.Append("closest", "%s", ops.Dumper.Any(hl))
+	}
+	return msg
+}
+
+// HasSuffix checks "have" string ends with the "suffix". Returns nil if it
+// does, otherwise returns an error with a message indicating the expected and
+// actual values. The message highlights the longest common suffix.
+func HasSuffix(suffix, have string, opts ...Option) error {
+	if strings.HasSuffix(have, suffix) {
+		return nil
+	}
+	ops := DefaultOptions(opts...)
+	msg := notice.New("expected string to have suffix").
+		SetTrail(ops.Trail).
+		Append("string", "%s", ops.Dumper.Any(have)).
+		Append("suffix", "%s", ops.Dumper.Any(suffix))
+	if n := commonSuffix(suffix, have); n > 0 {
+		hl := highlight(have, len(have)-n, len(have))
+		_ = msg.Append("closest", "%s", ops.Dumper.Any(hl))
+	}
+	return msg
+}
+
+// StringContains checks "want" is a substring of "have". Returns nil if it
+// is, otherwise returns an error with a message indicating the expected and
+// actual values. Unlike [Contain], the message highlights the longest prefix
+// of "want" found in "have" and formats multiline strings using [dump].
+func StringContains(want, have string, opts ...Option) error {
+	if strings.Contains(have, want) {
+		return nil
+	}
+	ops := DefaultOptions(opts...)
+	msg := notice.New("expected string to contain substring").
+		SetTrail(ops.Trail).
+		Append("string", "%s", ops.Dumper.Any(have)).
+		Append("substring", "%s", ops.Dumper.Any(want))
+	if idx, n := closestMatch(want, have); n > 0 {
+		hl := highlight(have, idx, idx+n)
+		_ = msg.Append("closest", "%s", ops.Dumper.Any(hl))
+	}
+	return msg
+}
+
+// Markers used to highlight a part of a string in messages.
+const (
+	hlStart = "[["
+	hlEnd   = "]]"
+)
+
+// highlight wraps the part of the string between "start" and "end" byte
+// offsets with highlight markers.
+func highlight(str string, start, end int) string {
+	return str[:start] + hlStart + str[start:end] + hlEnd + str[end:]
+}
+
+// commonPrefix returns the length in bytes of the longest common prefix of
+// both strings. It never splits a multibyte rune, and invalid UTF-8 bytes are
+// compared as single bytes.
+func commonPrefix(a, b string) int {
+	var n int
+	for n < len(a) && n < len(b) {
+		_, size := utf8.DecodeRuneInString(a[n:])
+		if n+size > len(b) || a[n:n+size] != b[n:n+size] {
+			break
+		}
+		n += size
+	}
+	return n
+}
+
+// commonSuffix returns the length in bytes of the longest common suffix of
+// both strings. It never splits a multibyte rune, and invalid UTF-8 bytes are
+// compared as single bytes.
+func commonSuffix(a, b string) int {
+	var n int
+	for n < len(a) && n < len(b) {
+		_, size := utf8.DecodeLastRuneInString(a[:len(a)-n])
+		if n+size > len(b) {
+			break
+		}
+		if a[len(a)-n-size:len(a)-n] != b[len(b)-n-size:len(b)-n] {
+			break
+		}
+		n += size
+	}
+	return n
+}
+
+// closestMatch finds the longest prefix of "sub" which is present in "str".
+// Returns the index in "str" where it was found and its length in bytes. When
+// nothing was found, it returns -1 and 0.
+func closestMatch(sub, str string) (int, int) {
+	for end := len(sub); end > 0; {
+		if idx := strings.Index(str, sub[:end]); idx >= 0 {
+			return idx, end
+		}
+		_, size := utf8.DecodeLastRuneInString(sub[:end])
+		end -= size
+	}
+	return -1, 0
+}
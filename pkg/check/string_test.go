@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/dump"
 )
 
 func Test_Contain(t *testing.T) {
@@ -144,3 +145,411 @@ func Test_NotContain_error_tabular(t *testing.T) {
 		})
 	}
 }
+
+func Test_EqualFold(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- When ---
+		err := EqualFold("Go", "GO")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := EqualFold("Go", "Golang")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected strings to be equal ignoring case:\n" +
+			"        want: \"Go\"\n" +
+			"        have: \"Golang\"\n" +
+			"  comparison: case-insensitive"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := EqualFold("abc", "xyz", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected strings to be equal ignoring case:\n" +
+			"       trail: type.field\n" +
+			"        want: \"abc\"\n" +
+			"        have: \"xyz\"\n" +
+			"  comparison: case-insensitive"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_EqualTrimmed(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		want := "SELECT *\n  FROM users\r\n WHERE id = 1;"
+		have := "  SELECT * FROM\tusers WHERE   id = 1;\n"
+
+		// --- When ---
+		err := EqualTrimmed(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := EqualTrimmed("a  b", "a c")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected strings to be equal ignoring whitespace:\n" +
+			"        want: \"a  b\"\n" +
+			"        have: \"a c\"\n" +
+			"  comparison: whitespace-normalized"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := EqualTrimmed("abc", "xyz", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected strings to be equal ignoring whitespace:\n" +
+			"       trail: type.field\n" +
+			"        want: \"abc\"\n" +
+			"        have: \"xyz\"\n" +
+			"  comparison: whitespace-normalized"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_stringsEqual_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		want  string
+		have  string
+		opts  []Option
+		equal bool
+		mode  string
+	}{
+		{"exact equal", "a", "a", nil, true, ""},
+		{"exact not equal", "a", "A", nil, false, ""},
+		{"fold", "a", "A", []Option{WithCaseInsensitive}, true, "case-insensitive"},
+		{
+			"spaces",
+			" a\n b ",
+			"a b",
+			[]Option{WithNormalizeWhitespace},
+			true,
+			"whitespace-normalized",
+		},
+		{
+			"spaces and fold",
+			" a\n b ",
+			"A B",
+			[]Option{WithNormalizeWhitespace, WithCaseInsensitive},
+			true,
+			"whitespace-normalized, case-insensitive",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			ops := DefaultOptions(tc.opts...)
+
+			// --- When ---
+			equal, mode := stringsEqual(tc.want, tc.have, &ops)
+
+			// --- Then ---
+			affirm.Equal(t, tc.equal, equal)
+			affirm.Equal(t, tc.mode, mode)
+		})
+	}
+}
+
+func Test_normalizeWhitespace_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		str  string
+		want string
+	}{
+		{"empty", "", ""},
+		{"only whitespace", " \t\r\n ", ""},
+		{"trimmed", "  abc  ", "abc"},
+		{"collapsed", "a  \t b\r\n\nc", "a b c"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := normalizeWhitespace(tc.str)
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_HasPrefix(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := HasPrefix("abc", "abcdef")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error with closest match", func(t *testing.T) {
+		// --- When ---
+		err := HasPrefix("abX", "abcdef")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected string to have prefix:\n" +
+			"   string: \"abcdef\"\n" +
+			"   prefix: \"abX\"\n" +
+			"  closest: \"[[ab]]cdef\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error without closest match", func(t *testing.T) {
+		// --- When ---
+		err := HasPrefix("xyz", "abcdef")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected string to have prefix:\n" +
+			"  string: \"abcdef\"\n" +
+			"  prefix: \"xyz\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := HasPrefix("xyz", "abc", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected string to have prefix:\n" +
+			"   trail: type.field\n" +
+			"  string: \"abc\"\n" +
+			"  prefix: \"xyz\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_HasSuffix(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := HasSuffix("def", "abcdef")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error with closest match", func(t *testing.T) {
+		// --- When ---
+		err := HasSuffix("Xef", "abcdef")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected string to have suffix:\n" +
+			"   string: \"abcdef\"\n" +
+			"   suffix: \"Xef\"\n" +
+			"  closest: \"abcd[[ef]]\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - invalid UTF-8", func(t *testing.T) {
+		// --- When ---
+		err := HasSuffix("\uFFFD", "\xff")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected string to have suffix:\n" +
+			"  string: \"\\xff\"\n" +
+			"  suffix: \"\uFFFD\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := HasSuffix("xyz", "abc", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected string to have suffix:\n" +
+			"   trail: type.field\n" +
+			"  string: \"abc\"\n" +
+			"  suffix: \"xyz\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_StringContains(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := StringContains("cd", "abcdef")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error with closest match", func(t *testing.T) {
+		// --- When ---
+		err := StringContains("cdX", "abcdef")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected string to contain substring:\n" +
+			"     string: \"abcdef\"\n" +
+			"  substring: \"cdX\"\n" +
+			"    closest: \"ab[[cd]]ef\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("multiline strings", func(t *testing.T) {
+		// --- Given ---
+		have := "line1\nline2\nline3"
+		opt := WithDumper(dump.WithFlatStrings(0))
+
+		// --- When ---
+		err := StringContains("line2\nX", have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected string to contain substring:\n" +
+			"     string:\n" +
+			"             line1\n" +
+			"             line2\n" +
+			"             line3\n" +
+			"  substring:\n" +
+			"             line2\n" +
+			"             X\n" +
+			"    closest:\n" +
+			"             line1\n" +
+			"             [[line2\n" +
+			"             ]]line3"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := StringContains("xyz", "abc", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected string to contain substring:\n" +
+			"      trail: type.field\n" +
+			"     string: \"abc\"\n" +
+			"  substring: \"xyz\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_commonPrefix_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		a    string
+		b    string
+		want int
+	}{
+		{"empty", "", "", 0},
+		{"none", "abc", "xyz", 0},
+		{"partial", "abX", "abc", 2},
+		{"full", "abc", "abc", 3},
+		{"multibyte", "zaż", "zażółć", 4},
+		{"multibyte not split", "ż", "ź", 0},
+		{"invalid UTF-8", "\xffa", "\uFFFDa", 0},
+		{"invalid UTF-8 after valid", "a\xff", "a\xff", 2},
+		{"mixed width", "aż", "a\xc5", 1},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := commonPrefix(tc.a, tc.b)
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_commonSuffix_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		a    string
+		b    string
+		want int
+	}{
+		{"empty", "", "", 0},
+		{"none", "abc", "xyz", 0},
+		{"partial", "Xbc", "abc", 2},
+		{"full", "abc", "abc", 3},
+		{"multibyte", "łć", "zażółć", 4},
+		{"multibyte not split", "ż", "ź", 0},
+		{"invalid UTF-8", "\uFFFD", "\xff", 0},
+		{"invalid UTF-8 before valid", "\xffa", "x\xffa", 2},
+		{"mixed width", "żb", "\xbcb", 1},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := commonSuffix(tc.a, tc.b)
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_closestMatch_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		sub  string
+		str  string
+		wIdx int
+		wLen int
+	}{
+		{"empty", "", "abc", -1, 0},
+		{"none", "xyz", "abc", -1, 0},
+		{"partial", "cdX", "abcdef", 2, 2},
+		{"full", "cd", "abcdef", 2, 2},
+		{"multibyte", "óX", "zażółć", 4, 2},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			idx, n := closestMatch(tc.sub, tc.str)
+
+			// --- Then ---
+			affirm.Equal(t, tc.wIdx, idx)
+			affirm.Equal(t, tc.wLen, n)
+		})
+	}
+}
@@ -351,28 +351,66 @@ func Zone(want, have any, opts ...Option) error {
 
 // Duration checks "want" and "have" durations are equal. Returns nil if they
 // are, otherwise returns an error with a message indicating the expected and
-// actual values.
+// actual values. Use the [WithDurationDelta] option to allow durations to
+// differ by the given delta.
 //
 // The "want" and "have" may represent duration in the form of a string, int,
 // int64 or [time.Duration].
 func Duration(want, have any, opts ...Option) error {
-	wDur, wStr, _, err := getDur(want, opts...)
+	wDur, wStr, wRep, err := getDur(want, opts...)
 	if err != nil {
 		return notice.From(err, "want")
 	}
-	hDur, hStr, _, err := getDur(have, opts...)
+	hDur, hStr, hRep, err := getDur(have, opts...)
 	if err != nil {
 		return notice.From(err, "have")
 	}
 
-	if wDur == hDur {
+	ops := DefaultOptions(opts...)
+	diff := wDur - hDur
+	if diff == 0 || (ops.DurationDelta != 0 &&
+		math.Abs(float64(diff)) <= math.Abs(float64(ops.DurationDelta))) {
 		return nil
 	}
-	ops := DefaultOptions(opts...)
-	return notice.New("expected equal time durations").
+
+	msg := notice.New("expected equal time durations").
 		SetTrail(ops.Trail).
-		Want("%s", wStr).
-		Have("%s", hStr)
+		Want("%s", formatDur(wDur, wStr, wRep)).
+		Have("%s", formatDur(hDur, hStr, hRep))
+	if ops.DurationDelta != 0 {
+		_ = msg.
+			Append("max diff +/-", "%s", ops.DurationDelta).
+			Append("have diff", "%s", diff)
+	}
+	return msg
+}
+
+// durationChecker is the default [Checker] for the [time.Duration] type. The
+// [Equal] checker passes values of simple kinds without their named types, so
+// the int64 values are converted back to [time.Duration] before calling the
+// [Duration] checker.
+func durationChecker(want, have any, opts ...Option) error {
+	if val, ok := want.(int64); ok {
+		want = time.Duration(val)
+	}
+	if val, ok := have.(int64); ok {
+		have = time.Duration(val)
+	}
+	return Duration(want, have, opts...)
+}
+
+// formatDur formats duration for an error message. Durations represented by
+// integers are shown in the human-readable form followed by the original
+// value in parenthesis.
+//
+// Example:
+//
+//	1.5s (1500000000)
+func formatDur(dur time.Duration, str string, rep durRep) string {
+	if rep == durTypeInt || rep == durTypeInt64 {
+		return fmt.Sprintf("%s (%s)", dur, str)
+	}
+	return str
 }
 
 // formatDates formats two dates for comparison in an error message.
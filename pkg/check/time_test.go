@@ -1010,6 +1010,78 @@ func Test_Duration(t *testing.T) {
 		affirm.Equal(t, wMsg, err.Error())
 	})
 
+	t.Run("error - integers shown in human-readable form", func(t *testing.T) {
+		// --- When ---
+		err := Duration(1500000000, int64(2000000000))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected equal time durations:\n" +
+			"  want: 1.5s (1500000000)\n" +
+			"  have: 2s (2000000000)"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("within delta", func(t *testing.T) {
+		// --- Given ---
+		opt := WithDurationDelta(time.Second)
+
+		// --- When ---
+		err := Duration(time.Second, 2*time.Second, opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - not within delta", func(t *testing.T) {
+		// --- Given ---
+		opt := WithDurationDelta(time.Second)
+
+		// --- When ---
+		err := Duration(time.Second, 2500*time.Millisecond, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected equal time durations:\n" +
+			"          want: 1s\n" +
+			"          have: 2.5s\n" +
+			"  max diff +/-: 1s\n" +
+			"     have diff: -1.5s"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("nested duration field in Equal", func(t *testing.T) {
+		// --- Given ---
+		want := types.TA{Dur: time.Second}
+		have := types.TA{Dur: 1500 * time.Millisecond}
+
+		// --- When ---
+		err := Equal(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected equal time durations:\n" +
+			"  trail: TA.Dur\n" +
+			"   want: 1s\n" +
+			"   have: 1.5s"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("nested duration field in Equal within delta", func(t *testing.T) {
+		// --- Given ---
+		want := types.TA{Dur: time.Second}
+		have := types.TA{Dur: 1500 * time.Millisecond}
+
+		// --- When ---
+		err := Equal(want, have, WithDurationDelta(time.Second))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
 	t.Run("invalid want", func(t *testing.T) {
 		// --- When ---
 		err := Duration("abc", "2000s")
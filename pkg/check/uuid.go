@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"strings"
+
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// UUID checks "have" is a UUID in the canonical RFC 4122 textual form
+// (xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx). The version of the UUID is not
+// checked, and hexadecimal digits may be in upper or lower case. Returns nil
+// if it is, otherwise it returns an error with a message indicating the
+// expected and actual values.
+func UUID(have string, opts ...Option) error {
+	if isUUID(have) {
+		return nil
+	}
+	ops := DefaultOptions(opts...)
+	return notice.New("expected valid UUID").
+		SetTrail(ops.Trail).
+		Have("%q", have)
+}
+
+// UUIDv4 checks "have" is a valid version 4 UUID in the canonical RFC 4122
+// textual form. Returns nil if it is, otherwise it returns an error with a
+// message indicating the expected and actual values.
+func UUIDv4(have string, opts ...Option) error {
+	if err := UUID(have, opts...); err != nil {
+		return err
+	}
+	if have[14] == '4' && strings.ContainsRune("89abAB", rune(have[19])) {
+		return nil
+	}
+	ops := DefaultOptions(opts...)
+	return notice.New("expected version 4 UUID").
+		SetTrail(ops.Trail).
+		Append("version", "%c", have[14]).
+		Append("variant", "%c", have[19]).
+		Have("%q", have)
+}
+
+// UUIDEqual checks "want" and "have" represent the same UUID. The comparison
+// is case-insensitive and the UUIDs may be given with or without dashes.
+// Returns nil if they are, otherwise it returns an error with a message
+// indicating the expected and actual values.
+func UUIDEqual(want, have string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	wNorm, ok := normUUID(want)
+	if !ok {
+		return notice.New("expected valid UUID").
+			SetTrail(ops.Trail).
+			Append("argument", "want").
+			Append("value", "%q", want)
+	}
+	hNorm, ok := normUUID(have)
+	if !ok {
+		return notice.New("expected valid UUID").
+			SetTrail(ops.Trail).
+			Append("argument", "have").
+			Append("value", "%q", have)
+	}
+	if wNorm == hNorm {
+		return nil
+	}
+	return notice.New("expected equal UUIDs").
+		SetTrail(ops.Trail).
+		Want("%q", want).
+		Have("%q", have)
+}
+
+// isUUID returns true if "str" is a UUID in canonical RFC 4122 textual form.
+func isUUID(str string) bool {
+	if len(str) != 36 {
+		return false
+	}
+	for i := 0; i < len(str); i++ {
+		switch i {
+		case 8, 13, 18, 23:
+			if str[i] != '-' {
+				return false
+			}
+		default:
+			if !isHexDigit(str[i]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// normUUID returns UUID in lower case without dashes. Returns false if "str"
+// is not a valid UUID with or without dashes.
+func normUUID(str string) (string, bool) {
+	if isUUID(str) {
+		str = strings.ReplaceAll(str, "-", "")
+	}
+	if len(str) != 32 {
+		return "", false
+	}
+	for i := 0; i < len(str); i++ {
+		if !isHexDigit(str[i]) {
+			return "", false
+		}
+	}
+	return strings.ToLower(str), true
+}
+
+// isHexDigit returns true if "c" is a hexadecimal digit.
+func isHexDigit(c byte) bool {
+	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'f') ||
+		('A' <= c && c <= 'F')
+}
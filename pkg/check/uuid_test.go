@@ -0,0 +1,231 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+)
+
+func Test_UUID(t *testing.T) {
+	t.Run("lower case", func(t *testing.T) {
+		// --- When ---
+		err := UUID("f47ac10b-58cc-0372-8567-0e02b2c3d479")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("upper case", func(t *testing.T) {
+		// --- When ---
+		err := UUID("F47AC10B-58CC-4372-A567-0E02B2C3D479")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := UUID("f47ac10b58cc43728567-0e02b2c3d479")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected valid UUID:\n" +
+			"  have: \"f47ac10b58cc43728567-0e02b2c3d479\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := UUID("abc", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected valid UUID:\n" +
+			"  trail: type.field\n" +
+			"   have: \"abc\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_UUIDv4(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := UUIDv4("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - not UUID", func(t *testing.T) {
+		// --- When ---
+		err := UUIDv4("abc")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected valid UUID:\n" +
+			"  have: \"abc\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - wrong version", func(t *testing.T) {
+		// --- When ---
+		err := UUIDv4("f47ac10b-58cc-1372-a567-0e02b2c3d479")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected version 4 UUID:\n" +
+			"  version: 1\n" +
+			"  variant: a\n" +
+			"     have: \"f47ac10b-58cc-1372-a567-0e02b2c3d479\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - wrong variant", func(t *testing.T) {
+		// --- When ---
+		err := UUIDv4("f47ac10b-58cc-4372-c567-0e02b2c3d479")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected version 4 UUID:\n" +
+			"  version: 4\n" +
+			"  variant: c\n" +
+			"     have: \"f47ac10b-58cc-4372-c567-0e02b2c3d479\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := UUIDv4("f47ac10b-58cc-1372-a567-0e02b2c3d479", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected version 4 UUID:\n" +
+			"    trail: type.field\n" +
+			"  version: 1\n" +
+			"  variant: a\n" +
+			"     have: \"f47ac10b-58cc-1372-a567-0e02b2c3d479\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_UUIDEqual(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		want := "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+
+		// --- When ---
+		err := UUIDEqual(want, want)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal ignoring case and dashes", func(t *testing.T) {
+		// --- Given ---
+		want := "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+		have := "F47AC10B58CC4372A5670E02B2C3D479"
+
+		// --- When ---
+		err := UUIDEqual(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - invalid want", func(t *testing.T) {
+		// --- When ---
+		err := UUIDEqual("abc", "f47ac10b-58cc-4372-a567-0e02b2c3d479")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected valid UUID:\n" +
+			"  argument: want\n" +
+			"     value: \"abc\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - invalid have", func(t *testing.T) {
+		// --- When ---
+		err := UUIDEqual("f47ac10b-58cc-4372-a567-0e02b2c3d479", "abc")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected valid UUID:\n" +
+			"  argument: have\n" +
+			"     value: \"abc\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		want := "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+		have := "F47AC10B58CC4372A5670E02B2C3D470"
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := UUIDEqual(want, have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected equal UUIDs:\n" +
+			"  trail: type.field\n" +
+			"   want: \"f47ac10b-58cc-4372-a567-0e02b2c3d479\"\n" +
+			"   have: \"F47AC10B58CC4372A5670E02B2C3D470\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_normUUID_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		str    string
+		want   string
+		wantOK bool
+	}{
+		{
+			"canonical",
+			"F47AC10B-58CC-4372-A567-0E02B2C3D479",
+			"f47ac10b58cc4372a5670e02b2c3d479",
+			true,
+		},
+		{
+			"no dashes",
+			"f47ac10b58cc4372a5670e02b2c3d479",
+			"f47ac10b58cc4372a5670e02b2c3d479",
+			true,
+		},
+		{"empty", "", "", false},
+		{"too short", "f47ac10b58cc4372a5670e02b2c3d47", "", false},
+		{"not hex", "x47ac10b58cc4372a5670e02b2c3d479", "", false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, ok := normUUID(tc.str)
+
+			// --- Then ---
+			affirm.Equal(t, tc.wantOK, ok)
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+// Package diff provides line-level and word-level text diffs with unified,
+// side-by-side and inline word renderers.
+//
+// Diffs are computed with the Myers algorithm on sequences of lines or
+// words, so they can be used by custom reporters to present differences the
+// same way the assertions do.
+package diff
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/ctx42/testing/internal/diff/lcs"
+)
+
+// Op represents a diff operation.
+type Op int
+
+// Diff operations.
+const (
+	Equal  Op = iota // Text present in both strings.
+	Delete           // Text present only in the "before" string.
+	Insert           // Text present only in the "after" string.
+)
+
+// String implements [fmt.Stringer] interface.
+func (op Op) String() string {
+	switch op {
+	case Equal:
+		return "equal"
+	case Delete:
+		return "delete"
+	case Insert:
+		return "insert"
+	default:
+		return "unknown"
+	}
+}
+
+// Chunk represents a continuous part of the text with the same [Op].
+type Chunk struct {
+	Op   Op     // The operation.
+	Text string // The text.
+}
+
+// Lines returns the line-level diff of "before" and "after" strings. The
+// text of chunks has whole lines including the new line characters.
+// Concatenating the [Equal] and [Delete] chunks gives the "before" string,
+// concatenating the [Equal] and [Insert] chunks gives the "after" string.
+// Returns nil when both strings are empty.
+func Lines(before, after string) []Chunk {
+	return compute(splitLines(before), splitLines(after))
+}
+
+// Words returns the word-level diff of "before" and "after" strings. Words
+// are sequences of letters, digits and underscores. Sequences of white space
+// characters and other characters are compared separately. Concatenating the
+// [Equal] and [Delete] chunks gives the "before" string, concatenating the
+// [Equal] and [Insert] chunks gives the "after" string. Returns nil when both
+// strings are empty.
+func Words(before, after string) []Chunk {
+	return compute(splitWords(before), splitWords(after))
+}
+
+// compute returns the diff of two sequences of tokens.
+func compute(before, after []string) []Chunk {
+	// Encode tokens as runes, so the sequences can be compared with the
+	// rune-based LCS algorithm.
+	ids := make(map[string]rune)
+	bRunes := encode(ids, before)
+	aRunes := encode(ids, after)
+
+	var chunks []Chunk
+	var pos int
+	for _, d := range lcs.DiffRunes(bRunes, aRunes) {
+		chunks = appendChunk(chunks, Equal, before[pos:d.Start])
+		chunks = appendChunk(chunks, Delete, before[d.Start:d.End])
+		chunks = appendChunk(chunks, Insert, after[d.ReplStart:d.ReplEnd])
+		pos = d.End
+	}
+	return appendChunk(chunks, Equal, before[pos:])
+}
+
+// encode returns tokens as runes. Equal tokens are represented by the same
+// rune, the "ids" map is updated with tokens seen for the first time.
+func encode(ids map[string]rune, tokens []string) []rune {
+	runes := make([]rune, len(tokens))
+	for i, tok := range tokens {
+		id, ok := ids[tok]
+		if !ok {
+			id = rune(len(ids))
+			ids[tok] = id
+		}
+		runes[i] = id
+	}
+	return runes
+}
+
+// appendChunk appends the tokens as a chunk with the operation. When the last
+// chunk has the same operation, the tokens are added to it.
+func appendChunk(chunks []Chunk, op Op, tokens []string) []Chunk {
+	if len(tokens) == 0 {
+		return chunks
+	}
+	txt := strings.Join(tokens, "")
+	if n := len(chunks); n > 0 && chunks[n-1].Op == op {
+		chunks[n-1].Text += txt
+		return chunks
+	}
+	return append(chunks, Chunk{Op: op, Text: txt})
+}
+
+// splitLines splits the string into lines. The lines include the new line
+// characters.
+func splitLines(str string) []string {
+	lines := strings.SplitAfter(str, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// splitWords splits the string into words, sequences of white space
+// characters and single other characters.
+func splitWords(str string) []string {
+	var words []string
+	runes := []rune(str)
+	for i := 0; i < len(runes); {
+		end := i + 1
+		switch {
+		case isWord(runes[i]):
+			for end < len(runes) && isWord(runes[end]) {
+				end++
+			}
+		case unicode.IsSpace(runes[i]):
+			for end < len(runes) && unicode.IsSpace(runes[end]) {
+				end++
+			}
+		}
+		words = append(words, string(runes[i:end]))
+		i = end
+	}
+	return words
+}
+
+// isWord returns true if the rune is a part of a word.
+func isWord(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
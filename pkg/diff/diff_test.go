@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+)
+
+func Test_Op_String_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		op   Op
+		want string
+	}{
+		{"equal", Equal, "equal"},
+		{"delete", Delete, "delete"},
+		{"insert", Insert, "insert"},
+		{"unknown", Op(100), "unknown"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := tc.op.String()
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_Lines(t *testing.T) {
+	t.Run("both empty", func(t *testing.T) {
+		// --- When ---
+		have := Lines("", "")
+
+		// --- Then ---
+		affirm.Nil(t, have)
+	})
+
+	t.Run("equal", func(t *testing.T) {
+		// --- When ---
+		have := Lines("a\nb\n", "a\nb\n")
+
+		// --- Then ---
+		want := []Chunk{{Op: Equal, Text: "a\nb\n"}}
+		affirm.DeepEqual(t, want, have)
+	})
+
+	t.Run("changed line", func(t *testing.T) {
+		// --- When ---
+		have := Lines("a\nb\nc\n", "a\nX\nc\n")
+
+		// --- Then ---
+		want := []Chunk{
+			{Op: Equal, Text: "a\n"},
+			{Op: Delete, Text: "b\n"},
+			{Op: Insert, Text: "X\n"},
+			{Op: Equal, Text: "c\n"},
+		}
+		affirm.DeepEqual(t, want, have)
+	})
+
+	t.Run("added and removed lines", func(t *testing.T) {
+		// --- When ---
+		have := Lines("a\nb\nc", "b\nc\nd")
+
+		// --- Then ---
+		want := []Chunk{
+			{Op: Delete, Text: "a\n"},
+			{Op: Equal, Text: "b\n"},
+			{Op: Delete, Text: "c"},
+			{Op: Insert, Text: "c\nd"},
+		}
+		affirm.DeepEqual(t, want, have)
+	})
+
+	t.Run("from empty", func(t *testing.T) {
+		// --- When ---
+		have := Lines("", "a\nb\n")
+
+		// --- Then ---
+		want := []Chunk{{Op: Insert, Text: "a\nb\n"}}
+		affirm.DeepEqual(t, want, have)
+	})
+
+	t.Run("to empty", func(t *testing.T) {
+		// --- When ---
+		have := Lines("a\nb\n", "")
+
+		// --- Then ---
+		want := []Chunk{{Op: Delete, Text: "a\nb\n"}}
+		affirm.DeepEqual(t, want, have)
+	})
+}
+
+func Test_Words(t *testing.T) {
+	t.Run("both empty", func(t *testing.T) {
+		// --- When ---
+		have := Words("", "")
+
+		// --- Then ---
+		affirm.Nil(t, have)
+	})
+
+	t.Run("changed word", func(t *testing.T) {
+		// --- When ---
+		have := Words("the quick brown fox", "the slow brown fox")
+
+		// --- Then ---
+		want := []Chunk{
+			{Op: Equal, Text: "the "},
+			{Op: Delete, Text: "quick"},
+			{Op: Insert, Text: "slow"},
+			{Op: Equal, Text: " brown fox"},
+		}
+		affirm.DeepEqual(t, want, have)
+	})
+
+	t.Run("punctuation and white space", func(t *testing.T) {
+		// --- When ---
+		have := Words("a, b", "a;  b")
+
+		// --- Then ---
+		want := []Chunk{
+			{Op: Equal, Text: "a"},
+			{Op: Delete, Text: ", "},
+			{Op: Insert, Text: ";  "},
+			{Op: Equal, Text: "b"},
+		}
+		affirm.DeepEqual(t, want, have)
+	})
+
+	t.Run("unicode words", func(t *testing.T) {
+		// --- When ---
+		have := Words("zażółć gęślą", "zażółć jaźń")
+
+		// --- Then ---
+		want := []Chunk{
+			{Op: Equal, Text: "zażółć "},
+			{Op: Delete, Text: "gęślą"},
+			{Op: Insert, Text: "jaźń"},
+		}
+		affirm.DeepEqual(t, want, have)
+	})
+}
+
+func Test_splitLines_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		str  string
+		want []string
+	}{
+		{"empty", "", []string{}},
+		{"one line", "a", []string{"a"}},
+		{"trailing new line", "a\n", []string{"a\n"}},
+		{"many lines", "a\nb\n\nc", []string{"a\n", "b\n", "\n", "c"}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := splitLines(tc.str)
+
+			// --- Then ---
+			affirm.Equal(t, len(tc.want), len(have))
+			for i := range tc.want {
+				affirm.Equal(t, tc.want[i], have[i])
+			}
+		})
+	}
+}
+
+func Test_splitWords_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		str  string
+		want []string
+	}{
+		{"empty", "", nil},
+		{"words", "ab cd", []string{"ab", " ", "cd"}},
+		{"underscore and digits", "a_1 b2", []string{"a_1", " ", "b2"}},
+		{"white space", "a \t\nb", []string{"a", " \t\n", "b"}},
+		{"other characters", "a.(b)", []string{"a", ".", "(", "b", ")"}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := splitWords(tc.str)
+
+			// --- Then ---
+			affirm.DeepEqual(t, tc.want, have)
+		})
+	}
+}
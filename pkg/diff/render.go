@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package diff
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ctx42/testing/internal/diff"
+)
+
+// Unified returns the line-level unified diff of "before" and "after"
+// strings, with "ctx" unchanged lines around the changed ones. The diff has
+// only hunks, lines removed from "before" start with "-" and lines added in
+// "after" start with "+". Returns empty string if the strings are equal.
+//
+// Example:
+//
+//	@@ -1,3 +1,3 @@
+//	 line 1
+//	-line 2
+//	+line X
+//	 line 3
+func Unified(before, after string, ctx int) string {
+	edits := diff.Strings(before, after)
+	// Error can't happen: edits are consistent.
+	str, _ := diff.CtxToUnified("before", "after", before, edits, ctx)
+	return strings.TrimRight(str, "\n")
+}
+
+// SideBySide returns the line-level diff of "before" and "after" strings
+// rendered in two columns. The left column has "before" lines padded or
+// truncated to "width" characters, the right column has "after" lines. The
+// columns are separated by the marker: " " for equal lines, "|" for changed
+// lines, "<" for lines removed from "before" and ">" for lines added in
+// "after".
+//
+// Example:
+//
+//	line 1   line 1
+//	line 2 | line X
+//	line 3 <
+func SideBySide(before, after string, width int) string {
+	var rows []string
+	chunks := Lines(before, after)
+	for i := 0; i < len(chunks); i++ {
+		chk := chunks[i]
+		switch chk.Op {
+		case Equal:
+			for _, line := range splitLines(chk.Text) {
+				rows = append(rows, row(line, ' ', line, width))
+			}
+
+		case Delete:
+			left := splitLines(chk.Text)
+			var right []string
+			if i+1 < len(chunks) && chunks[i+1].Op == Insert {
+				right = splitLines(chunks[i+1].Text)
+				i++
+			}
+			rows = append(rows, changedRows(left, right, width)...)
+
+		case Insert:
+			rows = append(rows, changedRows(nil, splitLines(chk.Text), width)...)
+		}
+	}
+	return strings.Join(rows, "\n")
+}
+
+// changedRows returns side-by-side rows for the lines removed from "before"
+// and lines added in "after" at the same position.
+func changedRows(left, right []string, width int) []string {
+	rows := make([]string, 0, max(len(left), len(right)))
+	for i := 0; i < len(left) || i < len(right); i++ {
+		switch {
+		case i < len(left) && i < len(right):
+			rows = append(rows, row(left[i], '|', right[i], width))
+		case i < len(left):
+			rows = append(rows, row(left[i], '<', "", width))
+		default:
+			rows = append(rows, row("", '>', right[i], width))
+		}
+	}
+	return rows
+}
+
+// row returns the side-by-side row with the left column padded or truncated
+// to "width" characters.
+func row(left string, marker byte, right string, width int) string {
+	left = strings.TrimSuffix(left, "\n")
+	right = strings.TrimSuffix(right, "\n")
+	if n := utf8.RuneCountInString(left); n > width {
+		left = string([]rune(left)[:max(width-1, 0)]) + "…"
+	} else {
+		left += strings.Repeat(" ", width-n)
+	}
+	str := left + " " + string(marker) + " " + right
+	return strings.TrimRight(str, " ")
+}
+
+// WordDiff returns the word-level diff of "before" and "after" strings (see
+// [Words]) rendered inline. Removed words are enclosed in "[-" and "-]",
+// added words are enclosed in "{+" and "+}". Returns "after" string if the
+// strings are equal.
+//
+// Example:
+//
+//	the [-quick-]{+slow+} brown fox
+func WordDiff(before, after string) string {
+	buf := &strings.Builder{}
+	for _, chk := range Words(before, after) {
+		switch chk.Op {
+		case Equal:
+			buf.WriteString(chk.Text)
+		case Delete:
+			buf.WriteString("[-" + chk.Text + "-]")
+		case Insert:
+			buf.WriteString("{+" + chk.Text + "+}")
+		}
+	}
+	return buf.String()
+}
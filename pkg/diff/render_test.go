@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+)
+
+func Test_Unified(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- When ---
+		have := Unified("a\nb\n", "a\nb\n", 3)
+
+		// --- Then ---
+		affirm.Equal(t, "", have)
+	})
+
+	t.Run("changed line", func(t *testing.T) {
+		// --- When ---
+		have := Unified("a\nb\nc\n", "a\nX\nc\n", 3)
+
+		// --- Then ---
+		want := "" +
+			"@@ -1,3 +1,3 @@\n" +
+			" a\n" +
+			"-b\n" +
+			"+X\n" +
+			" c"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("context lines", func(t *testing.T) {
+		// --- When ---
+		have := Unified("a\nb\nc\nd\ne\n", "a\nb\nX\nd\ne\n", 1)
+
+		// --- Then ---
+		want := "" +
+			"@@ -2,3 +2,3 @@\n" +
+			" b\n" +
+			"-c\n" +
+			"+X\n" +
+			" d"
+		affirm.Equal(t, want, have)
+	})
+}
+
+func Test_SideBySide(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- When ---
+		have := SideBySide("a\nb\n", "a\nb\n", 3)
+
+		// --- Then ---
+		want := "" +
+			"a     a\n" +
+			"b     b"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("changed removed and added lines", func(t *testing.T) {
+		// --- Given ---
+		before := "line 1\nline 2\nline 3\nline 4\n"
+		after := "line 0\nline 1\nline X\nline 3\n"
+
+		// --- When ---
+		have := SideBySide(before, after, 6)
+
+		// --- Then ---
+		want := "" +
+			"       > line 0\n" +
+			"line 1   line 1\n" +
+			"line 2 | line X\n" +
+			"line 3   line 3\n" +
+			"line 4 <"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("more removed than added lines", func(t *testing.T) {
+		// --- When ---
+		have := SideBySide("a\nb\nc\n", "X\n", 1)
+
+		// --- Then ---
+		want := "" +
+			"a | X\n" +
+			"b <\n" +
+			"c <"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("more added than removed lines", func(t *testing.T) {
+		// --- When ---
+		have := SideBySide("a\n", "X\nY\n", 1)
+
+		// --- Then ---
+		want := "" +
+			"a | X\n" +
+			"  > Y"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("long lines are truncated", func(t *testing.T) {
+		// --- When ---
+		have := SideBySide("abcdef\n", "ąbcdef\n", 4)
+
+		// --- Then ---
+		affirm.Equal(t, "abc… | ąbcdef", have)
+	})
+
+	t.Run("both empty", func(t *testing.T) {
+		// --- When ---
+		have := SideBySide("", "", 4)
+
+		// --- Then ---
+		affirm.Equal(t, "", have)
+	})
+}
+
+func Test_row_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		left   string
+		marker byte
+		right  string
+		width  int
+		want   string
+	}{
+		{"padded", "ab", ' ', "ab", 4, "ab     ab"},
+		{"new lines removed", "ab\n", '|', "cd\n", 2, "ab | cd"},
+		{"truncated", "abcd", '<', "", 3, "ab… <"},
+		{"unicode", "ąę", ' ', "ąę", 3, "ąę    ąę"},
+		{"zero width", "ab", '|', "cd", 0, "… | cd"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := row(tc.left, tc.marker, tc.right, tc.width)
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_WordDiff_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		before string
+		after  string
+		want   string
+	}{
+		{"equal", "a b", "a b", "a b"},
+		{"changed", "a quick fox", "a slow fox", "a [-quick-]{+slow+} fox"},
+		{"removed", "a quick fox", "a fox", "a[- quick-] fox"},
+		{"added", "a fox", "a red fox", "a{+ red+} fox"},
+		{"both empty", "", "", ""},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := WordDiff(tc.before, tc.after)
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/internal/types"
+)
+
+//goland:noinspection GoUnusedGlobalVariable
+var benchDump string
+
+func BenchmarkDump_Any(b *testing.B) {
+	b.Run("slice of structs", func(b *testing.B) {
+		val := make([]types.TA, 1000)
+		for i := range val {
+			val[i] = types.TA{Int: i, Str: "abc", TAp: &types.TA{Int: i}}
+		}
+		dmp := New()
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		var str string
+		for i := 0; i < b.N; i++ {
+			str = dmp.Any(val)
+		}
+		benchDump = str
+	})
+
+	b.Run("flat slice of structs", func(b *testing.B) {
+		val := make([]types.TA, 1000)
+		for i := range val {
+			val[i] = types.TA{Int: i, Str: "abc", TAp: &types.TA{Int: i}}
+		}
+		dmp := New(WithFlat)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		var str string
+		for i := 0; i < b.N; i++ {
+			str = dmp.Any(val)
+		}
+		benchDump = str
+	})
+}
@@ -5,16 +5,22 @@
 package dump
 
 import (
+	"bufio"
 	"fmt"
+	"io"
 	"log"
 	"maps"
 	"os"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
 
-	"github.com/ctx42/testing/internal/diff"
+	"github.com/ctx42/testing/internal/core"
+	"github.com/ctx42/testing/pkg/diff"
 )
 
 // globLog is a global logger used package-wide.
@@ -29,8 +35,31 @@ const (
 	ValChan       = "<chan>"             // The [reflect.Value] is a channel.
 	ValInvalid    = "<invalid>"          // The [reflect.Value] is invalid.
 	ValMaxNesting = "<...>"              // The maximum nesting reached.
+	ValMaxNestObj = "{...}"              // Max nesting of a composite value.
 	ValEmpty      = "<empty>"            // Empty value.
 	ValErrUsage   = "<dump-usage-error>" // The [reflect.Value] is unexpected in the given context.
+	ValCycle      = "<cycle to %s@%d>"   // Back-reference to a value.
+	ValMoreItems  = "... (+%d more)"     // Number of not dumped items.
+	ValMoreChars  = "…(+%d chars)"       // Number of not dumped string runes.
+	ValRedacted   = "<redacted>"         // The struct field value is redacted.
+	ValOmitted    = "<state omitted>"    // The internal state is not dumped.
+	ValRef        = "&%d → "             // Label of the shared pointer.
+	ValSeeRef     = "<see &%d>"          // Reference to the shared pointer.
+)
+
+// ANSI escape codes used when dumping with colors.
+const (
+	colorReset  = "\x1b[0m"  // Resets the color.
+	colorType   = "\x1b[36m" // Color of type names (cyan).
+	colorField  = "\x1b[34m" // Color of struct field names (blue).
+	colorString = "\x1b[32m" // Color of strings (green).
+	colorNumber = "\x1b[33m" // Color of numbers (yellow).
+)
+
+// Formats of nil values used with [WithNilFormat].
+const (
+	NilPlain = "nil"       // Nil values are dumped as "nil".
+	NilTyped = "(%s)(nil)" // Nil values are dumped with their types.
 )
 
 // Package wide default configuration.
@@ -46,6 +75,9 @@ const (
 
 	// DefaultTabWith is the default tab width in spaces.
 	DefaultTabWith = 2
+
+	// DefaultHTTPBody is the default size of the HTTP body preview in bytes.
+	DefaultHTTPBody = 64
 )
 
 // Package-wide configuration.
@@ -61,6 +93,9 @@ var (
 
 	// TabWidth is a configurable tab width in spaces.
 	TabWidth = DefaultTabWith
+
+	// HTTPBody is a configurable size of the HTTP body preview in bytes.
+	HTTPBody = DefaultHTTPBody
 )
 
 // Types for built-in dumpers.
@@ -71,6 +106,12 @@ var (
 	typError    = reflect.TypeOf((*error)(nil)).Elem()
 )
 
+// Types of interfaces changing how values are dumped.
+var (
+	typStringer   = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	typGoStringer = reflect.TypeOf((*fmt.GoStringer)(nil)).Elem()
+)
+
 var nilVal = reflect.ValueOf(nil)
 
 // Dumper represents function signature for value dumpers.
@@ -79,10 +120,25 @@ type Dumper func(dmp Dump, level int, val reflect.Value) string
 // typeDumpers is the global map of custom dumpers for given types.
 var typeDumpers map[reflect.Type]Dumper
 
+// Register globally registers a custom dumper for a given type. Dumpers
+// registered this way are the baseline configuration of every [Dump] created
+// with [New], so domain-specific types are dumped the same way in all the
+// checks and assertions. Use [WithDumper] to overwrite them for a given
+// instance. It panics if a dumper for the same type is already registered.
+// Dumpers should be registered before creating [Dump] instances, for example,
+// in the init function or TestMain.
+func Register(typ any, fn Dumper) { register(3, typ, fn) }
+
 // RegisterTypeDumper globally registers a custom dumper for a given type.
 // It panics if a dumper for the same type is already registered.
-func RegisterTypeDumper(typ any, dmp Dumper) {
-	if dmp == nil {
+//
+// Deprecated: Use [Register] instead.
+func RegisterTypeDumper(typ any, dmp Dumper) { register(3, typ, dmp) }
+
+// register globally registers a custom dumper for a given type. The depth is
+// the number of stack frames to skip when logging the registration.
+func register(depth int, typ any, fn Dumper) {
+	if fn == nil {
 		panic("cannot register a nil type dumper")
 	}
 	if typeDumpers == nil {
@@ -93,8 +149,8 @@ func RegisterTypeDumper(typ any, dmp Dumper) {
 	if _, ok := typeDumpers[rt]; ok {
 		panic("cannot overwrite an existing type dumper: " + msg)
 	}
-	_ = globLog.Output(2, msg)
-	typeDumpers[rt] = dmp
+	_ = globLog.Output(depth, msg)
+	typeDumpers[rt] = fn
 }
 
 // Option represents a [NewConfig] option.
@@ -113,6 +169,29 @@ func WithFlatStrings(n int) Option {
 	return func(dmp *Dump) { dmp.FlatStrings = n }
 }
 
+// WithFlatCompact is an option for [New] which makes [Dump] display structs,
+// maps, slices, and arrays with no more than n fields or elements in one line
+// when all of them are simple values, for example, "Point{X: 1, Y: 2}". This
+// option is similar to [WithFlatStrings] but applies to composite values
+// based on the number of their elements. Set to zero to turn this feature off.
+func WithFlatCompact(n int) Option {
+	return func(dmp *Dump) { dmp.FlatCompact = n }
+}
+
+// WithMaxStringLen is an option for [New] which makes [Dump] truncate strings
+// longer than n runes. The truncated strings are followed by the number of
+// not displayed runes, for example, "abc"…(+1234 chars). Values less than one
+// turn the truncation off.
+func WithMaxStringLen(n int) Option {
+	return func(dmp *Dump) { dmp.MaxStringLen = n }
+}
+
+// WithRawStrings is an option for [New] which makes [Dump] display multiline
+// strings as they are. By default, non-printable characters and invalid UTF-8
+// bytes in multiline strings are escaped, for example, as "\x00" or "\u202e",
+// so they don't corrupt the terminal output. Other strings are always quoted.
+func WithRawStrings(dmp *Dump) { dmp.RawStrings = true }
+
 // WithCompact is an option for [New] which makes [Dump] display values without
 // unnecessary whitespaces.
 func WithCompact(dmp *Dump) { dmp.Compact = true }
@@ -125,6 +204,82 @@ func WithPtrAddr(dmp *Dump) { dmp.PtrAddr = true }
 // values for not exported fields.
 func WithNoPrivate(dmp *Dump) { dmp.PrintPrivate = false }
 
+// WithSharedPtr is an option for [New] which makes [Dump] label pointers
+// referenced more than once in the dumped value. The first occurrence is
+// prefixed with the label, for example, "&1 → ", and the next occurrences are
+// dumped as a reference to it, for example, "<see &1>". It makes aliasing
+// visible and doesn't repeat the same values.
+func WithSharedPtr(dmp *Dump) { dmp.SharedPtr = true }
+
+// WithNilFormat is an option for [New] which sets the representation of nil
+// pointers, slices, maps, channels, functions, and interfaces. The "%s" in
+// the format is replaced with the type of the value, for example, [NilTyped]
+// displays nil slice of integers as "([]int)(nil)". Untyped nil values are
+// displayed as [ValNil] when the format uses the type. The format may also be
+// a custom string, for example, "<nil>".
+func WithNilFormat(format string) Option {
+	return func(dmp *Dump) { dmp.NilFormat = format }
+}
+
+// WithChanLen is an option for [New] which makes [Dump] display the number of
+// buffered elements and the capacity of channels. See [ChanDumper].
+func WithChanLen(dmp *Dump) { dmp.ChanLen = true }
+
+// WithFuncNames is an option for [New] which makes [Dump] display the names
+// of functions and the places of their definitions. See [FuncDumper].
+func WithFuncNames(dmp *Dump) { dmp.FuncNames = true }
+
+// WithSyncState is an option for [New] which makes [Dump] display the internal
+// state of synchronization primitives like [sync.Mutex]. By default, they are
+// dumped using [SyncDumper].
+func WithSyncState(dmp *Dump) { dmp.SyncState = true }
+
+// WithDynamicTypes is an option for [New] which makes [Dump] prefix values
+// stored in interfaces with their concrete types. For example, the int64
+// value stored in []any is displayed as "int64(7)" instead of "7".
+func WithDynamicTypes(dmp *Dump) { dmp.DynamicTypes = true }
+
+// WithFloatFormat is an option for [New] which sets the format and precision
+// used to display floating point numbers. The arguments have the same meaning
+// as for [strconv.FormatFloat], for example, 'e' and 3 display 1234.5678 as
+// "1.235e+03". The precision -1 uses the smallest number of digits necessary
+// to represent the value exactly. By default, the 'f' format with -1
+// precision is used.
+func WithFloatFormat(format byte, prec int) Option {
+	return func(dmp *Dump) {
+		dmp.FloatFormat = format
+		dmp.FloatPrec = prec
+	}
+}
+
+// WithHTTPHeaders is an option for [New] which limits HTTP headers displayed
+// for [http.Request] and [http.Response] values to the ones with given names.
+// By default, all headers are displayed.
+func WithHTTPHeaders(names ...string) Option {
+	return func(dmp *Dump) {
+		dmp.HTTPHeaders = append(dmp.HTTPHeaders, names...)
+	}
+}
+
+// WithHTTPBody is an option for [New] which sets the maximum number of body
+// bytes displayed for [http.Request] and [http.Response] values. Values less
+// than one turn the body preview off.
+func WithHTTPBody(limit int) Option {
+	return func(dmp *Dump) { dmp.HTTPBody = limit }
+}
+
+// WithErrorChain is an option for [New] which makes [Dump] display errors with
+// the chain of errors they wrap, each with its dynamic type. See
+// [ErrorDumper].
+func WithErrorChain(dmp *Dump) { dmp.ErrorChain = true }
+
+// WithUnsafeUnexported is an option for [New] which makes [Dump] read values
+// of not exported struct fields using the unsafe package. It allows dumping
+// them the same way as exported fields, including custom dumpers,
+// [fmt.Stringer] implementations and errors, which otherwise require
+// [reflect.Value.Interface] not allowed for not exported fields.
+func WithUnsafeUnexported(dmp *Dump) { dmp.UnsafeUnexported = true }
+
 // WithTimeFormat is an option for [New] which makes [Dump] display [time.Time]
 // using a given format. The format might be a standard Go time formating
 // layout or one of the custom values - see [Dump.TimeFormat] for more details.
@@ -145,11 +300,91 @@ func WithDumper(typ any, dumper Dumper) Option {
 }
 
 // WithMaxDepth is an option for [New] which controls maximum nesting when
-// bumping recursive types.
+// dumping recursive types. Composite values (structs, maps, slices and arrays)
+// nested deeper are dumped as [ValMaxNestObj] ("{...}"), other values as
+// [ValMaxNesting] ("<...>").
 func WithMaxDepth(maximum int) Option {
 	return func(dmp *Dump) { dmp.MaxDepth = maximum }
 }
 
+// WithMaxItems is an option for [New] which limits the number of dumped slice,
+// array, and map items. The items above the limit are not dumped, instead, the
+// number of them is shown using the [ValMoreItems] format. Values less than
+// one mean no limit.
+func WithMaxItems(n int) Option {
+	return func(dmp *Dump) { dmp.MaxItems = n }
+}
+
+// WithColor is an option for [New] which turns on colorizing type names, field
+// names, strings, and numbers using ANSI escape codes. The colors are used only
+// when the standard output is a terminal and the NO_COLOR environment variable
+// is not set.
+func WithColor(dmp *Dump) { dmp.Color = core.ColorSupported() }
+
+// WithFormatYAML is an option for [New] which makes [Dump] render values as
+// YAML. It is denser than the default format for deeply nested values and
+// works well with golden files. See [YAMLDumper].
+func WithFormatYAML(dmp *Dump) { dmp.FormatYAML = true }
+
+// WithGoSyntax is an option for [New] which makes [Dump] render values as Go
+// literals which can be copied to the Go source code. See [GoDumper] for
+// details.
+func WithGoSyntax(dmp *Dump) { dmp.GoSyntax = true }
+
+// WithStringer is an option for [New] which makes [Dump] render values
+// implementing [fmt.Stringer] or [fmt.GoStringer] using their own methods
+// instead of reflection. The [fmt.Stringer] is preferred, except when dumping
+// with [Dump.GoSyntax] where only [fmt.GoStringer] is used. Values of the
+// types passed in "skip" are always dumped using reflection, which is useful
+// for types whose String method hides important details.
+func WithStringer(skip ...any) Option {
+	return func(dmp *Dump) {
+		dmp.Stringer = true
+		for _, typ := range skip {
+			dmp.StringerSkip = append(dmp.StringerSkip, reflect.TypeOf(typ))
+		}
+	}
+}
+
+// WithRedact is an option for [New] which makes [Dump] render values of the
+// struct fields with given names as [ValRedacted] ("<redacted>"). Fields with
+// the `dump:"redact"` tag are always redacted.
+func WithRedact(names ...string) Option {
+	return func(dmp *Dump) { dmp.Redact = append(dmp.Redact, names...) }
+}
+
+// WithTags is an option for [New] which makes [Dump] annotate struct field
+// names with values of the given struct tags, for example, "json" or "yaml".
+// Fields without any of the tags are not annotated. The option has no effect
+// on dumps in Go syntax.
+func WithTags(names ...string) Option {
+	return func(dmp *Dump) { dmp.Tags = append(dmp.Tags, names...) }
+}
+
+// WithHexDump is an option for [New] which makes [Dump] render byte slices and
+// arrays longer than the threshold as a hexdump block. See [HexDumpDumper].
+// Values less than one turn the hexdump off. The option has no effect on flat
+// dumps.
+func WithHexDump(threshold int) Option {
+	return func(dmp *Dump) { dmp.HexDump = threshold }
+}
+
+// WithKeySort is an option for [New] which sets the function used to order
+// map keys when dumping maps. The function must return true if the key "a"
+// should be dumped before the key "b". By default, numbers are ordered
+// numerically and strings lexically.
+func WithKeySort(less func(a, b reflect.Value) bool) Option {
+	return func(dmp *Dump) { dmp.KeySort = less }
+}
+
+// WithMaxWidth is an option for [New] which makes [Dump] display structs,
+// maps, slices, and arrays in one line as long as the line, including the
+// indentation, is not wider than the given number of columns. The wider values
+// are displayed in multiple lines. Values less than one turn the feature off.
+func WithMaxWidth(cols int) Option {
+	return func(dmp *Dump) { dmp.MaxWidth = cols }
+}
+
 // WithIndent is an option for [New] which sets additional indentation to apply
 // to dumped values.
 func WithIndent(n int) Option {
@@ -169,6 +404,18 @@ type Dump struct {
 	// Display strings shorter that given value as with Flat.
 	FlatStrings int
 
+	// Display composite values with a few simple elements as with Flat. See
+	// [WithFlatCompact].
+	FlatCompact int
+
+	// Maximum number of string runes to display. Values less than one mean
+	// no limit. See [WithMaxStringLen].
+	MaxStringLen int
+
+	// Do not escape non-printable characters in multiline strings. See
+	// [WithRawStrings].
+	RawStrings bool
+
 	// Do not use any indents or whitespace separators.
 	Compact bool
 
@@ -178,6 +425,8 @@ type Dump struct {
 	// available:
 	//
 	//  - [TimeAsUnix] - Unix timestamp,
+	//  - [TimeAsUnixNano] - Unix timestamp in nanoseconds,
+	//  - [TimeAsGoString] - Go syntax, see [time.Time.GoString],
 	//
 	// By default (empty value) [time.RFC3339Nano] is used.
 	TimeFormat string
@@ -199,9 +448,84 @@ type Dump struct {
 	// Controls if the not exported field values should be printed.
 	PrintPrivate bool
 
+	// Read not exported field values using the unsafe package.
+	UnsafeUnexported bool
+
+	// Label pointers referenced more than once. See [WithSharedPtr].
+	SharedPtr bool
+
+	// Display errors with the chain of wrapped errors. See [WithErrorChain].
+	ErrorChain bool
+
+	// Display internal state of synchronization primitives. See
+	// [WithSyncState].
+	SyncState bool
+
+	// Display the length and capacity of channels. See [WithChanLen].
+	ChanLen bool
+
+	// Representation of nil values. When empty, each dumper uses its own.
+	// See [WithNilFormat].
+	NilFormat string
+
+	// Display function names and definition places. See [WithFuncNames].
+	FuncNames bool
+
+	// Format of floating point numbers as in [strconv.FormatFloat]. When
+	// zero, the 'f' format is used. See [WithFloatFormat].
+	FloatFormat byte
+
+	// Precision of floating point numbers. Used only when FloatFormat is
+	// set. See [WithFloatFormat].
+	FloatPrec int
+
+	// Names of HTTP headers to display. See [WithHTTPHeaders].
+	HTTPHeaders []string
+
+	// Maximum size of HTTP body preview in bytes. See [WithHTTPBody].
+	HTTPBody int
+
 	// Use "any" instead of "interface{}".
 	UseAny bool
 
+	// Prefix values stored in interfaces with their concrete types. See
+	// [WithDynamicTypes].
+	DynamicTypes bool
+
+	// Colorize output using ANSI escape codes. See [WithColor].
+	Color bool
+
+	// Render values as Go literals. See [WithGoSyntax].
+	GoSyntax bool
+
+	// Render values as YAML. See [WithFormatYAML].
+	FormatYAML bool
+
+	// Use [fmt.Stringer] and [fmt.GoStringer] implementations. See
+	// [WithStringer].
+	Stringer bool
+
+	// Types for which [fmt.Stringer] and [fmt.GoStringer] implementations
+	// are not used.
+	StringerSkip []reflect.Type
+
+	// Names of struct fields to redact. See [WithRedact].
+	Redact []string
+
+	// Names of struct tags to display next to field names. See [WithTags].
+	Tags []string
+
+	// Byte slices and arrays longer than this are rendered as hexdump.
+	// Values less than one turn the hexdump off. See [WithHexDump].
+	HexDump int
+
+	// Function ordering map keys. See [WithKeySort].
+	KeySort func(a, b reflect.Value) bool
+
+	// Maximum width of composite values displayed in one line. See
+	// [WithMaxWidth].
+	MaxWidth int
+
 	// Custom type dumpers.
 	//
 	// By default, dumpers for types:
@@ -216,6 +540,10 @@ type Dump struct {
 	// The depth is also used to properly indent values being dumped.
 	MaxDepth int
 
+	// Maximum number of slice, array, and map items to dump.
+	// Values less than one mean no limit.
+	MaxItems int
+
 	// How much additional indentation to apply to values being dumped.
 	Indent int
 
@@ -226,6 +554,186 @@ type Dump struct {
 	// fields to be dumped in flat representation. This value has the same
 	// meaning as the Flat option.
 	flatStrings bool
+
+	// Pointers being dumped with the levels they were dumped at. Used to
+	// detect cycles.
+	visited map[visit]int
+
+	// Shared pointers and their labels. Set when dumping with SharedPtr.
+	refs *refs
+
+	// Cache of dumpers resolved by [Dump.typeDumper]. Set by [New].
+	cache *sync.Map
+}
+
+// colorize wraps the string in the ANSI color escape codes when the
+// [Dump.Color] is set.
+func (dmp Dump) colorize(color, str string) string {
+	if !dmp.Color {
+		return str
+	}
+	return color + str + colorReset
+}
+
+// IsRedacted returns true if the struct field value should be redacted.
+func (dmp Dump) IsRedacted(fld reflect.StructField) bool {
+	if fld.Tag.Get("dump") == "redact" {
+		return true
+	}
+	return slices.Contains(dmp.Redact, fld.Name)
+}
+
+// fieldTags returns struct tags selected with [Dump.Tags] the field has, in
+// the struct tag format. Returns empty string if the field has none of them.
+func (dmp Dump) fieldTags(fld reflect.StructField) string {
+	tags := make([]string, 0, len(dmp.Tags))
+	for _, name := range dmp.Tags {
+		if tag, ok := fld.Tag.Lookup(name); ok {
+			tags = append(tags, name+":"+strconv.Quote(tag))
+		}
+	}
+	if len(tags) == 0 {
+		return ""
+	}
+	return "`" + strings.Join(tags, " ") + "`"
+}
+
+// sortKeys sorts map keys using [Dump.KeySort] when set, otherwise using
+// [core.ValueCmp].
+func (dmp Dump) sortKeys(keys []reflect.Value) {
+	if dmp.KeySort == nil {
+		slices.SortStableFunc(keys, core.ValueCmp)
+		return
+	}
+	slices.SortStableFunc(keys, func(a, b reflect.Value) int {
+		switch {
+		case dmp.KeySort(a, b):
+			return -1
+		case dmp.KeySort(b, a):
+			return 1
+		default:
+			return 0
+		}
+	})
+}
+
+// fitWidth returns a composite value dumped in one line and true when the
+// [Dump.MaxWidth] is set and the line fits in it, or when the value is
+// compact (see [Dump.isCompact]). Otherwise, it returns an empty string and
+// false.
+func (dmp Dump) fitWidth(lvl int, val reflect.Value) (string, bool) {
+	if dmp.Flat || !isComposite(val.Kind()) {
+		return "", false
+	}
+	compact := dmp.isCompact(val)
+	if dmp.MaxWidth < 1 && !compact {
+		return "", false
+	}
+
+	flat := dmp
+	flat.Flat = true
+	flat.Color = false
+	flat.MaxWidth = 0
+	flat.refs = dmp.refs.clone()
+	str, _ := flat.value(lvl, val)
+	width := (dmp.Indent+lvl)*dmp.TabWidth + utf8.RuneCountInString(str)
+	if (!compact && width > dmp.MaxWidth) || strings.Contains(str, "\n") {
+		return "", false
+	}
+	if dmp.Color {
+		flat.Color = true
+		flat.refs = dmp.refs.clone()
+		str, _ = flat.value(lvl, val)
+	}
+	if dmp.refs != nil {
+		*dmp.refs = *flat.refs
+	}
+	prn := NewPrinter(dmp)
+	return prn.Tab(dmp.Indent + lvl).Write(str).String(), true
+}
+
+// isCompact returns true when the [Dump.FlatCompact] is set and the struct,
+// map, slice, or array has no more fields or elements than it, and all of
+// them are simple values (see [Dump.isSimple]).
+func (dmp Dump) isCompact(val reflect.Value) bool {
+	if dmp.FlatCompact < 1 {
+		return false
+	}
+	switch val.Kind() {
+	case reflect.Struct:
+		if val.NumField() > dmp.FlatCompact {
+			return false
+		}
+		for i := 0; i < val.NumField(); i++ {
+			if !dmp.isSimple(val.Field(i)) {
+				return false
+			}
+		}
+
+	case reflect.Map:
+		if val.Len() > dmp.FlatCompact {
+			return false
+		}
+		iter := val.MapRange()
+		for iter.Next() {
+			if !dmp.isSimple(iter.Key()) || !dmp.isSimple(iter.Value()) {
+				return false
+			}
+		}
+
+	case reflect.Slice, reflect.Array:
+		if val.Len() > dmp.FlatCompact {
+			return false
+		}
+		for i := 0; i < val.Len(); i++ {
+			if !dmp.isSimple(val.Index(i)) {
+				return false
+			}
+		}
+
+	default:
+		return false
+	}
+	return true
+}
+
+// isSimple returns true if the value is not a struct, map, slice, or array,
+// or when it has a custom dumper. Interfaces and pointers are checked using
+// values they point to.
+func (dmp Dump) isSimple(val reflect.Value) bool {
+	if val.Kind() == reflect.Interface && !val.IsNil() {
+		val = val.Elem()
+	}
+	if val.Kind() == reflect.Pointer && !val.IsNil() {
+		val = val.Elem()
+	}
+	if !isComposite(val.Kind()) {
+		return true
+	}
+	_, ok := dmp.Dumpers[val.Type()]
+	return ok
+}
+
+// maxItems returns the number of items to dump out of "num" items.
+func (dmp Dump) maxItems(num int) int {
+	if dmp.MaxItems > 0 && num > dmp.MaxItems {
+		return dmp.MaxItems
+	}
+	return num
+}
+
+// moreItems writes to the printer the number of not dumped items if "more" is
+// greater than zero.
+func (dmp Dump) moreItems(prn Printer, lvl, more int) {
+	if more > 0 {
+		prn.Tab(dmp.Indent + lvl).Write(fmt.Sprintf(ValMoreItems, more)).NL()
+	}
+}
+
+// visit represents a pointer being dumped.
+type visit struct {
+	ptr uintptr      // The pointer address.
+	typ reflect.Type // The pointer type.
 }
 
 // New returns new instance of [Dump].
@@ -240,6 +748,8 @@ func New(opts ...Option) Dump {
 		MaxDepth:     Depth,
 		Indent:       Indent,
 		TabWidth:     TabWidth,
+		HTTPBody:     HTTPBody,
+		cache:        &sync.Map{},
 	}
 	if dmp.Dumpers == nil {
 		dmp.Dumpers = make(map[reflect.Type]Dumper)
@@ -259,13 +769,294 @@ func New(opts ...Option) Dump {
 	if _, ok := dmp.Dumpers[typDur]; !ok {
 		dmp.Dumpers[typDur] = GetDurDumper(dmp.DurationFormat)
 	}
+
+	for typ, dpr := range map[reflect.Type]Dumper{
+		typReflectType:  ReflectTypeDumper,
+		typReflectRType: ReflectTypeDumper,
+		typReflectValue: ReflectValueDumper,
+		typHTTPRequest:  HTTPRequestDumper,
+		typHTTPResponse: HTTPResponseDumper,
+	} {
+		if _, ok := dmp.Dumpers[typ]; !ok {
+			dmp.Dumpers[typ] = dpr
+		}
+	}
+
+	if !dmp.SyncState {
+		for _, typ := range syncTypes {
+			if _, ok := dmp.Dumpers[typ]; !ok {
+				dmp.Dumpers[typ] = SyncDumper
+			}
+		}
+	}
 	return dmp
 }
 
 // Any dumps any value to its string representation.
 func (dmp Dump) Any(val any) string {
-	str, _ := dmp.value(0, reflect.ValueOf(val))
-	return str
+	var buf strings.Builder
+	dmp.write(newWriterPrinter(dmp, &buf), 0, reflect.ValueOf(val))
+	return buf.String()
+}
+
+// Labeled represents a value with a label, which is used by [Dump.Values].
+type Labeled struct {
+	Label string // The value label.
+	Value any    // The value to dump.
+}
+
+// Label returns the value with a label for [Dump.Values].
+func Label(label string, val any) Labeled {
+	return Labeled{Label: label, Value: val}
+}
+
+// Values dumps values to their string representations using the same
+// configuration for each of them. Values are separated with new lines, or
+// with commas when [Dump.Flat] is set. The values created with [Label] are
+// prefixed with their labels.
+//
+// Example:
+//
+//	dmp.Values(dump.Label("want", 1), dump.Label("have", "a"), true)
+//
+//	want: 1
+//	have: "a"
+//	true
+func (dmp Dump) Values(vals ...any) string {
+	var buf strings.Builder
+	prn := newWriterPrinter(dmp, &buf)
+	for i, val := range vals {
+		if i > 0 && dmp.Flat {
+			prn.Write(",").Sep(false)
+		} else if i > 0 {
+			prn.NL()
+		}
+		if lbl, ok := val.(Labeled); ok {
+			prn.Write(lbl.Label).Write(":").Space()
+			val = lbl.Value
+		}
+		dmp.write(prn, 0, reflect.ValueOf(val))
+	}
+	return buf.String()
+}
+
+// Write dumps any value to the writer. Unlike [Dump.Any], it doesn't build the
+// whole representation in memory; slices, arrays, maps, and structs are
+// written incrementally, one element or field at a time. Returns the first
+// error encountered while writing.
+func (dmp Dump) Write(w io.Writer, val any) error {
+	bw := bufio.NewWriter(w)
+	dmp.write(newWriterPrinter(dmp, bw), 0, reflect.ValueOf(val))
+	return bw.Flush()
+}
+
+// write writes the value representation to the printer. Slices, arrays, maps,
+// and structs which would be dumped by [ArrayDumper], [MapDumper], or
+// [StructDumper] are written incrementally.
+func (dmp Dump) write(prn Printer, lvl int, val reflect.Value) {
+	if dmp.SharedPtr && dmp.refs == nil && !dmp.GoSyntax && !dmp.FormatYAML {
+		dmp.refs = newRefs(dmp, val)
+	}
+	if !dmp.streamable(lvl, val) {
+		str, _ := dmp.value(lvl, val)
+		prn.Write(str)
+		return
+	}
+
+	switch val.Kind() {
+	case reflect.Interface:
+		dmp.write(prn, lvl, val.Elem())
+
+	case reflect.Pointer:
+		vis := visit{ptr: val.Pointer(), typ: val.Type()}
+		if dmp.visited == nil {
+			dmp.visited = make(map[visit]int)
+		}
+		dmp.visited[vis] = lvl
+		dmp.write(prn, lvl, val.Elem())
+		delete(dmp.visited, vis)
+
+	case reflect.Slice, reflect.Array:
+		dmp.writeArray(prn, lvl, val)
+
+	case reflect.Map:
+		dmp.writeMap(prn, lvl, val)
+
+	case reflect.Struct:
+		dmp.writeStruct(prn, lvl, val)
+	}
+}
+
+// streamable returns true if the value can be written incrementally by
+// [Dump.write]. It must match the logic of [Dump.value], so the written
+// representation is the same.
+//
+// nolint: cyclop
+func (dmp Dump) streamable(lvl int, val reflect.Value) bool {
+	if lvl > dmp.MaxDepth || dmp.GoSyntax || dmp.FormatYAML || !val.IsValid() {
+		return false
+	}
+
+	typ := val.Type()
+	switch knd := val.Kind(); knd {
+	case reflect.Interface, reflect.Slice:
+		if val.IsNil() || (dmp.DynamicTypes && knd == reflect.Interface) {
+			return false
+		}
+	case reflect.Pointer:
+		if val.IsNil() {
+			return false
+		}
+		vis := visit{ptr: val.Pointer(), typ: typ}
+		if _, ok := dmp.visited[vis]; ok || dmp.refs.isShared(vis) {
+			return false
+		}
+	case reflect.Array, reflect.Map, reflect.Struct:
+	default:
+		return false
+	}
+
+	if _, ok := dmp.Dumpers[typ]; ok || isContext(val) {
+		return false
+	}
+	if _, ok := dmp.nilValue(lvl, val); ok {
+		return false
+	}
+	if typ == typError || typ.String() == "*errors.errorString" {
+		return false
+	}
+	if _, ok := errorValue(val); ok && dmp.ErrorChain {
+		return false
+	}
+	if _, ok := dmp.stringer(val); ok {
+		return false
+	}
+	probe := dmp // Labels assigned when probing are not kept.
+	probe.refs = dmp.refs.clone()
+	if _, ok := probe.fitWidth(lvl, val); ok {
+		return false
+	}
+	return true
+}
+
+// typeDumper returns the dumper for values of the given type resolved by
+// their kind. Returns nil when the dumper must be resolved for each value, for
+// example, when the type implements [fmt.Stringer] or error interface.
+// Resolved dumpers are cached, so dumping many values of the same type
+// doesn't resolve it again.
+func (dmp Dump) typeDumper(typ reflect.Type) Dumper {
+	if dmp.cache == nil {
+		return resolveDumper(typ)
+	}
+	if fn, ok := dmp.cache.Load(typ); ok {
+		return fn.(Dumper) // nolint: forcetypeassert
+	}
+	fn := resolveDumper(typ)
+	dmp.cache.Store(typ, fn)
+	return fn
+}
+
+// resolveDumper returns the dumper for values of the given type resolved by
+// their kind. Returns nil for types which may be dumped differently depending
+// on the value or configuration.
+func resolveDumper(typ reflect.Type) Dumper {
+	for _, itf := range []reflect.Type{
+		typStringer, typGoStringer, typError, typContext,
+	} {
+		if typ.Implements(itf) {
+			return nil
+		}
+	}
+	return kindDumper(typ.Kind())
+}
+
+// kindDumper returns the dumper for values of the given kind. Returns nil for
+// kinds which must be dumped by [Dump.value].
+func kindDumper(knd reflect.Kind) Dumper {
+	switch knd {
+	case reflect.Bool, reflect.String, reflect.Float32, reflect.Float64:
+		return SimpleDumper
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		return SimpleDumper
+	case reflect.Uint, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return SimpleDumper
+	case reflect.Uint8, reflect.Uintptr, reflect.UnsafePointer:
+		return HexPtrDumper
+	case reflect.Complex64, reflect.Complex128:
+		return ComplexDumper
+	case reflect.Array:
+		return ArrayDumper
+	case reflect.Slice:
+		return SliceDumper
+	case reflect.Map:
+		return MapDumper
+	case reflect.Struct:
+		return StructDumper
+	case reflect.Chan:
+		return ChanDumper
+	case reflect.Func:
+		return FuncDumper
+	}
+	return nil
+}
+
+// nilValue returns the nil value representation in the format set with
+// [Dump.NilFormat] and true. Returns false when the value is not nil or the
+// format is not set.
+func (dmp Dump) nilValue(lvl int, val reflect.Value) (string, bool) {
+	if dmp.NilFormat == "" {
+		return "", false
+	}
+	var name string
+	switch val.Kind() {
+	case reflect.Invalid:
+		if nilVal != val { // nolint: govet
+			return "", false
+		}
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Chan,
+		reflect.Func, reflect.Interface:
+		if !val.IsNil() {
+			return "", false
+		}
+		name = val.Type().String()
+		if dmp.UseAny {
+			name = strings.ReplaceAll(name, "interface {}", "any")
+		}
+	default:
+		return "", false
+	}
+
+	str := dmp.NilFormat
+	if strings.Contains(str, "%s") {
+		str = ValNil
+		if name != "" {
+			str = strings.ReplaceAll(dmp.NilFormat, "%s", name)
+		}
+	}
+	prn := NewPrinter(dmp)
+	return prn.Tab(dmp.Indent + lvl).Write(str).String(), true
+}
+
+// dynamicType prefixes the representation of the value stored in an interface
+// with its concrete type. Representations starting with "{" are prefixed with
+// the type, the other ones are wrapped in parentheses, for example,
+// "int64(7)".
+func (dmp Dump) dynamicType(lvl int, val reflect.Value, str string) string {
+	tab := NewPrinter(dmp).Tab(dmp.Indent + lvl).String()
+	str = strings.TrimPrefix(str, tab)
+
+	name := val.Type().String()
+	if dmp.UseAny {
+		name = strings.ReplaceAll(name, "interface {}", "any")
+	}
+	if !strings.HasPrefix(str, "{") {
+		if val.Kind() == reflect.Pointer {
+			name = "(" + name + ")"
+		}
+		str = "(" + str + ")"
+	}
+	return tab + dmp.colorize(colorType, name) + str
 }
 
 // Diff compares two values and returns their formatted representations and
@@ -316,10 +1107,24 @@ func (dmp Dump) DiffValue(wVal, hVal reflect.Value) (string, string, string) {
 		return wStr, hStr, ""
 	}
 
-	edits := diff.Strings(hDiffStr, wDiffStr)
-	// Error can't happen: edits are consistent.
-	unified, _ := diff.CtxToUnified("want", "have", hDiffStr, edits, 2)
-	return wStr, hStr, strings.TrimRight(unified, "\n")
+	return wStr, hStr, unifiedDiff(wDiffStr, hDiffStr)
+}
+
+// Diff dumps both values using [Dump] configured with given options and
+// returns the line-level unified diff of them, with the context lines around
+// the differing lines. Unlike [Dump.Diff], the diff is returned also for
+// values dumped as single lines. Returns empty string if the dumps are equal.
+func Diff(want, have any, opts ...Option) string {
+	dmp := New(opts...)
+	wStr, _ := dmp.forDiff(reflect.ValueOf(want))
+	hStr, _ := dmp.forDiff(reflect.ValueOf(have))
+	return unifiedDiff(wStr, hStr)
+}
+
+// unifiedDiff returns the unified diff of the dumped "want" and "have"
+// values. Returns empty string if they are equal.
+func unifiedDiff(wStr, hStr string) string {
+	return diff.Unified(hStr, wStr, 2)
 }
 
 // forDiff prepares a value for diffing by formatting it into a string. Returns
@@ -328,6 +1133,7 @@ func (dmp Dump) forDiff(val reflect.Value) (string, reflect.Kind) {
 	dmp.Flat = false
 	dmp.FlatStrings = 0
 	dmp.Compact = false
+	dmp.Color = false
 
 	str, knd := dmp.value(0, val)
 	if s, err := strconv.Unquote(str); err == nil {
@@ -336,10 +1142,65 @@ func (dmp Dump) forDiff(val reflect.Value) (string, reflect.Kind) {
 	return str, knd
 }
 
+// stringer returns the value representation using its [fmt.Stringer] or
+// [fmt.GoStringer] implementation. Returns false when [Dump.Stringer] is not
+// set, the value doesn't implement any of the interfaces (only
+// [fmt.GoStringer] is considered for [Dump.GoSyntax]), its type is in
+// [Dump.StringerSkip], or the method panics. The [fmt.Stringer] result is
+// quoted.
+func (dmp Dump) stringer(val reflect.Value) (str string, ok bool) {
+	if !dmp.Stringer || !val.IsValid() || !val.CanInterface() {
+		return "", false
+	}
+	switch val.Kind() {
+	case reflect.Interface:
+		return "", false // Decided when dumping the interface value.
+	case reflect.Pointer, reflect.Map, reflect.Slice:
+		if val.IsNil() {
+			return "", false
+		}
+	}
+	if slices.Contains(dmp.StringerSkip, val.Type()) {
+		return "", false
+	}
+
+	defer func() {
+		if recover() != nil {
+			str, ok = "", false
+		}
+	}()
+
+	gs, isGS := val.Interface().(fmt.GoStringer)
+	s, isS := val.Interface().(fmt.Stringer)
+	switch {
+	case isGS && (dmp.GoSyntax || !isS):
+		return gs.GoString(), true
+	case isS && !dmp.GoSyntax:
+		return strconv.Quote(s.String()), true
+	}
+	return "", false
+}
+
+// sharedPtr dumps the pointer referenced more than once in the dumped value.
+// The first occurrence is prefixed with [ValRef] label, the next ones are
+// dumped as [ValSeeRef].
+func (dmp Dump) sharedPtr(lvl int, val reflect.Value) (string, reflect.Kind) {
+	n, seen := dmp.refs.label(visit{ptr: val.Pointer(), typ: val.Type()})
+	if seen {
+		prn := NewPrinter(dmp).Tab(dmp.Indent + lvl)
+		return prn.Write(fmt.Sprintf(ValSeeRef, n)).String(), reflect.Pointer
+	}
+	str, knd := dmp.value(lvl, val.Elem())
+	trimmed := strings.TrimLeft(str, " \t")
+	lbl := fmt.Sprintf(ValRef, n)
+	return str[:len(str)-len(trimmed)] + lbl + trimmed, knd
+}
+
 // Value dumps a [reflect.Value] representation of a value as a string.
 func (dmp Dump) Value(val reflect.Value) string {
-	str, _ := dmp.value(0, val)
-	return str
+	var buf strings.Builder
+	dmp.write(newWriterPrinter(dmp, &buf), 0, val)
+	return buf.String()
 }
 
 // value dumps given a value as a string.
@@ -347,7 +1208,19 @@ func (dmp Dump) Value(val reflect.Value) string {
 // nolint: cyclop
 func (dmp Dump) value(lvl int, val reflect.Value) (string, reflect.Kind) {
 	if lvl > dmp.MaxDepth {
-		return ValMaxNesting, reflect.Invalid
+		return maxNesting(val), reflect.Invalid
+	}
+
+	if dmp.GoSyntax {
+		return GoDumper(dmp, lvl, val), val.Kind()
+	}
+
+	if dmp.FormatYAML {
+		return YAMLDumper(dmp, lvl, val), val.Kind()
+	}
+
+	if dmp.SharedPtr && dmp.refs == nil {
+		dmp.refs = newRefs(dmp, val)
 	}
 
 	var str string // One or more lines representing passed value.
@@ -359,17 +1232,55 @@ func (dmp Dump) value(lvl int, val reflect.Value) (string, reflect.Kind) {
 		}
 	}
 
+	if str, ok := dmp.nilValue(lvl, val); ok {
+		return str, knd
+	}
+
+	if knd != reflect.Invalid {
+		if (dmp.MaxWidth < 1 && dmp.FlatCompact < 1) || !isComposite(knd) {
+			if fn := dmp.typeDumper(val.Type()); fn != nil {
+				return fn(dmp, lvl, val), knd
+			}
+		}
+	}
+
+	if isContext(val) {
+		return ContextDumper(dmp, lvl, val), knd
+	}
+
+	if dmp.DynamicTypes && knd == reflect.Interface && !val.IsNil() {
+		str, knd = dmp.value(lvl, val.Elem())
+		return dmp.dynamicType(lvl, val.Elem(), str), knd
+	}
+
+	if str, ok := dmp.stringer(val); ok {
+		prn := NewPrinter(dmp)
+		str = dmp.colorize(colorString, str)
+		return prn.Tab(dmp.Indent + lvl).Write(str).String(), knd
+	}
+
+	if dmp.ErrorChain {
+		if _, ok := errorValue(val); ok {
+			return ErrorDumper(dmp, lvl, val), knd
+		}
+	}
+
 	if val.IsValid() {
 		typ := val.Type()
 		// Special case for type: error.
 		if typ == typError || typ.String() == "*errors.errorString" {
-			err := val.Interface().(error) // nolint: forcetypeassert
-			str = fmt.Sprintf("%q", err.Error())
-			prn := NewPrinter(dmp)
-			return prn.Tab(dmp.Indent + lvl).Write(str).String(), knd
+			if err, ok := val.Interface().(error); ok {
+				str = fmt.Sprintf("%q", err.Error())
+				prn := NewPrinter(dmp)
+				return prn.Tab(dmp.Indent + lvl).Write(str).String(), knd
+			}
 		}
 	}
 
+	if str, ok := dmp.fitWidth(lvl, val); ok {
+		return str, knd
+	}
+
 	switch knd {
 	case reflect.Invalid:
 		str = ValInvalid
@@ -377,63 +1288,32 @@ func (dmp Dump) value(lvl int, val reflect.Value) (string, reflect.Kind) {
 			str = ValNil
 		}
 
-	case reflect.Bool, reflect.Int:
-		str = SimpleDumper(dmp, lvl, val)
-
-	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		str = SimpleDumper(dmp, lvl, val)
-
-	case reflect.Uint:
-		str = SimpleDumper(dmp, lvl, val)
-
-	case reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		str = SimpleDumper(dmp, lvl, val)
-
-	case reflect.Uint8:
-		str = HexPtrDumper(dmp, lvl, val)
-
-	case reflect.Uintptr:
-		str = HexPtrDumper(dmp, lvl, val)
-
-	case reflect.Float32, reflect.Float64:
-		str = SimpleDumper(dmp, lvl, val)
-
-	case reflect.Complex64, reflect.Complex128:
-		str = ComplexDumper(dmp, lvl, val)
-
-	case reflect.Array:
-		str = ArrayDumper(dmp, lvl, val)
-
-	case reflect.Chan:
-		str = ChanDumper(dmp, lvl, val)
-
-	case reflect.Func:
-		str = FuncDumper(dmp, lvl, val)
-
 	case reflect.Interface:
 		str, knd = dmp.value(lvl, val.Elem())
 
-	case reflect.Map:
-		str = MapDumper(dmp, lvl, val)
-
 	case reflect.Pointer:
 		if val.IsNil() {
 			str = ValNil
-		} else {
-			str, knd = dmp.value(lvl, val.Elem())
+			break
 		}
+		vis := visit{ptr: val.Pointer(), typ: val.Type()}
+		if dmp.refs.isShared(vis) {
+			str, knd = dmp.sharedPtr(lvl, val)
+			break
+		}
+		if at, ok := dmp.visited[vis]; ok {
+			str = fmt.Sprintf(ValCycle, typeName(val.Type().Elem()), at)
+			break
+		}
+		if dmp.visited == nil {
+			dmp.visited = make(map[visit]int)
+		}
+		dmp.visited[vis] = lvl
+		str, knd = dmp.value(lvl, val.Elem())
+		delete(dmp.visited, vis)
 
-	case reflect.Slice:
-		str = SliceDumper(dmp, lvl, val)
-
-	case reflect.String:
-		str = SimpleDumper(dmp, lvl, val)
-
-	case reflect.Struct:
-		str = StructDumper(dmp, lvl, val)
-
-	case reflect.UnsafePointer:
-		str = HexPtrDumper(dmp, lvl, val)
+	default:
+		str = kindDumper(knd)(dmp, lvl, val)
 	}
 
 	return str, knd
@@ -7,9 +7,12 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"log"
+	"os"
 	"reflect"
 	strings "strings"
+	"sync"
 	"testing"
 	"time"
 	"unsafe"
@@ -20,6 +23,76 @@ import (
 	"github.com/ctx42/testing/pkg/goldy"
 )
 
+func Test_Register(t *testing.T) {
+	t.Setenv("___", "___")
+	affirm.Nil(t, typeDumpers)
+	origLog := globLog
+	buf := &bytes.Buffer{}
+	globLog = log.New(buf, "", log.Lshortfile)
+	dmp := func(Dump, int, reflect.Value) string { return "123456" }
+	t.Cleanup(func() { globLog = origLog; typeDumpers = nil })
+
+	t.Run("is registered", func(t *testing.T) {
+		// --- Given ---
+		type custom struct{}
+		t.Cleanup(func() { typeDumpers = nil; buf.Reset() })
+
+		// --- When ---
+		Register(custom{}, dmp)
+
+		// --- Then ---
+		affirm.Equal(t, 1, len(typeDumpers))
+		wChk := core.Same(dmp, typeDumpers[reflect.TypeOf(custom{})])
+		affirm.Equal(t, true, wChk)
+		wMsg := "Registering type dumper for: dump.custom\n"
+		affirm.Equal(t, true, strings.HasPrefix(buf.String(), "dump_test.go:"))
+		affirm.Equal(t, true, strings.HasSuffix(buf.String(), wMsg))
+	})
+
+	t.Run("used by New", func(t *testing.T) {
+		// --- Given ---
+		type custom struct{}
+		t.Cleanup(func() { typeDumpers = nil; buf.Reset() })
+		Register(custom{}, dmp)
+
+		// --- When ---
+		have := New().Any(custom{})
+
+		// --- Then ---
+		affirm.Equal(t, "123456", have)
+	})
+
+	t.Run("panics if already registered", func(t *testing.T) {
+		// --- Given ---
+		type custom struct{}
+		t.Cleanup(func() { typeDumpers = nil; buf.Reset() })
+		typeDumpers = map[reflect.Type]Dumper{reflect.TypeOf(custom{}): dmp}
+
+		// --- When ---
+		fn := func() { Register(custom{}, dmp) }
+		msg := affirm.Panic(t, fn)
+
+		// --- Then ---
+		wMsg := "cannot overwrite an existing type dumper"
+		affirm.Equal(t, true, strings.Contains(*msg, wMsg))
+		affirm.Equal(t, "", buf.String())
+	})
+
+	t.Run("panics if the dumper is nil", func(t *testing.T) {
+		// --- Given ---
+		type custom struct{}
+		t.Cleanup(func() { typeDumpers = nil; buf.Reset() })
+
+		// --- When ---
+		fn := func() { Register(custom{}, nil) }
+		msg := affirm.Panic(t, fn)
+
+		// --- Then ---
+		affirm.Equal(t, "cannot register a nil type dumper", *msg)
+		affirm.Equal(t, "", buf.String())
+	})
+}
+
 func Test_RegisterTypeDumper(t *testing.T) {
 	t.Setenv("___", "___")
 	affirm.Nil(t, typeDumpers)
@@ -98,6 +171,28 @@ func Test_WithFlatStrings(t *testing.T) {
 	affirm.Equal(t, 123, dmp.FlatStrings)
 }
 
+func Test_WithMaxStringLen(t *testing.T) {
+	// --- Given ---
+	dmp := &Dump{}
+
+	// --- When ---
+	WithMaxStringLen(10)(dmp)
+
+	// --- Then ---
+	affirm.Equal(t, 10, dmp.MaxStringLen)
+}
+
+func Test_WithRawStrings(t *testing.T) {
+	// --- Given ---
+	dmp := &Dump{}
+
+	// --- When ---
+	WithRawStrings(dmp)
+
+	// --- Then ---
+	affirm.Equal(t, true, dmp.RawStrings)
+}
+
 func Test_WithCompact(t *testing.T) {
 	// --- Given ---
 	dmp := &Dump{}
@@ -131,6 +226,212 @@ func Test_WithNoPrivate(t *testing.T) {
 	affirm.Equal(t, false, dmp.PrintPrivate)
 }
 
+func Test_WithUnsafeUnexported(t *testing.T) {
+	// --- Given ---
+	dmp := &Dump{}
+
+	// --- When ---
+	WithUnsafeUnexported(dmp)
+
+	// --- Then ---
+	affirm.Equal(t, true, dmp.UnsafeUnexported)
+}
+
+func Test_WithSharedPtr(t *testing.T) {
+	// --- Given ---
+	dmp := &Dump{}
+
+	// --- When ---
+	WithSharedPtr(dmp)
+
+	// --- Then ---
+	affirm.Equal(t, true, dmp.SharedPtr)
+}
+
+func Test_WithErrorChain(t *testing.T) {
+	// --- Given ---
+	dmp := &Dump{}
+
+	// --- When ---
+	WithErrorChain(dmp)
+
+	// --- Then ---
+	affirm.Equal(t, true, dmp.ErrorChain)
+}
+
+func Test_WithNilFormat(t *testing.T) {
+	// --- Given ---
+	dmp := &Dump{}
+
+	// --- When ---
+	WithNilFormat(NilTyped)(dmp)
+
+	// --- Then ---
+	affirm.Equal(t, NilTyped, dmp.NilFormat)
+}
+
+func Test_Dump_Any_NilFormat_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		format string
+		val    any
+		want   string
+	}{
+		{"plain pointer", NilPlain, (*int)(nil), "nil"},
+		{"plain slice", NilPlain, []int(nil), "nil"},
+		{"plain map", NilPlain, map[string]int(nil), "nil"},
+		{"plain chan", NilPlain, (chan int)(nil), "nil"},
+		{"plain func", NilPlain, (func())(nil), "nil"},
+		{"plain untyped", NilPlain, nil, "nil"},
+		{"typed pointer", NilTyped, (*int)(nil), "(*int)(nil)"},
+		{"typed slice", NilTyped, []int(nil), "([]int)(nil)"},
+		{"typed map", NilTyped, map[string]int(nil), "(map[string]int)(nil)"},
+		{"typed chan", NilTyped, (chan int)(nil), "(chan int)(nil)"},
+		{"typed func", NilTyped, (func())(nil), "(func())(nil)"},
+		{"typed untyped", NilTyped, nil, "nil"},
+		{"custom", "<nil>", []int(nil), "<nil>"},
+		{"custom untyped", "<nil>", nil, "<nil>"},
+		{"not nil", NilTyped, []int{}, "[]int{}"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			dmp := New(WithNilFormat(tc.format))
+
+			// --- When ---
+			have := dmp.Any(tc.val)
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_Dump_Any_NilFormat(t *testing.T) {
+	t.Run("struct fields", func(t *testing.T) {
+		// --- Given ---
+		type T struct {
+			Any any
+			Err error
+			Map map[string]int
+		}
+		dmp := New(WithNilFormat(NilTyped))
+
+		// --- When ---
+		have := dmp.Any(T{})
+
+		// --- Then ---
+		want := "{\n" +
+			"  Any: (any)(nil),\n" +
+			"  Err: (error)(nil),\n" +
+			"  Map: (map[string]int)(nil),\n" +
+			"}"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("slice elements", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithNilFormat("<nil>"))
+
+		// --- When ---
+		have := dmp.Any([]*int{nil, nil})
+
+		// --- Then ---
+		affirm.Equal(t, "[]*int{\n  <nil>,\n  <nil>,\n}", have)
+	})
+
+	t.Run("uses indent and level", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithNilFormat(NilTyped), WithIndent(1))
+
+		// --- When ---
+		have, _ := dmp.value(1, reflect.ValueOf([]int(nil)))
+
+		// --- Then ---
+		affirm.Equal(t, "    ([]int)(nil)", have)
+	})
+}
+
+func Test_WithChanLen(t *testing.T) {
+	// --- Given ---
+	dmp := &Dump{}
+
+	// --- When ---
+	WithChanLen(dmp)
+
+	// --- Then ---
+	affirm.Equal(t, true, dmp.ChanLen)
+}
+
+func Test_WithFuncNames(t *testing.T) {
+	// --- Given ---
+	dmp := &Dump{}
+
+	// --- When ---
+	WithFuncNames(dmp)
+
+	// --- Then ---
+	affirm.Equal(t, true, dmp.FuncNames)
+}
+
+func Test_WithSyncState(t *testing.T) {
+	// --- Given ---
+	dmp := &Dump{}
+
+	// --- When ---
+	WithSyncState(dmp)
+
+	// --- Then ---
+	affirm.Equal(t, true, dmp.SyncState)
+}
+
+func Test_WithDynamicTypes(t *testing.T) {
+	// --- Given ---
+	dmp := &Dump{}
+
+	// --- When ---
+	WithDynamicTypes(dmp)
+
+	// --- Then ---
+	affirm.Equal(t, true, dmp.DynamicTypes)
+}
+
+func Test_WithFloatFormat(t *testing.T) {
+	// --- Given ---
+	dmp := &Dump{}
+
+	// --- When ---
+	WithFloatFormat('e', 3)(dmp)
+
+	// --- Then ---
+	affirm.Equal(t, byte('e'), dmp.FloatFormat)
+	affirm.Equal(t, 3, dmp.FloatPrec)
+}
+
+func Test_WithHTTPHeaders(t *testing.T) {
+	// --- Given ---
+	dmp := &Dump{HTTPHeaders: []string{"A"}}
+
+	// --- When ---
+	WithHTTPHeaders("B", "C")(dmp)
+
+	// --- Then ---
+	affirm.DeepEqual(t, []string{"A", "B", "C"}, dmp.HTTPHeaders)
+}
+
+func Test_WithHTTPBody(t *testing.T) {
+	// --- Given ---
+	dmp := &Dump{}
+
+	// --- When ---
+	WithHTTPBody(16)(dmp)
+
+	// --- Then ---
+	affirm.Equal(t, 16, dmp.HTTPBody)
+}
+
 func Test_WithTimeFormat(t *testing.T) {
 	// --- Given ---
 	dmp := &Dump{}
@@ -155,202 +456,1366 @@ func Test_WithMaxDepth(t *testing.T) {
 	affirm.Equal(t, 10, dmp.MaxDepth)
 }
 
-func Test_WithIndent(t *testing.T) {
+func Test_WithMaxItems(t *testing.T) {
 	// --- Given ---
 	dmp := &Dump{}
 
 	// --- When ---
-	opt := WithIndent(10)
+	opt := WithMaxItems(10)
 
 	// --- Then ---
 	opt(dmp)
-	affirm.Equal(t, 10, dmp.Indent)
+	affirm.Equal(t, 10, dmp.MaxItems)
 }
 
-func Test_WithTabWidth(t *testing.T) {
+func Test_WithColor(t *testing.T) {
+	t.Run("disabled with NO_COLOR", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv("NO_COLOR", "1")
+		dmp := &Dump{Color: true}
+
+		// --- When ---
+		WithColor(dmp)
+
+		// --- Then ---
+		affirm.Equal(t, false, dmp.Color)
+	})
+
+	t.Run("disabled when not a terminal", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv("NO_COLOR", "")
+		stdout := os.Stdout
+		t.Cleanup(func() { os.Stdout = stdout })
+		fil, err := os.CreateTemp(t.TempDir(), "stdout")
+		affirm.Nil(t, err)
+		t.Cleanup(func() { _ = fil.Close() })
+		os.Stdout = fil
+		dmp := &Dump{Color: true}
+
+		// --- When ---
+		WithColor(dmp)
+
+		// --- Then ---
+		affirm.Equal(t, false, dmp.Color)
+	})
+}
+
+func Test_Dump_colors(t *testing.T) {
+	// --- Given ---
+	type T struct {
+		Int   int
+		Str   string
+		Slice []int
+		Map   map[string]int
+	}
+	val := T{Int: 1, Str: "a", Slice: []int{2}, Map: map[string]int{"b": 3}}
+	dmp := New(WithFlat, WithCompact)
+	dmp.Color = true
+
+	// --- When ---
+	have := dmp.Any(val)
+
+	// --- Then ---
+	want := "{" +
+		"\x1b[34mInt\x1b[0m:\x1b[33m1\x1b[0m," +
+		"\x1b[34mStr\x1b[0m:\x1b[32m\"a\"\x1b[0m," +
+		"\x1b[34mSlice\x1b[0m:\x1b[36m[]int\x1b[0m{\x1b[33m2\x1b[0m}," +
+		"\x1b[34mMap\x1b[0m:\x1b[36mmap[string]int\x1b[0m{" +
+		"\x1b[32m\"b\"\x1b[0m:\x1b[33m3\x1b[0m" +
+		"}" +
+		"}"
+	affirm.Equal(t, want, have)
+}
+
+func Test_Dump_Diff_without_colors(t *testing.T) {
+	// --- Given ---
+	dmp := New()
+	dmp.Color = true
+
+	// --- When ---
+	_, _, diff := dmp.Diff([]int{1, 2}, []int{1, 3})
+
+	// --- Then ---
+	affirm.Equal(t, false, strings.Contains(diff, "\x1b["))
+}
+
+func Test_WithFormatYAML(t *testing.T) {
 	// --- Given ---
 	dmp := &Dump{}
 
 	// --- When ---
-	opt := WithTabWidth(10)
+	WithFormatYAML(dmp)
+
+	// --- Then ---
+	affirm.Equal(t, true, dmp.FormatYAML)
+}
+
+func Test_WithGoSyntax(t *testing.T) {
+	// --- Given ---
+	dmp := &Dump{}
+
+	// --- When ---
+	WithGoSyntax(dmp)
+
+	// --- Then ---
+	affirm.Equal(t, true, dmp.GoSyntax)
+}
+
+func Test_Dump_Any_GoSyntax(t *testing.T) {
+	// --- Given ---
+	val := map[string]*types.TIntStr{"a": {Int: 1, Str: "b"}}
+	dmp := New(WithFlat, WithGoSyntax)
+
+	// --- When ---
+	have := dmp.Any(val)
+
+	// --- Then ---
+	want := `map[string]*types.TIntStr{"a": {Int: 1, Str: "b"}}`
+	affirm.Equal(t, want, have)
+}
+
+func Test_WithStringer(t *testing.T) {
+	t.Run("without types to skip", func(t *testing.T) {
+		// --- Given ---
+		dmp := &Dump{}
+
+		// --- When ---
+		WithStringer()(dmp)
+
+		// --- Then ---
+		affirm.Equal(t, true, dmp.Stringer)
+		affirm.Nil(t, dmp.StringerSkip)
+	})
+
+	t.Run("with types to skip", func(t *testing.T) {
+		// --- Given ---
+		dmp := &Dump{}
+
+		// --- When ---
+		WithStringer(types.TStringer{}, &types.TStringer{})(dmp)
+
+		// --- Then ---
+		affirm.Equal(t, true, dmp.Stringer)
+		affirm.Equal(t, 2, len(dmp.StringerSkip))
+		affirm.Equal(t, reflect.TypeOf(types.TStringer{}), dmp.StringerSkip[0])
+		affirm.Equal(t, reflect.TypeOf(&types.TStringer{}), dmp.StringerSkip[1])
+	})
+}
+
+func Test_Dump_Any_Stringer(t *testing.T) {
+	t.Run("not used by default", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFlat)
+
+		// --- When ---
+		have := dmp.Any(types.TStringer{Val: "a"})
+
+		// --- Then ---
+		affirm.Equal(t, `{Val: "a"}`, have)
+	})
+
+	t.Run("stringer", func(t *testing.T) {
+		// --- Given ---
+		val := []any{types.TStringer{Val: "a"}, &types.TStringer{Val: "b"}}
+		dmp := New(WithFlat, WithStringer())
+
+		// --- When ---
+		have := dmp.Any(val)
+
+		// --- Then ---
+		affirm.Equal(t, `[]any{"str:a", "str:b"}`, have)
+	})
+
+	t.Run("go stringer", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFlat, WithStringer())
+
+		// --- When ---
+		have := dmp.Any(types.TGoStringer{Val: "a"})
+
+		// --- Then ---
+		affirm.Equal(t, "gostr:a", have)
+	})
+
+	t.Run("go stringer preferred in go syntax mode", func(t *testing.T) {
+		// --- Given ---
+		val := []types.TStringer{{Val: "a"}}
+		dmp := New(WithFlat, WithGoSyntax, WithStringer())
+
+		// --- When ---
+		have := dmp.Any(val)
+
+		// --- Then ---
+		affirm.Equal(t, "[]types.TStringer{gostr:a}", have)
+	})
+
+	t.Run("stringer not used in go syntax mode", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFlat, WithGoSyntax, WithStringer())
+
+		// --- When ---
+		have := dmp.Any(types.TPanicStringer{Val: "a"})
+
+		// --- Then ---
+		affirm.Equal(t, `types.TPanicStringer{Val: "a"}`, have)
+	})
+
+	t.Run("skipped types", func(t *testing.T) {
+		// --- Given ---
+		val := []any{types.TStringer{Val: "a"}, &types.TStringer{Val: "b"}}
+		dmp := New(WithFlat, WithStringer(types.TStringer{}))
+
+		// --- When ---
+		have := dmp.Any(val)
+
+		// --- Then ---
+		affirm.Equal(t, `[]any{{Val: "a"}, "str:b"}`, have)
+	})
+
+	t.Run("nil pointer", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFlat, WithStringer())
+
+		// --- When ---
+		have := dmp.Any((*types.TStringer)(nil))
+
+		// --- Then ---
+		affirm.Equal(t, "nil", have)
+	})
+
+	t.Run("panicking stringer", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFlat, WithStringer())
+
+		// --- When ---
+		have := dmp.Any(types.TPanicStringer{Val: "a"})
+
+		// --- Then ---
+		affirm.Equal(t, `{Val: "a"}`, have)
+	})
+
+	t.Run("custom dumper takes precedence", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFlat, WithStringer())
+
+		// --- When ---
+		have := dmp.Any(time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC))
+
+		// --- Then ---
+		affirm.Equal(t, `"2000-01-02T03:04:05Z"`, have)
+	})
+
+	t.Run("colors", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFlat, WithStringer())
+		dmp.Color = true
+
+		// --- When ---
+		have := dmp.Any(types.TStringer{Val: "a"})
+
+		// --- Then ---
+		affirm.Equal(t, "\x1b[32m\"str:a\"\x1b[0m", have)
+	})
+}
+
+func Test_WithRedact(t *testing.T) {
+	// --- Given ---
+	dmp := &Dump{Redact: []string{"A"}}
+
+	// --- When ---
+	WithRedact("B", "C")(dmp)
+
+	// --- Then ---
+	affirm.DeepEqual(t, []string{"A", "B", "C"}, dmp.Redact)
+}
+
+func Test_Dump_IsRedacted(t *testing.T) {
+	// --- Given ---
+	type T struct {
+		Name  string
+		Pass  string `dump:"redact"`
+		Token string `dump:"other"`
+	}
+	typ := reflect.TypeOf(T{})
+	dmp := New(WithRedact("Token"))
+
+	// --- Then ---
+	affirm.Equal(t, false, dmp.IsRedacted(typ.Field(0)))
+	affirm.Equal(t, true, dmp.IsRedacted(typ.Field(1)))
+	affirm.Equal(t, true, dmp.IsRedacted(typ.Field(2)))
+}
+
+func Test_WithTags(t *testing.T) {
+	// --- Given ---
+	dmp := &Dump{Tags: []string{"json"}}
+
+	// --- When ---
+	WithTags("yaml", "xml")(dmp)
+
+	// --- Then ---
+	affirm.DeepEqual(t, []string{"json", "yaml", "xml"}, dmp.Tags)
+}
+
+func Test_Dump_fieldTags(t *testing.T) {
+	// --- Given ---
+	type T struct {
+		Name string `json:"name" yaml:"nm" xml:"n"`
+		Age  int    `yaml:"age,omitempty"`
+		Note string `xml:"note"`
+		Empt string `json:""`
+	}
+	typ := reflect.TypeOf(T{})
+	dmp := New(WithTags("json", "yaml"))
+
+	// --- Then ---
+	affirm.Equal(t, "`json:\"name\" yaml:\"nm\"`", dmp.fieldTags(typ.Field(0)))
+	affirm.Equal(t, "`yaml:\"age,omitempty\"`", dmp.fieldTags(typ.Field(1)))
+	affirm.Equal(t, "", dmp.fieldTags(typ.Field(2)))
+	affirm.Equal(t, "`json:\"\"`", dmp.fieldTags(typ.Field(3)))
+}
+
+func Test_WithHexDump(t *testing.T) {
+	// --- Given ---
+	dmp := &Dump{}
+
+	// --- When ---
+	WithHexDump(16)(dmp)
+
+	// --- Then ---
+	affirm.Equal(t, 16, dmp.HexDump)
+}
+
+func Test_WithKeySort(t *testing.T) {
+	// --- Given ---
+	fn := func(a, b reflect.Value) bool { return false }
+	dmp := &Dump{}
+
+	// --- When ---
+	WithKeySort(fn)(dmp)
+
+	// --- Then ---
+	affirm.Equal(t, true, core.Same(fn, dmp.KeySort))
+}
+
+func Test_WithMaxWidth(t *testing.T) {
+	// --- Given ---
+	dmp := &Dump{}
+
+	// --- When ---
+	WithMaxWidth(80)(dmp)
+
+	// --- Then ---
+	affirm.Equal(t, 80, dmp.MaxWidth)
+}
+
+func Test_Dump_Any_MaxWidth(t *testing.T) {
+	t.Run("fits in one line", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithMaxWidth(20))
+
+		// --- When ---
+		have := dmp.Any([]int{1, 2, 3})
+
+		// --- Then ---
+		affirm.Equal(t, "[]int{1, 2, 3}", have)
+	})
+
+	t.Run("exactly fits in one line", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithMaxWidth(14))
+
+		// --- When ---
+		have := dmp.Any([]int{1, 2, 3})
+
+		// --- Then ---
+		affirm.Equal(t, "[]int{1, 2, 3}", have)
+	})
+
+	t.Run("too wide", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithMaxWidth(13))
+
+		// --- When ---
+		have := dmp.Any([]int{1, 2, 3})
+
+		// --- Then ---
+		affirm.Equal(t, "[]int{\n  1,\n  2,\n  3,\n}", have)
+	})
+
+	t.Run("small nested values in one line", func(t *testing.T) {
+		// --- Given ---
+		val := types.TNested{
+			SInt:    []int{1, 2},
+			MStrInt: map[string]int{"A": 1, "B": 2, "C": 3, "D": 4},
+		}
+		dmp := New(WithMaxWidth(30))
+
+		// --- When ---
+		have := dmp.Any(val)
+
+		// --- Then ---
+		want := "{\n" +
+			"  SInt: []int{1, 2},\n" +
+			"  STA: nil,\n" +
+			"  STAp: nil,\n" +
+			"  MStrInt: map[string]int{\n" +
+			"    \"A\": 1,\n" +
+			"    \"B\": 2,\n" +
+			"    \"C\": 3,\n" +
+			"    \"D\": 4,\n" +
+			"  },\n" +
+			"  MStrTyp: map[string]types.TA(nil),\n" +
+			"  MIntTyp: map[int]types.TA(nil),\n" +
+			"}"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("indentation counts", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithMaxWidth(14), WithIndent(1))
+
+		// --- When ---
+		have := dmp.Any([]int{1, 2, 3})
+
+		// --- Then ---
+		affirm.Equal(t, "  []int{\n    1,\n    2,\n    3,\n  }", have)
+	})
+
+	t.Run("with colors", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithMaxWidth(14))
+		dmp.Color = true
+
+		// --- When ---
+		have := dmp.Any([]int{1})
+
+		// --- Then ---
+		affirm.Equal(t, "\x1b[36m[]int\x1b[0m{\x1b[33m1\x1b[0m}", have)
+	})
+}
+
+func Test_WithFlatCompact(t *testing.T) {
+	// --- Given ---
+	dmp := &Dump{}
+
+	// --- When ---
+	WithFlatCompact(3)(dmp)
+
+	// --- Then ---
+	affirm.Equal(t, 3, dmp.FlatCompact)
+}
+
+func Test_Dump_Any_FlatCompact(t *testing.T) {
+	t.Run("struct with simple fields", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFlatCompact(2))
+
+		// --- When ---
+		have := dmp.Any(types.TIntStr{Int: 1, Str: "abc"})
+
+		// --- Then ---
+		affirm.Equal(t, `{Int: 1, Str: "abc"}`, have)
+	})
+
+	t.Run("too many elements", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFlatCompact(2))
+
+		// --- When ---
+		have := dmp.Any([]int{1, 2, 3})
+
+		// --- Then ---
+		affirm.Equal(t, "[]int{\n  1,\n  2,\n  3,\n}", have)
+	})
+
+	t.Run("not simple elements", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFlatCompact(2))
+
+		// --- When ---
+		have := dmp.Any([][]int{{1}, {2}})
+
+		// --- Then ---
+		affirm.Equal(t, "[][]int{\n  {1},\n  {2},\n}", have)
+	})
+
+	t.Run("map with pointers and custom dumpers", func(t *testing.T) {
+		// --- Given ---
+		tim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+		val := map[string]any{"a": &tim, "b": nil}
+		dmp := New(WithFlatCompact(2))
+
+		// --- When ---
+		have := dmp.Any(val)
+
+		// --- Then ---
+		want := `map[string]any{"a": "2000-01-02T03:04:05Z", "b": nil}`
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("nested compact values", func(t *testing.T) {
+		// --- Given ---
+		val := map[string][]int{"a": {1, 2}, "b": {1, 2, 3}}
+		dmp := New(WithFlatCompact(2))
+
+		// --- When ---
+		have := dmp.Any(val)
+
+		// --- Then ---
+		want := "map[string][]int{\n" +
+			"  \"a\": {1, 2},\n" +
+			"  \"b\": []int{\n" +
+			"    1,\n" +
+			"    2,\n" +
+			"    3,\n" +
+			"  },\n" +
+			"}"
+		affirm.Equal(t, want, have)
+	})
+}
+
+func Test_WithIndent(t *testing.T) {
+	// --- Given ---
+	dmp := &Dump{}
+
+	// --- When ---
+	opt := WithIndent(10)
+
+	// --- Then ---
+	opt(dmp)
+	affirm.Equal(t, 10, dmp.Indent)
+}
+
+func Test_WithTabWidth(t *testing.T) {
+	// --- Given ---
+	dmp := &Dump{}
+
+	// --- When ---
+	opt := WithTabWidth(10)
+
+	// --- Then ---
+	opt(dmp)
+	affirm.Equal(t, 10, dmp.TabWidth)
+}
+
+func Test_WithDumper(t *testing.T) {
+	t.Setenv("___", "___")
+	affirm.Nil(t, typeDumpers)
+	origLog := globLog
+	buf := &bytes.Buffer{}
+	globLog = log.New(buf, "", 0)
+	cDpr := func(Dump, int, reflect.Value) string { return "123456" }
+	t.Cleanup(func() { globLog = origLog; typeDumpers = nil })
+
+	t.Run("setting", func(t *testing.T) {
+		// --- Given ---
+		dmp := &Dump{Dumpers: make(map[reflect.Type]Dumper)}
+		t.Cleanup(func() { typeDumpers = nil; buf.Reset() })
+
+		// --- When ---
+		WithDumper(123, cDpr)(dmp)
+
+		// --- Then ---
+		wChk := core.Same(cDpr, dmp.Dumpers[reflect.TypeOf(123)])
+		affirm.Equal(t, true, wChk)
+		affirm.Equal(t, "", buf.String())
+	})
+
+	t.Run("overwriting global checker", func(t *testing.T) {
+		// --- Given ---
+		type custom struct{}
+		dmp := &Dump{Dumpers: make(map[reflect.Type]Dumper)}
+		t.Cleanup(func() { typeDumpers = nil; buf.Reset() })
+
+		Register(custom{}, cDpr) // The first call.
+		buf.Reset()              // Test later the log is empty.
+
+		// --- When ---
+		WithDumper(custom{}, cDpr)(dmp)
+
+		// --- Then ---
+		wChk := core.Same(cDpr, dmp.Dumpers[reflect.TypeOf(custom{})])
+		affirm.Equal(t, true, wChk)
+		wMsg := "Overwriting the global type dumper for: dump.custom\n"
+		affirm.Equal(t, wMsg, buf.String())
+	})
+}
+
+func Test_New(t *testing.T) {
+	t.Run("no options", func(t *testing.T) {
+		// --- When ---
+		have := New()
+
+		// --- Then ---
+		affirm.Equal(t, false, have.Flat)
+		affirm.Equal(t, 200, have.FlatStrings)
+		affirm.Equal(t, false, have.Compact)
+		affirm.Equal(t, TimeFormat, have.TimeFormat)
+		affirm.Equal(t, "", have.DurationFormat)
+		affirm.Equal(t, false, have.PtrAddr)
+		affirm.Equal(t, true, have.PrintType)
+		affirm.Equal(t, true, have.UseAny)
+		affirm.Equal(t, true, len(have.Dumpers) == 12)
+		affirm.Equal(t, DefaultDepth, have.MaxDepth)
+		affirm.Equal(t, 0, have.MaxItems)
+		affirm.Equal(t, DefaultIndent, have.Indent)
+		affirm.Equal(t, DefaultTabWith, have.TabWidth)
+		affirm.Equal(t, DefaultHTTPBody, have.HTTPBody)
+
+		val, ok := have.Dumpers[typDur]
+		affirm.Equal(t, true, ok)
+		affirm.NotNil(t, val)
+
+		val, ok = have.Dumpers[typLocation]
+		affirm.Equal(t, true, ok)
+		affirm.NotNil(t, val)
+
+		val, ok = have.Dumpers[typTime]
+		affirm.Equal(t, true, ok)
+		affirm.NotNil(t, val)
+
+		val, ok = have.Dumpers[typReflectType]
+		affirm.Equal(t, true, ok)
+		affirm.Equal(t, true, core.Same(ReflectTypeDumper, val))
+
+		val, ok = have.Dumpers[typReflectRType]
+		affirm.Equal(t, true, ok)
+		affirm.Equal(t, true, core.Same(ReflectTypeDumper, val))
+
+		val, ok = have.Dumpers[typReflectValue]
+		affirm.Equal(t, true, ok)
+		affirm.Equal(t, true, core.Same(ReflectValueDumper, val))
+
+		val, ok = have.Dumpers[typHTTPRequest]
+		affirm.Equal(t, true, ok)
+		affirm.Equal(t, true, core.Same(HTTPRequestDumper, val))
+
+		val, ok = have.Dumpers[typHTTPResponse]
+		affirm.Equal(t, true, ok)
+		affirm.Equal(t, true, core.Same(HTTPResponseDumper, val))
+
+		for _, typ := range syncTypes {
+			val, ok = have.Dumpers[typ]
+			affirm.Equal(t, true, ok)
+			affirm.Equal(t, true, core.Same(SyncDumper, val))
+		}
+	})
+
+	t.Run("with sync state", func(t *testing.T) {
+		// --- When ---
+		have := New(WithSyncState)
+
+		// --- Then ---
+		affirm.Equal(t, true, have.SyncState)
+		affirm.Equal(t, true, len(have.Dumpers) == 8)
+	})
+
+	t.Run("custom sync dumper is not overwritten", func(t *testing.T) {
+		// --- Given ---
+		dpr := func(dmp Dump, lvl int, val reflect.Value) string { return "" }
+
+		// --- When ---
+		have := New(WithDumper(sync.Mutex{}, dpr))
+
+		// --- Then ---
+		val := have.Dumpers[reflect.TypeOf(sync.Mutex{})]
+		affirm.Equal(t, true, core.Same(dpr, val))
+	})
+}
+
+func Test_Dump_Any_Value_smoke_tabular(t *testing.T) {
+	var itfNil types.TItf
+	var itfVal, itfPtr types.TItf
+	var sNil *types.TA
+	itfVal = types.TVal{}
+	itfPtr = &types.TPtr{}
+	sPtr := &types.TPtr{Val: "a"}
+	var aAnyNil any
+	es := []error{errors.New("error message")}
+
+	tt := []struct {
+		testN string
+
+		dmp  Dump
+		v    any
+		want string
+	}{
+		// Simple.
+		{"bool true", New(WithFlat, WithCompact), true, "true"},
+		{"int", New(WithFlat, WithCompact), 123, "123"},
+		{"int8", New(WithFlat, WithCompact), int8(123), "123"},
+		{"int16", New(WithFlat, WithCompact), int16(123), "123"},
+		{"int32", New(WithFlat, WithCompact), int32(123), "123"},
+		{"int64", New(WithFlat, WithCompact), int64(123), "123"},
+		{"uint", New(WithFlat, WithCompact), uint(123), "123"},
+		{"uint8", New(WithFlat, WithCompact), uint8(123), "0x7b"},
+		{"byte", New(WithFlat, WithCompact), byte(123), "0x7b"},
+		{"uint16", New(WithFlat, WithCompact), uint16(123), "123"},
+		{"uint32", New(WithFlat, WithCompact), uint32(123), "123"},
+		{"uint64", New(WithFlat, WithCompact), uint64(123), "123"},
+		{"uintptr", New(WithFlat, WithCompact, WithPtrAddr), uintptr(123), "<0x7b>"},
+		{"float32", New(WithFlat, WithCompact), float32(12.3), "12.3"},
+		{"float64", New(WithFlat, WithCompact), 12.3, "12.3"},
+		{"complex64", New(WithFlat, WithCompact), complex(float32(1), float32(2)), "(1+2i)"},
+		{"complex128", New(WithFlat, WithCompact), complex(3.3, 4.4), "(3.3+4.4i)"},
+		{"array", New(WithFlat, WithCompact), [2]int{}, "[2]int{0,0}"},
+		{"chan", New(WithFlat, WithCompact), make(chan int), "(chan int)(<addr>)"},
+		{"func", New(WithFlat, WithCompact), func() {}, "<func>(<addr>)"},
+		{"interface nil", New(WithFlat, WithCompact), itfNil, ValNil},
+		{"any nil", New(WithFlat, WithCompact), aAnyNil, ValNil},
+		{"interface val", New(WithFlat, WithCompact), itfVal, `{Val:""}`},
+		{"interface ptr", New(WithFlat, WithCompact), itfPtr, `{Val:""}`},
+		{
+			"map",
+			New(WithFlat, WithCompact),
+			map[string]string{"A": "a", "B": "b"},
+			`map[string]string{"A":"a","B":"b"}`,
+		},
+		{"struct pointer", New(WithFlat, WithCompact), sPtr, `{Val:"a"}`},
+		{"slice", New(WithFlat, WithCompact), []int{1, 2}, "[]int{1,2}"},
+		{"string", New(WithFlat, WithCompact), "string", `"string"`},
+		{"struct", New(WithFlat, WithCompact), struct{ F0 int }{}, "{F0:0}"},
+		{
+			"registered",
+			New(WithFlat, WithCompact),
+			time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC),
+			`"2000-01-02T03:04:05Z"`,
+		},
+		{"struct nil", New(WithFlat, WithCompact), sNil, "nil"},
+		{
+			"registered",
+			New(WithFlat, WithCompact),
+			time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC),
+			`"2000-01-02T03:04:05Z"`,
+		},
+		{
+			"unsafe pointer",
+			New(WithFlat, WithCompact, WithPtrAddr),
+			unsafe.Pointer(sPtr),
+			fmt.Sprintf("<%p>", sPtr),
+		},
+		{
+			"special case for type error",
+			New(WithFlat, WithCompact, WithPtrAddr),
+			errors.New("error message"),
+			`"error message"`,
+		},
+		{
+			"special case for type error 2",
+			New(WithFlat, WithCompact, WithPtrAddr),
+			es,
+			"[]error{\"error message\"}",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			haveAny := tc.dmp.Any(tc.v)
+			haveVal := tc.dmp.Value(reflect.ValueOf(tc.v))
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, haveAny)
+			affirm.Equal(t, tc.want, haveVal)
+		})
+	}
+}
+
+func Test_Dump_Any_DynamicTypes(t *testing.T) {
+	t.Run("slice of any", func(t *testing.T) {
+		// --- Given ---
+		val := []any{int64(7), 7, "a", nil, (*int)(nil), []int{1}}
+		dmp := New(WithDynamicTypes, WithFlat)
+
+		// --- When ---
+		have := dmp.Any(val)
+
+		// --- Then ---
+		want := `[]any{int64(7), int(7), string("a"), nil, ` +
+			`(*int)(nil), []int{1}}`
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("struct field", func(t *testing.T) {
+		// --- Given ---
+		val := struct{ Val any }{Val: &types.TIntStr{Int: 1}}
+		dmp := New(WithDynamicTypes)
+
+		// --- When ---
+		have := dmp.Any(val)
+
+		// --- Then ---
+		want := "{\n" +
+			"  Val: *types.TIntStr{\n" +
+			"    Int: 1,\n" +
+			"    Str: \"\",\n" +
+			"  },\n" +
+			"}"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("not interface values are not annotated", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithDynamicTypes)
+
+		// --- When ---
+		have := dmp.Any(int64(7))
+
+		// --- Then ---
+		affirm.Equal(t, "7", have)
+	})
+
+	t.Run("same as Write", func(t *testing.T) {
+		// --- Given ---
+		val := map[string]any{"a": uint(1), "b": types.TIntStr{Int: 1}}
+		dmp := New(WithDynamicTypes)
+		buf := &bytes.Buffer{}
+
+		// --- When ---
+		err := dmp.Write(buf, val)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, dmp.Any(val), buf.String())
+	})
+}
+
+func Test_Dump_typeDumper(t *testing.T) {
+	t.Run("resolved dumper is cached", func(t *testing.T) {
+		// --- Given ---
+		dmp := New()
+
+		// --- When ---
+		have := dmp.typeDumper(reflect.TypeOf(1))
+
+		// --- Then ---
+		affirm.Equal(t, true, core.Same(SimpleDumper, have))
+		fn, ok := dmp.cache.Load(reflect.TypeOf(1))
+		affirm.Equal(t, true, ok)
+		affirm.Equal(t, true, core.Same(SimpleDumper, fn.(Dumper)))
+	})
+
+	t.Run("not resolved type is cached", func(t *testing.T) {
+		// --- Given ---
+		dmp := New()
+		typ := reflect.TypeOf(types.TStringer{})
+
+		// --- When ---
+		have := dmp.typeDumper(typ)
+
+		// --- Then ---
+		affirm.Nil(t, have)
+		fn, ok := dmp.cache.Load(typ)
+		affirm.Equal(t, true, ok)
+		affirm.Nil(t, fn.(Dumper))
+	})
+
+	t.Run("uses cached dumper", func(t *testing.T) {
+		// --- Given ---
+		dmp := New()
+		dmp.cache.Store(reflect.TypeOf(1), Dumper(FuncDumper))
+
+		// --- When ---
+		have := dmp.typeDumper(reflect.TypeOf(1))
+
+		// --- Then ---
+		affirm.Equal(t, true, core.Same(FuncDumper, have))
+	})
+
+	t.Run("without cache", func(t *testing.T) {
+		// --- Given ---
+		dmp := Dump{}
+
+		// --- When ---
+		have := dmp.typeDumper(reflect.TypeOf(1))
+
+		// --- Then ---
+		affirm.Equal(t, true, core.Same(SimpleDumper, have))
+	})
+
+	t.Run("used when dumping values", func(t *testing.T) {
+		// --- Given ---
+		dmp := New()
+
+		// --- When ---
+		have := dmp.Any(42)
+
+		// --- Then ---
+		affirm.Equal(t, "42", have)
+		_, ok := dmp.cache.Load(reflect.TypeOf(42))
+		affirm.Equal(t, true, ok)
+	})
+}
+
+func Test_resolveDumper(t *testing.T) {
+	t.Run("resolved by kind", func(t *testing.T) {
+		// --- When ---
+		have := resolveDumper(reflect.TypeOf(types.TIntStr{}))
+
+		// --- Then ---
+		affirm.Equal(t, true, core.Same(StructDumper, have))
+	})
+
+	t.Run("stringer", func(t *testing.T) {
+		// --- When ---
+		have := resolveDumper(reflect.TypeOf(types.TStringer{}))
+
+		// --- Then ---
+		affirm.Nil(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		have := resolveDumper(reflect.TypeOf(&tPtrError{}))
+
+		// --- Then ---
+		affirm.Nil(t, have)
+	})
+
+	t.Run("pointer", func(t *testing.T) {
+		// --- When ---
+		have := resolveDumper(reflect.TypeOf(&types.TIntStr{}))
+
+		// --- Then ---
+		affirm.Nil(t, have)
+	})
+}
+
+func Test_kindDumper_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		knd  reflect.Kind
+		want Dumper
+	}{
+		{"bool", reflect.Bool, SimpleDumper},
+		{"int", reflect.Int, SimpleDumper},
+		{"int64", reflect.Int64, SimpleDumper},
+		{"uint", reflect.Uint, SimpleDumper},
+		{"uint8", reflect.Uint8, HexPtrDumper},
+		{"uintptr", reflect.Uintptr, HexPtrDumper},
+		{"unsafe pointer", reflect.UnsafePointer, HexPtrDumper},
+		{"float64", reflect.Float64, SimpleDumper},
+		{"complex128", reflect.Complex128, ComplexDumper},
+		{"string", reflect.String, SimpleDumper},
+		{"array", reflect.Array, ArrayDumper},
+		{"slice", reflect.Slice, SliceDumper},
+		{"map", reflect.Map, MapDumper},
+		{"struct", reflect.Struct, StructDumper},
+		{"chan", reflect.Chan, ChanDumper},
+		{"func", reflect.Func, FuncDumper},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := kindDumper(tc.knd)
+
+			// --- Then ---
+			affirm.Equal(t, true, core.Same(tc.want, have))
+		})
+	}
+
+	t.Run("not resolved kinds", func(t *testing.T) {
+		for _, knd := range []reflect.Kind{
+			reflect.Invalid, reflect.Interface, reflect.Pointer,
+		} {
+			affirm.Nil(t, kindDumper(knd))
+		}
+	})
+}
+
+func Test_Dump_dynamicType(t *testing.T) {
+	t.Run("wrapped in parentheses", func(t *testing.T) {
+		// --- Given ---
+		dmp := New()
+
+		// --- When ---
+		have := dmp.dynamicType(0, reflect.ValueOf(int64(7)), "7")
+
+		// --- Then ---
+		affirm.Equal(t, "int64(7)", have)
+	})
+
+	t.Run("composite value", func(t *testing.T) {
+		// --- Given ---
+		dmp := New()
+
+		// --- When ---
+		have := dmp.dynamicType(0, reflect.ValueOf([]int{1}), "{1}")
+
+		// --- Then ---
+		affirm.Equal(t, "[]int{1}", have)
+	})
+
+	t.Run("pointer", func(t *testing.T) {
+		// --- Given ---
+		dmp := New()
+
+		// --- When ---
+		have := dmp.dynamicType(0, reflect.ValueOf((*int)(nil)), ValNil)
+
+		// --- Then ---
+		affirm.Equal(t, "(*int)(nil)", have)
+	})
+
+	t.Run("uses any", func(t *testing.T) {
+		// --- Given ---
+		val := reflect.ValueOf(map[string]any{})
+		dmp := New()
+
+		// --- When ---
+		have := dmp.dynamicType(0, val, "{}")
+
+		// --- Then ---
+		affirm.Equal(t, "map[string]any{}", have)
+	})
+
+	t.Run("uses indent and level", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithIndent(1))
+
+		// --- When ---
+		have := dmp.dynamicType(1, reflect.ValueOf(7), "    7")
+
+		// --- Then ---
+		affirm.Equal(t, "    int(7)", have)
+	})
+}
+
+func Test_Dump_Any_SharedPtr(t *testing.T) {
+	t.Run("slice", func(t *testing.T) {
+		// --- Given ---
+		ptr := &types.TIntStr{Int: 1, Str: "a"}
+		dmp := New(WithSharedPtr)
+
+		// --- When ---
+		have := dmp.Any([]any{ptr, ptr, 1})
+
+		// --- Then ---
+		want := "[]any{\n" +
+			"  &1 → {\n" +
+			"    Int: 1,\n" +
+			"    Str: \"a\",\n" +
+			"  },\n" +
+			"  <see &1>,\n" +
+			"  1,\n" +
+			"}"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("map", func(t *testing.T) {
+		// --- Given ---
+		ptr := &types.TIntStr{Int: 1, Str: "a"}
+		val := map[string]*types.TIntStr{"a": ptr, "b": ptr, "c": {Int: 1}}
+		dmp := New(WithFlat, WithSharedPtr)
+
+		// --- When ---
+		have := dmp.Any(val)
+
+		// --- Then ---
+		want := `map[string]*types.TIntStr{"a": &1 → {Int: 1, Str: "a"}, ` +
+			`"b": <see &1>, "c": {Int: 1, Str: ""}}`
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("struct fields", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ A, B *types.TIntStr }
+		ptr := &types.TIntStr{Int: 1, Str: "a"}
+		dmp := New(WithFlat, WithSharedPtr)
+
+		// --- When ---
+		have := dmp.Any(T{A: ptr, B: ptr})
+
+		// --- Then ---
+		affirm.Equal(t, `{A: &1 → {Int: 1, Str: "a"}, B: <see &1>}`, have)
+	})
+
+	t.Run("not shared pointers are not labeled", func(t *testing.T) {
+		// --- Given ---
+		val := []*types.TIntStr{{Int: 1}, {Int: 1}}
+		dmp := New(WithFlat, WithSharedPtr)
+
+		// --- When ---
+		have := dmp.Any(val)
+
+		// --- Then ---
+		want := `[]*types.TIntStr{{Int: 1, Str: ""}, {Int: 1, Str: ""}}`
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		// --- Given ---
+		val := &types.TRec{Int: 1}
+		val.Rec = val
+		dmp := New(WithFlat, WithSharedPtr)
+
+		// --- When ---
+		have := dmp.Any(val)
+
+		// --- Then ---
+		affirm.Equal(t, "&1 → {Int: 1, Rec: <see &1>}", have)
+	})
+
+	t.Run("with max width", func(t *testing.T) {
+		// --- Given ---
+		ptr := &types.TIntStr{Int: 1, Str: "a"}
+		val := [][]any{{ptr, ptr}, {ptr}}
+		dmp := New(WithSharedPtr, WithMaxWidth(40))
+
+		// --- When ---
+		have := dmp.Any(val)
+
+		// --- Then ---
+		want := "[][]any{\n" +
+			"  {&1 → {Int: 1, Str: \"a\"}, <see &1>},\n" +
+			"  {<see &1>},\n" +
+			"}"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("labels are not shared between dumps", func(t *testing.T) {
+		// --- Given ---
+		ptr := &types.TIntStr{Int: 1}
+		dmp := New(WithFlat, WithSharedPtr)
+
+		// --- When ---
+		have0 := dmp.Any([]any{ptr, ptr})
+		have1 := dmp.Any([]any{ptr, ptr})
+
+		// --- Then ---
+		affirm.Equal(t, have0, have1)
+	})
+
+	t.Run("same as Write", func(t *testing.T) {
+		// --- Given ---
+		ptr := &types.TIntStr{Int: 1, Str: "a"}
+		val := map[string]any{"a": []any{ptr, ptr}, "b": ptr}
+		dmp := New(WithSharedPtr)
+		buf := &bytes.Buffer{}
+
+		// --- When ---
+		err := dmp.Write(buf, val)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, dmp.Any(val), buf.String())
+	})
+}
+
+func Test_Dump_Any_ErrorChain(t *testing.T) {
+	t.Run("struct field", func(t *testing.T) {
+		// --- Given ---
+		val := struct{ Err error }{Err: fmt.Errorf("read: %w", io.EOF)}
+		dmp := New(WithFlat, WithErrorChain)
+
+		// --- When ---
+		have := dmp.Any(val)
+
+		// --- Then ---
+		want := `{Err: *fmt.wrapError("read: EOF") ` +
+			`{*errors.errorString("EOF")}}`
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("nil error field", func(t *testing.T) {
+		// --- Given ---
+		val := struct{ Err error }{}
+		dmp := New(WithFlat, WithErrorChain)
+
+		// --- When ---
+		have := dmp.Any(val)
+
+		// --- Then ---
+		affirm.Equal(t, "{Err: nil}", have)
+	})
+
+	t.Run("same as Write", func(t *testing.T) {
+		// --- Given ---
+		val := &tPtrError{msg: "msg"}
+		dmp := New(WithErrorChain)
+		buf := &bytes.Buffer{}
+
+		// --- When ---
+		err := dmp.Write(buf, val)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, `*dump.tPtrError("msg")`, buf.String())
+	})
+}
+
+func Test_Dump_Any_nil_error_field(t *testing.T) {
+	// --- Given ---
+	val := struct{ Err error }{}
+	dmp := New(WithFlat)
+
+	// --- When ---
+	have := dmp.Any(val)
+
+	// --- Then ---
+	affirm.Equal(t, "{Err: nil}", have)
+}
+
+func Test_Label(t *testing.T) {
+	// --- When ---
+	have := Label("want", 42)
+
+	// --- Then ---
+	affirm.Equal(t, "want", have.Label)
+	affirm.Equal(t, 42, have.Value)
+}
+
+func Test_Dump_Values(t *testing.T) {
+	t.Run("no values", func(t *testing.T) {
+		// --- Given ---
+		dmp := New()
+
+		// --- When ---
+		have := dmp.Values()
+
+		// --- Then ---
+		affirm.Equal(t, "", have)
+	})
+
+	t.Run("values", func(t *testing.T) {
+		// --- Given ---
+		dmp := New()
 
-	// --- Then ---
-	opt(dmp)
-	affirm.Equal(t, 10, dmp.TabWidth)
-}
+		// --- When ---
+		have := dmp.Values(1, "a", []int{1, 2})
 
-func Test_WithDumper(t *testing.T) {
-	t.Setenv("___", "___")
-	affirm.Nil(t, typeDumpers)
-	origLog := globLog
-	buf := &bytes.Buffer{}
-	globLog = log.New(buf, "", 0)
-	cDpr := func(Dump, int, reflect.Value) string { return "123456" }
-	t.Cleanup(func() { globLog = origLog; typeDumpers = nil })
+		// --- Then ---
+		want := "1\n" +
+			"\"a\"\n" +
+			"[]int{\n" +
+			"  1,\n" +
+			"  2,\n" +
+			"}"
+		affirm.Equal(t, want, have)
+	})
 
-	t.Run("setting", func(t *testing.T) {
+	t.Run("labeled values", func(t *testing.T) {
 		// --- Given ---
-		dmp := &Dump{Dumpers: make(map[reflect.Type]Dumper)}
-		t.Cleanup(func() { typeDumpers = nil; buf.Reset() })
+		dmp := New()
 
 		// --- When ---
-		WithDumper(123, cDpr)(dmp)
+		have := dmp.Values(Label("want", 1), Label("have", "a"), true)
 
 		// --- Then ---
-		wChk := core.Same(cDpr, dmp.Dumpers[reflect.TypeOf(123)])
-		affirm.Equal(t, true, wChk)
-		affirm.Equal(t, "", buf.String())
+		affirm.Equal(t, "want: 1\nhave: \"a\"\ntrue", have)
 	})
 
-	t.Run("overwriting global checker", func(t *testing.T) {
+	t.Run("flat", func(t *testing.T) {
 		// --- Given ---
-		type custom struct{}
-		dmp := &Dump{Dumpers: make(map[reflect.Type]Dumper)}
-		t.Cleanup(func() { typeDumpers = nil; buf.Reset() })
+		dmp := New(WithFlat)
+
+		// --- When ---
+		have := dmp.Values(Label("want", []int{1, 2}), nil, "a")
+
+		// --- Then ---
+		affirm.Equal(t, `want: []int{1, 2}, nil, "a"`, have)
+	})
 
-		RegisterTypeDumper(custom{}, cDpr) // The first call.
-		buf.Reset()                        // Test later the log is empty.
+	t.Run("flat compact", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFlat, WithCompact)
 
 		// --- When ---
-		WithDumper(custom{}, cDpr)(dmp)
+		have := dmp.Values(Label("want", 1), 2)
 
 		// --- Then ---
-		wChk := core.Same(cDpr, dmp.Dumpers[reflect.TypeOf(custom{})])
-		affirm.Equal(t, true, wChk)
-		wMsg := "Overwriting the global type dumper for: dump.custom\n"
-		affirm.Equal(t, wMsg, buf.String())
+		affirm.Equal(t, "want:1,2", have)
 	})
 }
 
-func Test_New(t *testing.T) {
-	t.Run("no options", func(t *testing.T) {
+func Test_Dump_Write(t *testing.T) {
+	t.Run("same as Any", func(t *testing.T) {
+		// --- Given ---
+		vals := []any{
+			nil,
+			42,
+			"abc",
+			[]int{1, 2},
+			[]int(nil),
+			[2]string{"a", "b"},
+			map[string]int{"A": 1, "B": 2},
+			types.TA{Int: 1, Str: "abc", TAp: &types.TA{Int: 2}},
+			&types.TNested{SInt: []int{1}, MStrInt: map[string]int{"A": 1}},
+			[]any{1, "a", nil, []int{1}},
+			errors.New("msg"),
+		}
+		dmp := New()
+
+		for _, val := range vals {
+			buf := &bytes.Buffer{}
+
+			// --- When ---
+			err := dmp.Write(buf, val)
+
+			// --- Then ---
+			affirm.Nil(t, err)
+			affirm.Equal(t, dmp.Any(val), buf.String())
+		}
+	})
+
+	t.Run("large slice", func(t *testing.T) {
+		// --- Given ---
+		val := make([]types.TIntStr, 1000)
+		for i := range val {
+			val[i] = types.TIntStr{Int: i, Str: "abc"}
+		}
+		dmp := New()
+		buf := &bytes.Buffer{}
+
 		// --- When ---
-		have := New()
+		err := dmp.Write(buf, val)
 
 		// --- Then ---
-		affirm.Equal(t, false, have.Flat)
-		affirm.Equal(t, 200, have.FlatStrings)
-		affirm.Equal(t, false, have.Compact)
-		affirm.Equal(t, TimeFormat, have.TimeFormat)
-		affirm.Equal(t, "", have.DurationFormat)
-		affirm.Equal(t, false, have.PtrAddr)
-		affirm.Equal(t, true, have.PrintType)
-		affirm.Equal(t, true, have.UseAny)
-		affirm.Equal(t, true, len(have.Dumpers) == 3)
-		affirm.Equal(t, DefaultDepth, have.MaxDepth)
-		affirm.Equal(t, DefaultIndent, have.Indent)
-		affirm.Equal(t, DefaultTabWith, have.TabWidth)
+		affirm.Nil(t, err)
+		affirm.Equal(t, ArrayDumper(dmp, 0, reflect.ValueOf(val)), buf.String())
+	})
 
-		val, ok := have.Dumpers[typDur]
-		affirm.Equal(t, true, ok)
-		affirm.NotNil(t, val)
+	t.Run("cycle", func(t *testing.T) {
+		// --- Given ---
+		val := &types.TRec{Int: 1}
+		val.Rec = val
+		dmp := New(WithFlat)
+		buf := &bytes.Buffer{}
 
-		val, ok = have.Dumpers[typLocation]
-		affirm.Equal(t, true, ok)
-		affirm.NotNil(t, val)
+		// --- When ---
+		err := dmp.Write(buf, val)
 
-		val, ok = have.Dumpers[typTime]
-		affirm.Equal(t, true, ok)
-		affirm.NotNil(t, val)
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, "{Int: 1, Rec: <cycle to TRec@0>}", buf.String())
 	})
-}
 
-func Test_Dump_Any_Value_smoke_tabular(t *testing.T) {
-	var itfNil types.TItf
-	var itfVal, itfPtr types.TItf
-	var sNil *types.TA
-	itfVal = types.TVal{}
-	itfPtr = &types.TPtr{}
-	sPtr := &types.TPtr{Val: "a"}
-	var aAnyNil any
-	es := []error{errors.New("error message")}
+	t.Run("error - write error", func(t *testing.T) {
+		// --- Given ---
+		fil, err := os.CreateTemp(t.TempDir(), "dump")
+		affirm.Nil(t, err)
+		affirm.Nil(t, fil.Close())
+		dmp := New()
 
-	tt := []struct {
-		testN string
+		// --- When ---
+		err = dmp.Write(fil, []int{1, 2, 3})
 
-		dmp  Dump
-		v    any
-		want string
-	}{
-		// Simple.
-		{"bool true", New(WithFlat, WithCompact), true, "true"},
-		{"int", New(WithFlat, WithCompact), 123, "123"},
-		{"int8", New(WithFlat, WithCompact), int8(123), "123"},
-		{"int16", New(WithFlat, WithCompact), int16(123), "123"},
-		{"int32", New(WithFlat, WithCompact), int32(123), "123"},
-		{"int64", New(WithFlat, WithCompact), int64(123), "123"},
-		{"uint", New(WithFlat, WithCompact), uint(123), "123"},
-		{"uint8", New(WithFlat, WithCompact), uint8(123), "0x7b"},
-		{"byte", New(WithFlat, WithCompact), byte(123), "0x7b"},
-		{"uint16", New(WithFlat, WithCompact), uint16(123), "123"},
-		{"uint32", New(WithFlat, WithCompact), uint32(123), "123"},
-		{"uint64", New(WithFlat, WithCompact), uint64(123), "123"},
-		{"uintptr", New(WithFlat, WithCompact, WithPtrAddr), uintptr(123), "<0x7b>"},
-		{"float32", New(WithFlat, WithCompact), float32(12.3), "12.3"},
-		{"float64", New(WithFlat, WithCompact), 12.3, "12.3"},
-		{"complex64", New(WithFlat, WithCompact), complex(float32(1), float32(2)), "(1+2i)"},
-		{"complex128", New(WithFlat, WithCompact), complex(3.3, 4.4), "(3.3+4.4i)"},
-		{"array", New(WithFlat, WithCompact), [2]int{}, "[2]int{0,0}"},
-		{"chan", New(WithFlat, WithCompact), make(chan int), "(chan int)(<addr>)"},
-		{"func", New(WithFlat, WithCompact), func() {}, "<func>(<addr>)"},
-		{"interface nil", New(WithFlat, WithCompact), itfNil, ValNil},
-		{"any nil", New(WithFlat, WithCompact), aAnyNil, ValNil},
-		{"interface val", New(WithFlat, WithCompact), itfVal, `{Val:""}`},
-		{"interface ptr", New(WithFlat, WithCompact), itfPtr, `{Val:""}`},
-		{
-			"map",
-			New(WithFlat, WithCompact),
-			map[string]string{"A": "a", "B": "b"},
-			`map[string]string{"A":"a","B":"b"}`,
-		},
-		{"struct pointer", New(WithFlat, WithCompact), sPtr, `{Val:"a"}`},
-		{"slice", New(WithFlat, WithCompact), []int{1, 2}, "[]int{1,2}"},
-		{"string", New(WithFlat, WithCompact), "string", `"string"`},
-		{"struct", New(WithFlat, WithCompact), struct{ F0 int }{}, "{F0:0}"},
-		{
-			"registered",
-			New(WithFlat, WithCompact),
-			time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC),
-			`"2000-01-02T03:04:05Z"`,
-		},
-		{"struct nil", New(WithFlat, WithCompact), sNil, "nil"},
-		{
-			"registered",
-			New(WithFlat, WithCompact),
-			time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC),
-			`"2000-01-02T03:04:05Z"`,
-		},
-		{
-			"unsafe pointer",
-			New(WithFlat, WithCompact, WithPtrAddr),
-			unsafe.Pointer(sPtr),
-			fmt.Sprintf("<%p>", sPtr),
-		},
-		{
-			"special case for type error",
-			New(WithFlat, WithCompact, WithPtrAddr),
-			errors.New("error message"),
-			`"error message"`,
-		},
-		{
-			"special case for type error 2",
-			New(WithFlat, WithCompact, WithPtrAddr),
-			es,
-			"[]error{\"error message\"}",
-		},
-	}
+		// --- Then ---
+		affirm.Equal(t, true, errors.Is(err, os.ErrClosed))
+	})
+}
 
-	for _, tc := range tt {
-		t.Run(tc.testN, func(t *testing.T) {
-			// --- When ---
-			haveAny := tc.dmp.Any(tc.v)
-			haveVal := tc.dmp.Value(reflect.ValueOf(tc.v))
+func Test_Dump_Any_flat_last_private_field(t *testing.T) {
+	// --- Given ---
+	dmp := New(WithFlat, WithNoPrivate)
 
-			// --- Then ---
-			affirm.Equal(t, tc.want, haveAny)
-			affirm.Equal(t, tc.want, haveVal)
-		})
-	}
+	// --- When ---
+	have := dmp.Any(types.TPrv{Pub: 1})
+
+	// --- Then ---
+	affirm.Equal(t, "{Pub: 1}", have)
 }
 
 func Test_Dump_Any(t *testing.T) {
@@ -407,6 +1872,73 @@ func Test_Dump_Any(t *testing.T) {
 		affirm.Equal(t, "{S0:{S1:{S2:{S4:{S5:{S6:{VAL:<...>}}}}}}}", have)
 	})
 
+	t.Run("max depth", func(t *testing.T) {
+		// --- Given ---
+		type T struct {
+			Int  int
+			Next *T
+		}
+		val := &T{Int: 1, Next: &T{Int: 2, Next: &T{Int: 3, Next: &T{}}}}
+		dmp := New(WithFlat, WithCompact, WithMaxDepth(2))
+
+		// --- When ---
+		have := dmp.Any(val)
+
+		// --- Then ---
+		affirm.Equal(t, "{Int:1,Next:{Int:2,Next:{Int:<...>,Next:{...}}}}", have)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		// --- Given ---
+		type Node struct {
+			Val    int
+			Parent *Node
+		}
+		val := &Node{Val: 1}
+		val.Parent = val
+		dmp := New(WithFlat, WithCompact)
+
+		// --- When ---
+		have := dmp.Any(val)
+
+		// --- Then ---
+		affirm.Equal(t, "{Val:1,Parent:<cycle to Node@0>}", have)
+	})
+
+	t.Run("cycle through multiple values", func(t *testing.T) {
+		// --- Given ---
+		type Node struct {
+			Val  int
+			Next *Node
+		}
+		val := &Node{Val: 1, Next: &Node{Val: 2}}
+		val.Next.Next = val
+		dmp := New(WithFlat, WithCompact)
+
+		// --- When ---
+		have := dmp.Any(map[string]*Node{"A": val.Next})
+
+		// --- Then ---
+		want := "" +
+			`map[string]*dump.Node{` +
+			`"A":{Val:2,Next:{Val:1,Next:<cycle to Node@1>}}` +
+			`}`
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("the same pointer in siblings is not a cycle", func(t *testing.T) {
+		// --- Given ---
+		type Node struct{ Val int }
+		val := &Node{Val: 1}
+		dmp := New(WithFlat, WithCompact)
+
+		// --- When ---
+		have := dmp.Any([]*Node{val, val})
+
+		// --- Then ---
+		affirm.Equal(t, "[]*dump.Node{{Val:1},{Val:1}}", have)
+	})
+
 	t.Run("format nested slices", func(t *testing.T) {
 		// --- Given ---
 		type Node struct {
@@ -630,6 +2162,71 @@ func Test_Dump_Diff_tabular(t *testing.T) {
 	}
 }
 
+func Test_Diff(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- When ---
+		have := Diff([]int{1, 2}, []int{1, 2})
+
+		// --- Then ---
+		affirm.Equal(t, "", have)
+	})
+
+	t.Run("single line values", func(t *testing.T) {
+		// --- When ---
+		have := Diff(1, 2)
+
+		// --- Then ---
+		affirm.Equal(t, "@@ -1 +1 @@\n-2\n+1", have)
+	})
+
+	t.Run("only differing lines with context", func(t *testing.T) {
+		// --- Given ---
+		want := []int{1, 2, 3, 4, 5, 6, 7}
+		have := []int{1, 2, 3, 4, 9, 6, 7}
+
+		// --- When ---
+		diff := Diff(want, have)
+
+		// --- Then ---
+		wDiff := "" +
+			"@@ -4,5 +4,5 @@\n" +
+			"   3,\n" +
+			"   4,\n" +
+			"-  9,\n" +
+			"+  5,\n" +
+			"   6,\n" +
+			"   7,"
+		affirm.Equal(t, wDiff, diff)
+	})
+
+	t.Run("flat and compact options are ignored", func(t *testing.T) {
+		// --- When ---
+		have := Diff([]int{1}, []int{2}, WithFlat, WithCompact)
+
+		// --- Then ---
+		affirm.Equal(t, "@@ -1,3 +1,3 @@\n []int{\n-  2,\n+  1,\n }", have)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		// --- Given ---
+		want := map[string]int{"A": 1, "B": 2}
+		have := map[string]int{"A": 1, "B": 3}
+
+		// --- When ---
+		diff := Diff(want, have, WithIndent(1))
+
+		// --- Then ---
+		wDiff := "" +
+			"@@ -1,4 +1,4 @@\n" +
+			"   map[string]int{\n" +
+			"     \"A\": 1,\n" +
+			"-    \"B\": 3,\n" +
+			"+    \"B\": 2,\n" +
+			"   }"
+		affirm.Equal(t, wDiff, diff)
+	})
+}
+
 func Test_Dump_forDiff(t *testing.T) {
 	t.Run("changes Flat and Compact configuration", func(t *testing.T) {
 		// --- Given ---
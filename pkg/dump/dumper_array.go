@@ -19,32 +19,45 @@ import (
 // configuration.
 func ArrayDumper(dmp Dump, lvl int, val reflect.Value) string {
 	prn := NewPrinter(dmp)
-	prn.Tab(dmp.Indent + lvl)
+	dmp.writeArray(prn, lvl, val)
+	return prn.String()
+}
 
+// writeArray writes the slice or array representation to the printer, one
+// element at a time. See [ArrayDumper].
+func (dmp Dump) writeArray(prn Printer, lvl int, val reflect.Value) {
 	if !(val.Kind() == reflect.Slice || val.Kind() == reflect.Array) {
-		return prn.Write(ValErrUsage).String()
+		prn.Tab(dmp.Indent + lvl).Write(ValErrUsage)
+		return
+	}
+
+	if dmp.useHexDump(val) {
+		prn.Write(HexDumpDumper(dmp, lvl, val))
+		return
 	}
 
+	prn.Tab(dmp.Indent + lvl)
+
 	if dmp.PrintType {
 		valTypStr := val.Type().String()
 		if dmp.UseAny {
 			valTypStr = strings.Replace(valTypStr, "interface {}", "any", 1)
 		}
-		prn.Write(valTypStr)
+		prn.Write(dmp.colorize(colorType, valTypStr))
 	}
 
 	num := val.Len()
+	cnt := dmp.maxItems(num)
 	prn.Write("{").NLI(num)
 
 	dmp.PrintType = false // Don't print types for array elements.
-	for i := 0; i < num; i++ {
+	for i := 0; i < cnt; i++ {
 		last := i == num-1
 
 		sub, _ := dmp.value(lvl+1, val.Index(i))
 		prn.Write(sub)
 		prn.Comma(last).Sep(last).NL()
 	}
+	dmp.moreItems(prn, lvl+1, num-cnt)
 	prn.Tab(dmp.Indent + lvl).Write("}")
-
-	return prn.String()
 }
@@ -64,6 +64,36 @@ func Test_ArrayDumper_tabular(t *testing.T) {
 			[2]int{0, 1},
 			"[2]int{0,1}",
 		},
+		{
+			"max items",
+			New(WithMaxItems(2)),
+			[4]int{0, 1, 2, 3},
+			"[4]int{\n  0,\n  1,\n  ... (+2 more)\n}",
+		},
+		{
+			"max items with indent",
+			New(WithMaxItems(1), WithIndent(1)),
+			[2]int{0, 1},
+			"  [2]int{\n    0,\n    ... (+1 more)\n  }",
+		},
+		{
+			"flat max items",
+			New(WithFlat, WithMaxItems(2)),
+			[]int{0, 1, 2, 3},
+			"[]int{0, 1, ... (+2 more)}",
+		},
+		{
+			"flat and compact max items",
+			New(WithFlat, WithCompact, WithMaxItems(1)),
+			[]int{0, 1, 2},
+			"[]int{0,... (+2 more)}",
+		},
+		{
+			"max items equal to length",
+			New(WithFlat, WithMaxItems(2)),
+			[2]int{0, 1},
+			"[2]int{0, 1}",
+		},
 		{
 			"flat array empty int",
 			New(WithFlat),
@@ -15,7 +15,9 @@ import (
 //
 // Returns [valErrUsage] ("<dump-usage-error>") string if the kind cannot be
 // matched. It returns string representation in the format defined by [Dump]
-// configuration.
+// configuration. When [Dump.ChanLen] is set, the number of buffered elements
+// and the channel capacity are displayed, for example,
+// "(chan int)(<addr> len=3 cap=10)".
 func ChanDumper(dmp Dump, lvl int, val reflect.Value) string {
 	var str string
 	switch val.Kind() {
@@ -25,6 +27,9 @@ func ChanDumper(dmp Dump, lvl int, val reflect.Value) string {
 			ptr := reflect.ValueOf(val.Pointer())
 			ptrAddr = HexPtrDumper(dmp, lvl, ptr)
 		}
+		if dmp.ChanLen {
+			ptrAddr += fmt.Sprintf(" len=%d cap=%d", val.Len(), val.Cap())
+		}
 		str = fmt.Sprintf("(%s)(%s)", val.Type(), ptrAddr)
 	default:
 		str = ValErrUsage
@@ -62,6 +62,33 @@ func Test_ChanDumper(t *testing.T) {
 		affirm.Equal(t, want, have)
 	})
 
+	t.Run("length and capacity", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithChanLen)
+		ch := make(chan int, 10)
+		ch <- 1
+		ch <- 2
+		ch <- 3
+
+		// --- When ---
+		have := ChanDumper(dmp, 0, reflect.ValueOf(ch))
+
+		// --- Then ---
+		affirm.Equal(t, "(chan int)(<addr> len=3 cap=10)", have)
+	})
+
+	t.Run("length and capacity of nil channel", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithChanLen, WithPtrAddr)
+		var ch chan int
+
+		// --- When ---
+		have := ChanDumper(dmp, 0, reflect.ValueOf(ch))
+
+		// --- Then ---
+		affirm.Equal(t, "(chan int)(<0x0> len=0 cap=0)", have)
+	})
+
 	t.Run("uses level", func(t *testing.T) {
 		// --- Given ---
 		dmp := New()
@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"context"
+	"reflect"
+	"strings"
+)
+
+// typContext is the [context.Context] interface type.
+var typContext = reflect.TypeOf((*context.Context)(nil)).Elem()
+
+// ContextDumper requires val to be a value representing [context.Context] and
+// returns its deadline, error and the values attached to it with
+// [context.WithValue], instead of the internal structures of the context
+// implementation. The values are found using reflection on the standard
+// library implementations, values shadowed by the same key are not dumped.
+// Returns [ValErrUsage] ("<dump-usage-error>") string if the type cannot be
+// matched.
+//
+// Example:
+//
+//	context.Context{
+//	  Deadline: nil,
+//	  Err: "context canceled",
+//	  Values: {
+//	    "key": "value",
+//	  },
+//	}
+func ContextDumper(dmp Dump, lvl int, val reflect.Value) string {
+	prn := NewPrinter(dmp).Tab(dmp.Indent + lvl)
+	if val.IsValid() && val.Kind() == reflect.Interface && val.IsNil() {
+		return prn.Write(ValNil).String()
+	}
+	if !val.IsValid() || !val.CanInterface() {
+		return prn.Write(ValErrUsage).String()
+	}
+	ctx, ok := val.Interface().(context.Context)
+	if !ok || ctx == nil {
+		return prn.Write(ValErrUsage).String()
+	}
+
+	prn.Write(dmp.colorize(colorType, "context.Context")).Write("{").NL()
+
+	prn.Tab(dmp.Indent + lvl + 1).Write("Deadline:").Space()
+	if deadline, ok := ctx.Deadline(); ok {
+		sub, _ := dmp.value(lvl+1, reflect.ValueOf(deadline))
+		prn.Write(strings.TrimLeft(sub, " \t"))
+	} else {
+		prn.Write(ValNil)
+	}
+	prn.Comma(false).Sep(false).NL()
+
+	err := ctx.Err()
+	sub, _ := dmp.value(lvl+1, reflect.ValueOf(&err).Elem())
+	prn.Tab(dmp.Indent + lvl + 1).Write("Err:").Space()
+	prn.Write(strings.TrimLeft(sub, " \t"))
+	prn.Comma(false).Sep(false).NL()
+
+	keys, vals := contextValues(val)
+	num := len(keys)
+	prn.Tab(dmp.Indent + lvl + 1).Write("Values:").Space().Write("{").NLI(num)
+	for i := range keys {
+		last := i == num-1
+		sub, _ = dmp.value(lvl+2, keys[i])
+		prn.Write(sub).Write(":").Space()
+		sub, _ = dmp.value(lvl+2, vals[i])
+		prn.Write(strings.TrimLeft(sub, " \t"))
+		prn.Comma(last).Sep(last).NL()
+	}
+	if num > 0 {
+		prn.Tab(dmp.Indent + lvl + 1)
+	}
+	prn.Write("}").Comma(true).Sep(true).NL()
+
+	return prn.Tab(dmp.Indent + lvl).Write("}").String()
+}
+
+// isContext returns true if the value is the [context.Context] interface
+// value or one of the context implementations from the standard library, and
+// it can be used with [reflect.Value.Interface].
+func isContext(val reflect.Value) bool {
+	if !val.IsValid() || !val.CanInterface() {
+		return false
+	}
+	typ := val.Type()
+	if typ == typContext {
+		return true
+	}
+	if !typ.Implements(typContext) {
+		return false
+	}
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	return typ.PkgPath() == "context"
+}
+
+// contextValues returns keys and values attached to the context with
+// [context.WithValue], starting with the most recently attached one. Only the
+// standard library context implementations are supported.
+func contextValues(val reflect.Value) ([]reflect.Value, []reflect.Value) {
+	var keys, vals []reflect.Value
+	for {
+		for val.Kind() == reflect.Interface || val.Kind() == reflect.Pointer {
+			if val.IsNil() {
+				return keys, vals
+			}
+			val = val.Elem()
+		}
+		if val.Kind() != reflect.Struct || val.Type().PkgPath() != "context" {
+			return keys, vals
+		}
+
+		key := accessible(val.FieldByName("key"))
+		if key.IsValid() && key.CanInterface() && !hasKey(keys, key) {
+			keys = append(keys, key)
+			vals = append(vals, accessible(val.FieldByName("val")))
+		}
+		val = contextParent(val)
+	}
+}
+
+// contextParent returns the parent context of the standard library context
+// implementation. Returns invalid value if the parent cannot be found.
+func contextParent(val reflect.Value) reflect.Value {
+	for _, name := range []string{"Context", "c"} {
+		fld := val.FieldByName(name)
+		if fld.IsValid() && fld.Type() == typContext {
+			return fld
+		}
+	}
+	return reflect.Value{}
+}
+
+// hasKey returns true if the keys contain the key.
+func hasKey(keys []reflect.Value, key reflect.Value) bool {
+	for _, k := range keys {
+		if k.Interface() == key.Interface() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,271 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/internal/types"
+)
+
+// tCtxKey is a type used as a context key in tests.
+type tCtxKey string
+
+func Test_ContextDumper(t *testing.T) {
+	t.Run("background", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFlat)
+		val := reflect.ValueOf(context.Background())
+
+		// --- When ---
+		have := ContextDumper(dmp, 0, val)
+
+		// --- Then ---
+		want := "context.Context{Deadline: nil, Err: nil, Values: {}}"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("values", func(t *testing.T) {
+		// --- Given ---
+		ctx := context.WithValue(context.Background(), tCtxKey("a"), 1)
+		ctx = context.WithValue(ctx, tCtxKey("b"), "x")
+		dmp := New()
+
+		// --- When ---
+		have := ContextDumper(dmp, 0, reflect.ValueOf(ctx))
+
+		// --- Then ---
+		want := "context.Context{\n" +
+			"  Deadline: nil,\n" +
+			"  Err: nil,\n" +
+			"  Values: {\n" +
+			"    \"b\": \"x\",\n" +
+			"    \"a\": 1,\n" +
+			"  },\n" +
+			"}"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("shadowed values are not dumped", func(t *testing.T) {
+		// --- Given ---
+		ctx := context.WithValue(context.Background(), tCtxKey("a"), 1)
+		ctx = context.WithValue(ctx, tCtxKey("a"), 2)
+		dmp := New(WithFlat)
+
+		// --- When ---
+		have := ContextDumper(dmp, 0, reflect.ValueOf(ctx))
+
+		// --- Then ---
+		want := `context.Context{Deadline: nil, Err: nil, Values: {"a": 2}}`
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("canceled with deadline", func(t *testing.T) {
+		// --- Given ---
+		ctx := context.WithValue(context.Background(), tCtxKey("a"), 1)
+		tim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+		ctx, cancel := context.WithDeadline(ctx, tim)
+		cancel()
+		dmp := New(WithFlat)
+
+		// --- When ---
+		have := ContextDumper(dmp, 0, reflect.ValueOf(ctx))
+
+		// --- Then ---
+		want := "context.Context{" +
+			`Deadline: "2000-01-02T03:04:05Z", ` +
+			`Err: "context deadline exceeded", ` +
+			`Values: {"a": 1}}`
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		// --- Given ---
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		dmp := New(WithFlat)
+
+		// --- When ---
+		have := ContextDumper(dmp, 0, reflect.ValueOf(ctx))
+
+		// --- Then ---
+		want := "context.Context{" +
+			`Deadline: nil, Err: "context canceled", Values: {}}`
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("without cancel", func(t *testing.T) {
+		// --- Given ---
+		ctx := context.WithValue(context.Background(), tCtxKey("a"), 1)
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		ctx = context.WithoutCancel(ctx)
+		dmp := New(WithFlat)
+
+		// --- When ---
+		have := ContextDumper(dmp, 0, reflect.ValueOf(ctx))
+
+		// --- Then ---
+		want := `context.Context{Deadline: nil, Err: nil, Values: {"a": 1}}`
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("no values with indent and level", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithIndent(1))
+
+		// --- When ---
+		have := ContextDumper(dmp, 1, reflect.ValueOf(context.TODO()))
+
+		// --- Then ---
+		want := "    context.Context{\n" +
+			"      Deadline: nil,\n" +
+			"      Err: nil,\n" +
+			"      Values: {},\n" +
+			"    }"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("nil interface", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ Ctx context.Context }
+		dmp := New()
+		val := reflect.ValueOf(T{}).Field(0)
+
+		// --- When ---
+		have := ContextDumper(dmp, 0, val)
+
+		// --- Then ---
+		affirm.Equal(t, ValNil, have)
+	})
+
+	t.Run("uses indent and level", func(t *testing.T) {
+		// --- Given ---
+		ctx := context.WithValue(context.Background(), tCtxKey("a"), 1)
+		dmp := New(WithIndent(1))
+
+		// --- When ---
+		have := ContextDumper(dmp, 1, reflect.ValueOf(ctx))
+
+		// --- Then ---
+		want := "    context.Context{\n" +
+			"      Deadline: nil,\n" +
+			"      Err: nil,\n" +
+			"      Values: {\n" +
+			"        \"a\": 1,\n" +
+			"      },\n" +
+			"    }"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithIndent(1))
+
+		// --- When ---
+		have := ContextDumper(dmp, 2, reflect.ValueOf(123))
+
+		// --- Then ---
+		affirm.Equal(t, "      "+ValErrUsage, have)
+	})
+}
+
+func Test_isContext(t *testing.T) {
+	t.Run("context implementation", func(t *testing.T) {
+		// --- Given ---
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		// --- When ---
+		have := isContext(reflect.ValueOf(ctx))
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("context interface", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ Ctx context.Context }
+
+		// --- When ---
+		have := isContext(reflect.ValueOf(T{}).Field(0))
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("type embedding context", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ context.Context }
+
+		// --- When ---
+		have := isContext(reflect.ValueOf(T{Context: context.TODO()}))
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("not exported field", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ ctx context.Context }
+		val := T{ctx: context.TODO()}
+
+		// --- When ---
+		have := isContext(reflect.ValueOf(val).Field(0))
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("not context", func(t *testing.T) {
+		// --- When ---
+		have := isContext(reflect.ValueOf(types.TIntStr{}))
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		// --- When ---
+		have := isContext(reflect.Value{})
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+}
+
+func Test_Dump_Any_context(t *testing.T) {
+	t.Run("struct field", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ Ctx context.Context }
+		val := T{Ctx: context.WithValue(context.TODO(), tCtxKey("a"), 1)}
+		dmp := New(WithFlat)
+
+		// --- When ---
+		have := dmp.Any(val)
+
+		// --- Then ---
+		want := "{Ctx: context.Context{" +
+			`Deadline: nil, Err: nil, Values: {"a": 1}}}`
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("not exported field with unsafe access", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ ctx context.Context }
+		val := T{ctx: context.TODO()}
+		dmp := New(WithFlat, WithUnsafeUnexported)
+
+		// --- When ---
+		have := dmp.Any(val)
+
+		// --- Then ---
+		want := "{ctx: context.Context{Deadline: nil, Err: nil, Values: {}}}"
+		affirm.Equal(t, want, have)
+	})
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// ErrorDumper is a dumper for error values. It requires val to be a value
+// implementing the error interface and returns the chain of wrapped errors,
+// each layer with its dynamic type and message. The wrapped errors are found
+// using "Unwrap() error" and "Unwrap() []error" methods, the same way as
+// [errors.Is] does. Returns [ValErrUsage] ("<dump-usage-error>") string if the
+// value is not a not-nil error.
+//
+// Example:
+//
+//	*fmt.wrapError("read: EOF") {
+//	  *errors.errorString("EOF"),
+//	}
+func ErrorDumper(dmp Dump, lvl int, val reflect.Value) string {
+	prn := NewPrinter(dmp).Tab(dmp.Indent + lvl)
+	err, ok := errorValue(val)
+	if !ok {
+		return prn.Write(ValErrUsage).String()
+	}
+	dmp.writeError(prn, lvl, err)
+	return prn.String()
+}
+
+// writeError writes the error and the errors it wraps to the printer.
+func (dmp Dump) writeError(prn Printer, lvl int, err error) {
+	prn.Write(dmp.colorize(colorType, fmt.Sprintf("%T", err)))
+	prn.Write("(")
+	prn.Write(dmp.colorize(colorString, strconv.Quote(err.Error())))
+	prn.Write(")")
+
+	causes := unwrapError(err)
+	num := len(causes)
+	if num == 0 {
+		return
+	}
+	prn.Space()
+	if lvl >= dmp.MaxDepth {
+		prn.Write(ValMaxNestObj)
+		return
+	}
+
+	prn.Write("{").NLI(num)
+	for i, cause := range causes {
+		last := i == num-1
+		prn.Tab(dmp.Indent + lvl + 1)
+		dmp.writeError(prn, lvl+1, cause)
+		prn.Comma(last).Sep(last).NL()
+	}
+	prn.Tab(dmp.Indent + lvl).Write("}")
+}
+
+// errorValue returns the error represented by the value and true. Returns
+// false if the value doesn't implement the error interface, is nil, or cannot
+// be used without panicking.
+func errorValue(val reflect.Value) (error, bool) {
+	if !val.IsValid() || !val.CanInterface() {
+		return nil, false
+	}
+	err, ok := val.Interface().(error)
+	if !ok || err == nil {
+		return nil, false
+	}
+	ev := reflect.ValueOf(err)
+	if ev.Kind() == reflect.Pointer && ev.IsNil() {
+		return nil, false
+	}
+	return err, true
+}
+
+// unwrapError returns errors wrapped by the error. Returns nil if the error
+// doesn't wrap any errors.
+func unwrapError(err error) []error {
+	var causes []error
+	switch x := err.(type) { // nolint: errorlint
+	case interface{ Unwrap() error }:
+		causes = append(causes, x.Unwrap())
+	case interface{ Unwrap() []error }:
+		causes = x.Unwrap()
+	}
+	var ret []error
+	for _, cause := range causes {
+		if cause != nil {
+			ret = append(ret, cause)
+		}
+	}
+	return ret
+}
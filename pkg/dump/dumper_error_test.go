@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+)
+
+// tPtrError is an error implemented on a pointer receiver.
+type tPtrError struct{ msg string }
+
+func (e *tPtrError) Error() string { return e.msg }
+
+func Test_ErrorDumper(t *testing.T) {
+	t.Run("not wrapped error", func(t *testing.T) {
+		// --- Given ---
+		dmp := New()
+
+		// --- When ---
+		have := ErrorDumper(dmp, 0, reflect.ValueOf(io.EOF))
+
+		// --- Then ---
+		affirm.Equal(t, `*errors.errorString("EOF")`, have)
+	})
+
+	t.Run("wrapped error", func(t *testing.T) {
+		// --- Given ---
+		err := fmt.Errorf("read: %w", io.EOF)
+		dmp := New()
+
+		// --- When ---
+		have := ErrorDumper(dmp, 0, reflect.ValueOf(err))
+
+		// --- Then ---
+		want := "*fmt.wrapError(\"read: EOF\") {\n" +
+			"  *errors.errorString(\"EOF\"),\n" +
+			"}"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("joined errors", func(t *testing.T) {
+		// --- Given ---
+		err := errors.Join(io.EOF, fmt.Errorf("x: %w", io.ErrUnexpectedEOF))
+		dmp := New()
+
+		// --- When ---
+		have := ErrorDumper(dmp, 0, reflect.ValueOf(err))
+
+		// --- Then ---
+		want := "*errors.joinError(\"EOF\\nx: unexpected EOF\") {\n" +
+			"  *errors.errorString(\"EOF\"),\n" +
+			"  *fmt.wrapError(\"x: unexpected EOF\") {\n" +
+			"    *errors.errorString(\"unexpected EOF\"),\n" +
+			"  },\n" +
+			"}"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("flat", func(t *testing.T) {
+		// --- Given ---
+		err := errors.Join(io.EOF, fmt.Errorf("x: %w", io.ErrUnexpectedEOF))
+		dmp := New(WithFlat)
+
+		// --- When ---
+		have := ErrorDumper(dmp, 0, reflect.ValueOf(err))
+
+		// --- Then ---
+		want := `*errors.joinError("EOF\nx: unexpected EOF") {` +
+			`*errors.errorString("EOF"), ` +
+			`*fmt.wrapError("x: unexpected EOF") ` +
+			`{*errors.errorString("unexpected EOF")}}`
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("max depth", func(t *testing.T) {
+		// --- Given ---
+		err := fmt.Errorf("b: %w", fmt.Errorf("a: %w", io.EOF))
+		dmp := New(WithMaxDepth(1))
+
+		// --- When ---
+		have := ErrorDumper(dmp, 0, reflect.ValueOf(err))
+
+		// --- Then ---
+		want := "*fmt.wrapError(\"b: a: EOF\") {\n" +
+			"  *fmt.wrapError(\"a: EOF\") {...},\n" +
+			"}"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("uses indent and level", func(t *testing.T) {
+		// --- Given ---
+		err := fmt.Errorf("read: %w", io.EOF)
+		dmp := New(WithIndent(1))
+
+		// --- When ---
+		have := ErrorDumper(dmp, 1, reflect.ValueOf(err))
+
+		// --- Then ---
+		want := "    *fmt.wrapError(\"read: EOF\") {\n" +
+			"      *errors.errorString(\"EOF\"),\n" +
+			"    }"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("error - not error value", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithIndent(1))
+
+		// --- When ---
+		have := ErrorDumper(dmp, 2, reflect.ValueOf(123))
+
+		// --- Then ---
+		affirm.Equal(t, "      "+ValErrUsage, have)
+	})
+
+	t.Run("error - nil pointer error", func(t *testing.T) {
+		// --- Given ---
+		dmp := New()
+
+		// --- When ---
+		have := ErrorDumper(dmp, 0, reflect.ValueOf((*tPtrError)(nil)))
+
+		// --- Then ---
+		affirm.Equal(t, ValErrUsage, have)
+	})
+}
+
+func Test_errorValue(t *testing.T) {
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		have, ok := errorValue(reflect.ValueOf(io.EOF))
+
+		// --- Then ---
+		affirm.Equal(t, true, ok)
+		affirm.Equal(t, true, errors.Is(have, io.EOF))
+	})
+
+	t.Run("interface with error", func(t *testing.T) {
+		// --- Given ---
+		val := struct{ Err error }{Err: io.EOF}
+
+		// --- When ---
+		have, ok := errorValue(reflect.ValueOf(val).Field(0))
+
+		// --- Then ---
+		affirm.Equal(t, true, ok)
+		affirm.Equal(t, true, errors.Is(have, io.EOF))
+	})
+
+	t.Run("nil interface", func(t *testing.T) {
+		// --- Given ---
+		val := struct{ Err error }{}
+
+		// --- When ---
+		have, ok := errorValue(reflect.ValueOf(val).Field(0))
+
+		// --- Then ---
+		affirm.Equal(t, false, ok)
+		affirm.Nil(t, have)
+	})
+
+	t.Run("nil pointer", func(t *testing.T) {
+		// --- When ---
+		have, ok := errorValue(reflect.ValueOf((*tPtrError)(nil)))
+
+		// --- Then ---
+		affirm.Equal(t, false, ok)
+		affirm.Nil(t, have)
+	})
+
+	t.Run("not error", func(t *testing.T) {
+		// --- When ---
+		have, ok := errorValue(reflect.ValueOf(123))
+
+		// --- Then ---
+		affirm.Equal(t, false, ok)
+		affirm.Nil(t, have)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		// --- When ---
+		have, ok := errorValue(reflect.Value{})
+
+		// --- Then ---
+		affirm.Equal(t, false, ok)
+		affirm.Nil(t, have)
+	})
+}
+
+func Test_unwrapError(t *testing.T) {
+	t.Run("not wrapped", func(t *testing.T) {
+		// --- When ---
+		have := unwrapError(io.EOF)
+
+		// --- Then ---
+		affirm.Nil(t, have)
+	})
+
+	t.Run("wrapped", func(t *testing.T) {
+		// --- When ---
+		have := unwrapError(fmt.Errorf("read: %w", io.EOF))
+
+		// --- Then ---
+		affirm.DeepEqual(t, []error{io.EOF}, have)
+	})
+
+	t.Run("joined", func(t *testing.T) {
+		// --- When ---
+		have := unwrapError(errors.Join(io.EOF, io.ErrUnexpectedEOF))
+
+		// --- Then ---
+		affirm.DeepEqual(t, []error{io.EOF, io.ErrUnexpectedEOF}, have)
+	})
+
+	t.Run("not wrapping format", func(t *testing.T) {
+		// --- When ---
+		have := unwrapError(fmt.Errorf("read: %v", io.EOF))
+
+		// --- Then ---
+		affirm.Nil(t, have)
+	})
+}
@@ -5,7 +5,9 @@ package dump
 
 import (
 	"fmt"
+	"path/filepath"
 	"reflect"
+	"runtime"
 )
 
 // FuncDumper is a generic dumper for functions. It expects val to represent
@@ -15,7 +17,11 @@ import (
 //
 // Returns [valErrUsage] ("<dump-usage-error>") string if kind cannot be
 // matched. It returns string representation in the format defined by [Dump]
-// configuration.
+// configuration. When [Dump.FuncNames] is set, the qualified function name
+// and the place of its definition are displayed, for example,
+// "<func github.com/acme/svc.HandleOrder (handlers.go:42)>". Anonymous
+// functions are named after the enclosing function, for example,
+// "<func github.com/acme/svc.HandleOrder.func1 (handlers.go:45)>".
 func FuncDumper(dmp Dump, lvl int, val reflect.Value) string {
 	var str string
 	switch val.Kind() {
@@ -25,7 +31,13 @@ func FuncDumper(dmp Dump, lvl int, val reflect.Value) string {
 			ptr := reflect.ValueOf(val.Pointer())
 			ptrAddr = HexPtrDumper(dmp, lvl, ptr)
 		}
-		str = fmt.Sprintf("%s(%s)", ValFunc, ptrAddr)
+		str = funcName(val)
+		if str == "" || !dmp.FuncNames {
+			str = ValFunc
+		}
+		if !dmp.FuncNames || dmp.PtrAddr {
+			str = fmt.Sprintf("%s(%s)", str, ptrAddr)
+		}
 	default:
 		str = ValErrUsage
 	}
@@ -33,3 +45,18 @@ func FuncDumper(dmp Dump, lvl int, val reflect.Value) string {
 	prn := NewPrinter(dmp)
 	return prn.Tab(dmp.Indent + lvl).Write(str).String()
 }
+
+// funcName returns the function name in the format
+// "<func qualified.Name (file.go:line)>". Returns empty string for nil
+// functions or when the name cannot be resolved.
+func funcName(val reflect.Value) string {
+	if val.IsNil() {
+		return ""
+	}
+	fn := runtime.FuncForPC(val.Pointer())
+	if fn == nil {
+		return ""
+	}
+	file, line := fn.FileLine(fn.Entry())
+	return fmt.Sprintf("<func %s (%s:%d)>", fn.Name(), filepath.Base(file), line)
+}
@@ -11,6 +11,15 @@ import (
 	"github.com/ctx42/testing/internal/affirm"
 )
 
+// testFunc is used in tests of function names.
+func testFunc() {}
+
+// testFuncParent returns an anonymous function used in tests of function
+// names. It's not inlined to keep the anonymous function name stable.
+//
+//go:noinline
+func testFuncParent() func() { return func() {} }
+
 func Test_FuncDumper(t *testing.T) {
 	t.Run("nil function", func(t *testing.T) {
 		// --- Given ---
@@ -52,6 +61,63 @@ func Test_FuncDumper(t *testing.T) {
 		affirm.Equal(t, want, have)
 	})
 
+	t.Run("function name", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFuncNames)
+		val := reflect.ValueOf(testFunc)
+
+		// --- When ---
+		have := FuncDumper(dmp, 0, val)
+
+		// --- Then ---
+		want := "<func github.com/ctx42/testing/pkg/dump.testFunc " +
+			"(dumper_func_test.go:15)>"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("anonymous function name", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFuncNames)
+		val := reflect.ValueOf(testFuncParent())
+
+		// --- When ---
+		have := FuncDumper(dmp, 0, val)
+
+		// --- Then ---
+		want := "<func github.com/ctx42/testing/pkg/dump.testFuncParent.func1 " +
+			"(dumper_func_test.go:21)>"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("function name with pointer address", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFuncNames, WithPtrAddr)
+		val := reflect.ValueOf(testFunc)
+		want := fmt.Sprintf(
+			"<func github.com/ctx42/testing/pkg/dump.testFunc "+
+				"(dumper_func_test.go:15)>(<0x%x>)",
+			val.Pointer(),
+		)
+
+		// --- When ---
+		have := FuncDumper(dmp, 0, val)
+
+		// --- Then ---
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("nil function name", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFuncNames)
+		var fn func()
+
+		// --- When ---
+		have := FuncDumper(dmp, 0, reflect.ValueOf(fn))
+
+		// --- Then ---
+		affirm.Equal(t, "<func>", have)
+	})
+
 	t.Run("uses indent and level", func(t *testing.T) {
 		// --- Given ---
 		dmp := New(WithIndent(2))
@@ -0,0 +1,371 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Types used to decide if a Go literal needs a type conversion.
+var (
+	typBool       = reflect.TypeOf(false)
+	typInt        = reflect.TypeOf(0)
+	typString     = reflect.TypeOf("")
+	typFloat64    = reflect.TypeOf(0.0)
+	typComplex128 = reflect.TypeOf(complex128(0))
+)
+
+// Ptr returns a pointer to the given value. It is used by [GoDumper] to
+// represent pointers to values which cannot be addressed in Go literals.
+func Ptr[T any](v T) *T { return &v }
+
+// GoDumper is a dumper rendering values as Go literals which can be copied
+// directly to the Go source code. For example, the dumped "have" value can be
+// used as the new expected value in a test.
+//
+// Types are qualified with the package name (not the import path). Pointers
+// to composite values are rendered with the "&" operator, pointers to other
+// values using the [Ptr] helper. Values which cannot be represented as Go
+// literals (functions, channels, unsafe pointers) are rendered using the
+// package markers, for example, [ValFunc]. Custom dumpers, colors and types
+// configuration are not used.
+func GoDumper(dmp Dump, lvl int, val reflect.Value) string {
+	dmp.Color = false
+	prn := NewPrinter(dmp)
+	prn.Tab(dmp.Indent + lvl)
+	return prn.Write(dmp.goValue(lvl, val, false, true)).String()
+}
+
+// goValue returns the Go literal representing the value. The first line of
+// the result is not indented. When "elide" is true, the type of composite
+// literals is omitted as allowed in elements of slices, arrays and maps. When
+// "iface" is true, the static type of the value is not known from the context
+// (it is an interface), so the literal must carry the type.
+//
+// nolint: cyclop, gocognit
+func (dmp Dump) goValue(lvl int, val reflect.Value, elide, iface bool) string {
+	if lvl > dmp.MaxDepth {
+		return maxNesting(val)
+	}
+
+	if !val.IsValid() {
+		return ValNil
+	}
+
+	if str, ok := dmp.stringer(val); ok {
+		return str
+	}
+
+	typ := val.Type()
+	switch typ {
+	case typTime:
+		return goTime(val.Interface().(time.Time)) // nolint: forcetypeassert
+	case typDur:
+		return fmt.Sprintf("time.Duration(%d)", val.Int())
+	}
+	if typ == typError || typ.String() == "*errors.errorString" {
+		if val.IsNil() {
+			return ValNil
+		}
+		err := val.Interface().(error) // nolint: forcetypeassert
+		return fmt.Sprintf("errors.New(%q)", err.Error())
+	}
+
+	switch val.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Complex64, reflect.Complex128:
+		return goScalar(val, iface)
+
+	case reflect.Interface:
+		if val.IsNil() {
+			return ValNil
+		}
+		return dmp.goValue(lvl, val.Elem(), false, true)
+
+	case reflect.Pointer:
+		if val.IsNil() {
+			return dmp.goNil(val, iface)
+		}
+		if val.Type().Elem() == typLocation {
+			loc := val.Interface().(*time.Location) // nolint: forcetypeassert
+			return goLocation(loc, time.Now())
+		}
+		vis := visit{ptr: val.Pointer(), typ: typ}
+		if at, ok := dmp.visited[vis]; ok {
+			return fmt.Sprintf(ValCycle, typeName(typ.Elem()), at)
+		}
+		if dmp.visited == nil {
+			dmp.visited = make(map[visit]int)
+		}
+		dmp.visited[vis] = lvl
+		defer delete(dmp.visited, vis)
+
+		elem := val.Elem()
+		switch elem.Kind() {
+		case reflect.Struct, reflect.Array, reflect.Slice, reflect.Map:
+			if elem.Type() == typTime {
+				break
+			}
+			sub := dmp.goValue(lvl, elem, elide, false)
+			if elide {
+				return sub
+			}
+			return "&" + sub
+		}
+		return "dump.Ptr(" + dmp.goValue(lvl, elem, false, true) + ")"
+
+	case reflect.Array, reflect.Slice:
+		if val.Kind() == reflect.Slice && val.IsNil() {
+			return dmp.goNil(val, iface)
+		}
+		return dmp.goArray(lvl, val, elide)
+
+	case reflect.Map:
+		if val.IsNil() {
+			return dmp.goNil(val, iface)
+		}
+		return dmp.goMap(lvl, val, elide)
+
+	case reflect.Struct:
+		return dmp.goStruct(lvl, val, elide)
+
+	case reflect.Func:
+		if val.IsNil() {
+			return dmp.goNil(val, iface)
+		}
+		return ValFunc
+
+	case reflect.Chan:
+		if val.IsNil() {
+			return dmp.goNil(val, iface)
+		}
+		return ValChan
+
+	case reflect.UnsafePointer:
+		return ValAddr
+
+	default:
+		return ValErrUsage
+	}
+}
+
+// goArray returns Go literal for slices and arrays.
+func (dmp Dump) goArray(lvl int, val reflect.Value, elide bool) string {
+	prn := NewPrinter(dmp)
+	if !elide {
+		prn.Write(dmp.goType(val.Type()))
+	}
+
+	num := val.Len()
+	cnt := dmp.maxItems(num)
+	prn.Write("{").NLI(num)
+
+	sElide, sIface := goElemCtx(val.Type().Elem())
+	for i := 0; i < cnt; i++ {
+		last := i == num-1
+		sub := dmp.goValue(lvl+1, val.Index(i), sElide, sIface)
+		prn.Tab(dmp.Indent + lvl + 1).Write(sub)
+		prn.Comma(last).Sep(last).NL()
+	}
+	dmp.moreItems(prn, lvl+1, num-cnt)
+	prn.Tab(dmp.Indent + lvl).Write("}")
+
+	return prn.String()
+}
+
+// goMap returns Go literal for maps.
+func (dmp Dump) goMap(lvl int, val reflect.Value, elide bool) string {
+	prn := NewPrinter(dmp)
+	if !elide {
+		prn.Write(dmp.goType(val.Type()))
+	}
+
+	keys := val.MapKeys()
+	dmp.sortKeys(keys)
+
+	num := val.Len()
+	cnt := dmp.maxItems(num)
+	prn.Write("{").NLI(num)
+
+	kElide, kIface := goElemCtx(val.Type().Key())
+	vElide, vIface := goElemCtx(val.Type().Elem())
+	for i, key := range keys[:cnt] {
+		last := i == num-1
+		prn.Tab(dmp.Indent + lvl + 1)
+		prn.Write(dmp.goValue(lvl+1, key, kElide, kIface))
+		prn.Write(":").Space()
+		prn.Write(dmp.goValue(lvl+1, val.MapIndex(key), vElide, vIface))
+		prn.Comma(last).Sep(last).NL()
+	}
+	dmp.moreItems(prn, lvl+1, num-cnt)
+	prn.Tab(dmp.Indent + lvl).Write("}")
+
+	return prn.String()
+}
+
+// goStruct returns Go literal for structs.
+func (dmp Dump) goStruct(lvl int, val reflect.Value, elide bool) string {
+	prn := NewPrinter(dmp)
+	if !elide {
+		prn.Write(dmp.goType(val.Type()))
+	}
+
+	if dmp.UnsafeUnexported {
+		val = addressable(val)
+	}
+	typ := val.Type()
+	fields := make([]int, 0, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		if typ.Field(i).IsExported() || dmp.PrintPrivate {
+			fields = append(fields, i)
+		}
+	}
+
+	num := len(fields)
+	prn.Write("{").NLI(num)
+	for i, idx := range fields {
+		last := i == num-1
+		fld := typ.Field(idx)
+		iface := fld.Type.Kind() == reflect.Interface
+		prn.Tab(dmp.Indent + lvl + 1).Write(fld.Name).Write(":").Space()
+		if dmp.IsRedacted(fld) {
+			prn.Write(ValRedacted)
+		} else {
+			prn.Write(dmp.goValue(lvl+1, dmp.field(val, idx), false, iface))
+		}
+		prn.Comma(last).Sep(last).NL()
+	}
+	prn.Tab(dmp.Indent + lvl).Write("}")
+
+	return prn.String()
+}
+
+// goType returns Go representation of the type.
+func (dmp Dump) goType(typ reflect.Type) string {
+	str := typ.String()
+	if dmp.UseAny {
+		str = strings.ReplaceAll(str, "interface {}", "any")
+	}
+	return str
+}
+
+// goElemCtx returns "elide" and "iface" arguments for [Dump.goValue] when
+// dumping elements of the given type in slices, arrays and maps.
+func goElemCtx(typ reflect.Type) (bool, bool) {
+	if typ.Kind() == reflect.Interface {
+		return false, true
+	}
+	return true, false
+}
+
+// goNil returns Go literal for nil values of the type which is not an
+// interface.
+func (dmp Dump) goNil(val reflect.Value, iface bool) string {
+	if !iface {
+		return ValNil
+	}
+	return fmt.Sprintf("(%s)(nil)", dmp.goType(val.Type()))
+}
+
+// goScalar returns Go literal for booleans, strings and numbers. When "iface"
+// is true, the literal is converted to the value type unless it is the
+// default type of the literal.
+//
+// nolint: cyclop
+func goScalar(val reflect.Value, iface bool) string {
+	var str string
+	switch val.Kind() {
+	case reflect.Bool:
+		str = strconv.FormatBool(val.Bool())
+
+	case reflect.String:
+		str = strconv.Quote(val.String())
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		str = strconv.FormatInt(val.Int(), 10)
+
+	case reflect.Uint8:
+		str = fmt.Sprintf("0x%02x", val.Uint())
+
+	case reflect.Uint, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Uintptr:
+		str = strconv.FormatUint(val.Uint(), 10)
+
+	case reflect.Float32:
+		str = goFloat(val.Float(), 32)
+
+	case reflect.Float64:
+		str = goFloat(val.Float(), 64)
+
+	case reflect.Complex64, reflect.Complex128:
+		c := val.Complex()
+		str = fmt.Sprintf(
+			"complex(%s, %s)",
+			goFloat(real(c), 64),
+			goFloat(imag(c), 64),
+		)
+	}
+
+	if !iface {
+		return str
+	}
+	switch typ := val.Type(); typ {
+	case typBool, typInt, typString, typComplex128:
+		return str
+	case typFloat64:
+		if strings.ContainsAny(str, ".e(") {
+			return str
+		}
+		return str + ".0"
+	default:
+		return typ.String() + "(" + str + ")"
+	}
+}
+
+// goFloat returns Go literal for the floating point number with given bit
+// size.
+func goFloat(f float64, bits int) string {
+	switch {
+	case math.IsNaN(f):
+		return "math.NaN()"
+	case math.IsInf(f, 1):
+		return "math.Inf(1)"
+	case math.IsInf(f, -1):
+		return "math.Inf(-1)"
+	}
+	return strconv.FormatFloat(f, 'g', -1, bits)
+}
+
+// goTime returns Go literal for [time.Time].
+func goTime(tim time.Time) string {
+	return fmt.Sprintf(
+		"time.Date(%d, %d, %d, %d, %d, %d, %d, %s)",
+		tim.Year(), tim.Month(), tim.Day(),
+		tim.Hour(), tim.Minute(), tim.Second(), tim.Nanosecond(),
+		goLocation(tim.Location(), tim),
+	)
+}
+
+// goLocation returns Go expression for [time.Location]. Locations other than
+// [time.UTC] and [time.Local] are represented as [time.FixedZone] with the
+// location offset at the given time.
+func goLocation(loc *time.Location, at time.Time) string {
+	switch loc {
+	case time.UTC:
+		return "time.UTC"
+	case time.Local:
+		return "time.Local"
+	}
+	name, offset := at.In(loc).Zone()
+	return fmt.Sprintf("time.FixedZone(%q, %d)", name, offset)
+}
@@ -0,0 +1,238 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"errors"
+	"go/parser"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/internal/types"
+)
+
+func Test_Ptr(t *testing.T) {
+	// --- When ---
+	have := Ptr(42)
+
+	// --- Then ---
+	affirm.Equal(t, 42, *have)
+}
+
+func Test_GoDumper_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		val  any
+		want string
+	}{
+		{"nil", nil, "nil"},
+		{"bool", true, "true"},
+		{"int", 123, "123"},
+		{"int8", int8(-123), "int8(-123)"},
+		{"uint", uint(123), "uint(123)"},
+		{"uint8", uint8(10), "uint8(0x0a)"},
+		{"float32", float32(1.5), "float32(1.5)"},
+		{"float64", 1.5, "1.5"},
+		{"float64 integer", 2.0, "2.0"},
+		{"float64 exponent", 1e21, "1e+21"},
+		{"float64 NaN", math.NaN(), "math.NaN()"},
+		{"float64 inf", math.Inf(-1), "math.Inf(-1)"},
+		{"complex128", complex(1, 2), "complex(1, 2)"},
+		{"complex64", complex64(complex(1, 2)), "complex64(complex(1, 2))"},
+		{"string", "a\nb", `"a\nb"`},
+		{"named int", types.TIntType(1), "types.TIntType(1)"},
+		{"named string", types.TStrType("a"), `types.TStrType("a")`},
+		{"duration", time.Second, "time.Duration(1000000000)"},
+		{
+			"time UTC",
+			time.Date(2000, 1, 2, 3, 4, 5, 6, time.UTC),
+			"time.Date(2000, 1, 2, 3, 4, 5, 6, time.UTC)",
+		},
+		{
+			"time in location",
+			time.Date(2000, 1, 2, 3, 4, 5, 6, types.WAW),
+			`time.Date(2000, 1, 2, 3, 4, 5, 6, time.FixedZone("CET", 3600))`,
+		},
+		{"location", time.UTC, "time.UTC"},
+		{"error", errors.New("msg"), `errors.New("msg")`},
+		{"nil pointer", (*int)(nil), "(*int)(nil)"},
+		{"nil slice", []int(nil), "([]int)(nil)"},
+		{"nil map", map[string]int(nil), "(map[string]int)(nil)"},
+		{"pointer to int", Ptr(42), "dump.Ptr(42)"},
+		{"pointer to int8", Ptr(int8(42)), "dump.Ptr(int8(42))"},
+		{"pointer to pointer", Ptr(Ptr(42)), "dump.Ptr(dump.Ptr(42))"},
+		{"pointer to struct", &types.TInt{V: 1}, "&types.TInt{V: 1}"},
+		{"slice", []int{1, 2}, "[]int{1, 2}"},
+		{"array", [2]int{1, 2}, "[2]int{1, 2}"},
+		{"slice of any", []any{1, 1.0, "a"}, `[]any{1, 1.0, "a"}`},
+		{
+			"slice of structs",
+			[]types.TInt{{V: 1}, {V: 2}},
+			"[]types.TInt{{V: 1}, {V: 2}}",
+		},
+		{
+			"slice of struct pointers",
+			[]*types.TInt{{V: 1}, nil},
+			"[]*types.TInt{{V: 1}, nil}",
+		},
+		{
+			"map",
+			map[string][]int{"b": {2}, "a": {1}},
+			`map[string][]int{"a": {1}, "b": {2}}`,
+		},
+		{
+			"struct",
+			types.TIntStr{Int: 1, Str: "a"},
+			`types.TIntStr{Int: 1, Str: "a"}`,
+		},
+		{
+			"struct with interface field",
+			struct{ Val any }{Val: uint(1)},
+			"struct { Val any }{Val: uint(1)}",
+		},
+		{
+			"struct with zero time",
+			types.TTim{},
+			"types.TTim{Tim: time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)}",
+		},
+		{"func", func() {}, ValFunc},
+		{"chan", make(chan int), ValChan},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			dmp := New(WithFlat)
+
+			// --- When ---
+			have := GoDumper(dmp, 0, reflect.ValueOf(tc.val))
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_GoDumper(t *testing.T) {
+	t.Run("multiline", func(t *testing.T) {
+		// --- Given ---
+		val := types.TNested{
+			SInt:    []int{1, 2},
+			MStrInt: map[string]int{"A": 1},
+		}
+		dmp := New()
+
+		// --- When ---
+		have := GoDumper(dmp, 0, reflect.ValueOf(val))
+
+		// --- Then ---
+		want := "types.TNested{\n" +
+			"  SInt: []int{\n" +
+			"    1,\n" +
+			"    2,\n" +
+			"  },\n" +
+			"  STA: nil,\n" +
+			"  STAp: nil,\n" +
+			"  MStrInt: map[string]int{\n" +
+			"    \"A\": 1,\n" +
+			"  },\n" +
+			"  MStrTyp: nil,\n" +
+			"  MIntTyp: nil,\n" +
+			"}"
+		affirm.Equal(t, want, have)
+		_, err := parser.ParseExpr(have)
+		affirm.Nil(t, err)
+	})
+
+	t.Run("with indent and level", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithIndent(1))
+
+		// --- When ---
+		have := GoDumper(dmp, 1, reflect.ValueOf([]int{1}))
+
+		// --- Then ---
+		affirm.Equal(t, "    []int{\n      1,\n    }", have)
+	})
+
+	t.Run("without private fields", func(t *testing.T) {
+		// --- Given ---
+		val := types.TPrv{Pub: 1}
+		dmp := New(WithFlat, WithNoPrivate)
+
+		// --- When ---
+		have := GoDumper(dmp, 0, reflect.ValueOf(val))
+
+		// --- Then ---
+		affirm.Equal(t, "types.TPrv{Pub: 1}", have)
+	})
+
+	t.Run("unsafe unexported fields", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ err error }
+		val := T{err: errors.New("msg")}
+		dmp := New(WithFlat, WithUnsafeUnexported)
+
+		// --- When ---
+		have := GoDumper(dmp, 0, reflect.ValueOf(val))
+
+		// --- Then ---
+		want := `dump.T{err: errors.New("msg")}`
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("redacted fields", func(t *testing.T) {
+		// --- Given ---
+		val := types.TIntStr{Int: 1, Str: "abc"}
+		dmp := New(WithFlat, WithRedact("Str"))
+
+		// --- When ---
+		have := GoDumper(dmp, 0, reflect.ValueOf(val))
+
+		// --- Then ---
+		affirm.Equal(t, "types.TIntStr{Int: 1, Str: <redacted>}", have)
+	})
+
+	t.Run("custom key sort", func(t *testing.T) {
+		// --- Given ---
+		val := map[string]int{"a": 1, "b": 2}
+		less := func(a, b reflect.Value) bool { return a.String() > b.String() }
+		dmp := New(WithFlat, WithKeySort(less))
+
+		// --- When ---
+		have := GoDumper(dmp, 0, reflect.ValueOf(val))
+
+		// --- Then ---
+		affirm.Equal(t, `map[string]int{"b": 2, "a": 1}`, have)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		// --- Given ---
+		val := &types.TRec{Int: 1}
+		val.Rec = val
+		dmp := New(WithFlat)
+
+		// --- When ---
+		have := GoDumper(dmp, 0, reflect.ValueOf(val))
+
+		// --- Then ---
+		affirm.Equal(t, "&types.TRec{Int: 1, Rec: <cycle to TRec@0>}", have)
+	})
+
+	t.Run("colors are not used", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFlat)
+		dmp.Color = true
+
+		// --- When ---
+		have := GoDumper(dmp, 0, reflect.ValueOf(types.TIntStr{Int: 1}))
+
+		// --- Then ---
+		affirm.Equal(t, `types.TIntStr{Int: 1, Str: ""}`, have)
+	})
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"encoding/hex"
+	"reflect"
+	"strings"
+)
+
+// HexDumpDumper is a dumper for byte slices and arrays. It renders them as a
+// classic hexdump block with offsets, hex values, and ASCII characters, the
+// same as [hex.Dump] does. It expects val to represent a slice or an array of
+// [reflect.Uint8] kind. Returns [ValErrUsage] ("<dump-usage-error>") string if
+// the kind cannot be matched. The number of dumped bytes is limited by the
+// [Dump.MaxItems].
+func HexDumpDumper(dmp Dump, lvl int, val reflect.Value) string {
+	dmp.Flat = false // The hexdump is always multiline.
+	prn := NewPrinter(dmp)
+	prn.Tab(dmp.Indent + lvl)
+
+	if !isBytes(val) {
+		return prn.Write(ValErrUsage).String()
+	}
+	if val.Kind() == reflect.Slice && val.IsNil() {
+		return prn.Write(ValNil).String()
+	}
+
+	if dmp.PrintType {
+		prn.Write(dmp.colorize(colorType, val.Type().String()))
+	}
+
+	num := val.Len()
+	cnt := dmp.maxItems(num)
+	buf := make([]byte, cnt)
+	for i := range buf {
+		buf[i] = byte(val.Index(i).Uint())
+	}
+
+	prn.Write("{").NLI(cnt)
+	lines := strings.TrimSuffix(hex.Dump(buf), "\n")
+	for _, line := range strings.Split(lines, "\n") {
+		if line == "" {
+			continue
+		}
+		prn.Tab(dmp.Indent + lvl + 1).Write(line).NL()
+	}
+	dmp.moreItems(prn, lvl+1, num-cnt)
+	prn.Tab(dmp.Indent + lvl).Write("}")
+
+	return prn.String()
+}
+
+// useHexDump returns true if the value should be dumped with [HexDumpDumper].
+func (dmp Dump) useHexDump(val reflect.Value) bool {
+	return dmp.HexDump > 0 && !dmp.Flat && isBytes(val) &&
+		val.Len() > dmp.HexDump
+}
+
+// isBytes returns true if the value is a slice or an array of bytes.
+func isBytes(val reflect.Value) bool {
+	switch val.Kind() {
+	case reflect.Slice, reflect.Array:
+		return val.Type().Elem().Kind() == reflect.Uint8
+	default:
+		return false
+	}
+}
@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+)
+
+func Test_HexDumpDumper(t *testing.T) {
+	t.Run("byte slice", func(t *testing.T) {
+		// --- Given ---
+		val := []byte("Hello, World! 0123456789")
+		dmp := New()
+
+		// --- When ---
+		have := HexDumpDumper(dmp, 0, reflect.ValueOf(val))
+
+		// --- Then ---
+		want := "[]uint8{\n" +
+			"  00000000  48 65 6c 6c 6f 2c 20 57  6f 72 6c 64 21 20 30 31" +
+			"  |Hello, World! 01|\n" +
+			"  00000010  32 33 34 35 36 37 38 39" +
+			"                           |23456789|\n" +
+			"}"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("byte array", func(t *testing.T) {
+		// --- Given ---
+		val := [3]byte{'a', 'b', 'c'}
+		dmp := New()
+
+		// --- When ---
+		have := HexDumpDumper(dmp, 0, reflect.ValueOf(val))
+
+		// --- Then ---
+		want := "[3]uint8{\n" +
+			"  00000000  61 62 63" +
+			"                                          |abc|\n" +
+			"}"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("flat is ignored", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFlat)
+
+		// --- When ---
+		have := HexDumpDumper(dmp, 0, reflect.ValueOf([]byte("a")))
+
+		// --- Then ---
+		want := "[]uint8{\n" +
+			"  00000000  61" +
+			"                                                |a|\n" +
+			"}"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("with indent and level", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithIndent(1))
+		dmp.PrintType = false
+
+		// --- When ---
+		have := HexDumpDumper(dmp, 1, reflect.ValueOf([]byte("a")))
+
+		// --- Then ---
+		want := "    {\n" +
+			"      00000000  61" +
+			"                                                |a|\n" +
+			"    }"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("with max items", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithMaxItems(1))
+
+		// --- When ---
+		have := HexDumpDumper(dmp, 0, reflect.ValueOf([]byte("abc")))
+
+		// --- Then ---
+		want := "[]uint8{\n" +
+			"  00000000  61" +
+			"                                                |a|\n" +
+			"  ... (+2 more)\n" +
+			"}"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		// --- Given ---
+		dmp := New()
+
+		// --- When ---
+		have := HexDumpDumper(dmp, 0, reflect.ValueOf([]byte{}))
+
+		// --- Then ---
+		affirm.Equal(t, "[]uint8{}", have)
+	})
+
+	t.Run("nil slice", func(t *testing.T) {
+		// --- Given ---
+		dmp := New()
+
+		// --- When ---
+		have := HexDumpDumper(dmp, 0, reflect.ValueOf([]byte(nil)))
+
+		// --- Then ---
+		affirm.Equal(t, ValNil, have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithIndent(1))
+
+		// --- When ---
+		have := HexDumpDumper(dmp, 0, reflect.ValueOf([]int{1}))
+
+		// --- Then ---
+		affirm.Equal(t, "  "+ValErrUsage, have)
+	})
+}
+
+func Test_Dump_Any_HexDump(t *testing.T) {
+	t.Run("above threshold", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithHexDump(2))
+
+		// --- When ---
+		have := dmp.Any(map[string][]byte{"a": []byte("abc")})
+
+		// --- Then ---
+		want := "map[string][]uint8{\n" +
+			"  \"a\": {\n" +
+			"    00000000  61 62 63" +
+			"                                          |abc|\n" +
+			"  },\n" +
+			"}"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("not above threshold", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithHexDump(3))
+
+		// --- When ---
+		have := dmp.Any([]byte("abc"))
+
+		// --- Then ---
+		affirm.Equal(t, "[]uint8{\n  0x61,\n  0x62,\n  0x63,\n}", have)
+	})
+
+	t.Run("not used when flat", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFlat, WithHexDump(1))
+
+		// --- When ---
+		have := dmp.Any([]byte("abc"))
+
+		// --- Then ---
+		affirm.Equal(t, "[]uint8{0x61, 0x62, 0x63}", have)
+	})
+}
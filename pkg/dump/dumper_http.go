@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Types for HTTP dumpers.
+var (
+	typHTTPRequest  = reflect.TypeOf(http.Request{})
+	typHTTPResponse = reflect.TypeOf(http.Response{})
+)
+
+// HTTPRequestDumper requires val to be a value representing [http.Request]
+// and returns its method, URL, protocol, headers, and the body preview. The
+// headers can be limited with [WithHTTPHeaders] and the body preview size is
+// set with [WithHTTPBody]. The body is read using [http.Request.GetBody]
+// when available, otherwise the read part of the body is put back, so it can
+// still be read by the code under test. Returns [ValErrUsage]
+// ("<dump-usage-error>") string if the type cannot be matched.
+//
+// Example:
+//
+//	http.Request{
+//	  Method: "POST",
+//	  URL: "http://example.com/users",
+//	  Proto: "HTTP/1.1",
+//	  Header: {
+//	    "Content-Type": "application/json",
+//	  },
+//	  Body: "{\"name\":\"bob\"}",
+//	}
+func HTTPRequestDumper(dmp Dump, lvl int, val reflect.Value) string {
+	prn := NewPrinter(dmp).Tab(dmp.Indent + lvl)
+	if !val.IsValid() || !val.CanInterface() || val.Type() != typHTTPRequest {
+		return prn.Write(ValErrUsage).String()
+	}
+	req, restore := httpValue[http.Request](val)
+
+	url := ValNil
+	if req.URL != nil {
+		url = strconv.Quote(req.URL.String())
+	}
+	body := dmp.httpBody(&req.Body, req.GetBody, restore)
+
+	prn.Write(dmp.colorize(colorType, "http.Request")).Write("{").NL()
+	dmp.httpField(prn, lvl, "Method", strconv.Quote(req.Method))
+	dmp.httpField(prn, lvl, "URL", url)
+	dmp.httpField(prn, lvl, "Proto", strconv.Quote(req.Proto))
+	dmp.httpHeader(prn, lvl, req.Header)
+	dmp.httpField(prn, lvl, "Body", body)
+	return prn.Tab(dmp.Indent + lvl).Write("}").String()
+}
+
+// HTTPResponseDumper requires val to be a value representing [http.Response]
+// and returns its status, protocol, headers, and the body preview. The
+// headers can be limited with [WithHTTPHeaders] and the body preview size is
+// set with [WithHTTPBody]. The read part of the body is put back, so it can
+// still be read by the code under test. Returns [ValErrUsage]
+// ("<dump-usage-error>") string if the type cannot be matched.
+//
+// Example:
+//
+//	http.Response{
+//	  Status: "200 OK",
+//	  Proto: "HTTP/1.1",
+//	  Header: {
+//	    "Content-Type": "text/plain",
+//	  },
+//	  Body: "hello",
+//	}
+func HTTPResponseDumper(dmp Dump, lvl int, val reflect.Value) string {
+	prn := NewPrinter(dmp).Tab(dmp.Indent + lvl)
+	if !val.IsValid() || !val.CanInterface() || val.Type() != typHTTPResponse {
+		return prn.Write(ValErrUsage).String()
+	}
+	res, restore := httpValue[http.Response](val)
+	body := dmp.httpBody(&res.Body, nil, restore)
+
+	prn.Write(dmp.colorize(colorType, "http.Response")).Write("{").NL()
+	dmp.httpField(prn, lvl, "Status", strconv.Quote(res.Status))
+	dmp.httpField(prn, lvl, "Proto", strconv.Quote(res.Proto))
+	dmp.httpHeader(prn, lvl, res.Header)
+	dmp.httpField(prn, lvl, "Body", body)
+	return prn.Tab(dmp.Indent + lvl).Write("}").String()
+}
+
+// httpValue returns a pointer to the value represented by val. When val is
+// addressable, the pointer to it is returned with true, otherwise the pointer
+// to its copy is returned with false.
+func httpValue[T any](val reflect.Value) (*T, bool) {
+	if val.CanAddr() {
+		return val.Addr().Interface().(*T), true // nolint: forcetypeassert
+	}
+	cpy := val.Interface().(T) // nolint: forcetypeassert
+	return &cpy, false
+}
+
+// httpField writes the HTTP request or response field to the printer. The
+// "Body" field is always the last one.
+func (dmp Dump) httpField(prn Printer, lvl int, name, str string) {
+	last := name == "Body"
+	prn.Tab(dmp.Indent + lvl + 1).Write(name).Write(":").Space().Write(str)
+	prn.Comma(last).Sep(last).NL()
+}
+
+// httpHeader writes HTTP headers selected with [Dump.HTTPHeaders] to the
+// printer. Values of the header with multiple values are joined with commas.
+func (dmp Dump) httpHeader(prn Printer, lvl int, hdr http.Header) {
+	selected := make([]string, 0, len(dmp.HTTPHeaders))
+	for _, name := range dmp.HTTPHeaders {
+		selected = append(selected, http.CanonicalHeaderKey(name))
+	}
+
+	names := make([]string, 0, len(hdr))
+	for name := range hdr {
+		if len(selected) == 0 || slices.Contains(selected, name) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	num := len(names)
+	prn.Tab(dmp.Indent + lvl + 1).Write("Header:").Space().Write("{").NLI(num)
+	for i, name := range names {
+		last := i == num-1
+		prn.Tab(dmp.Indent + lvl + 2).Write(strconv.Quote(name))
+		prn.Write(":").Space()
+		prn.Write(strconv.Quote(strings.Join(hdr[name], ", ")))
+		prn.Comma(last).Sep(last).NL()
+	}
+	if num > 0 {
+		prn.Tab(dmp.Indent + lvl + 1)
+	}
+	prn.Write("}").Comma(false).Sep(false).NL()
+}
+
+// httpBody returns the preview of the HTTP body limited to [Dump.HTTPBody]
+// bytes. Truncated previews end with "...". The body is read using "getBody"
+// when it is not nil. Otherwise, when "restore" is true, the body is read and
+// the read part is put back. Returns [ValNotNil] when the body cannot be read
+// without consuming it or the preview is turned off.
+func (dmp Dump) httpBody(
+	body *io.ReadCloser,
+	getBody func() (io.ReadCloser, error),
+	restore bool,
+) string {
+
+	if *body == nil || *body == http.NoBody {
+		return ValNil
+	}
+	if dmp.HTTPBody < 1 {
+		return ValNotNil
+	}
+
+	limit := int64(dmp.HTTPBody)
+	var buf []byte
+	switch {
+	case getBody != nil:
+		rc, err := getBody()
+		if err != nil {
+			return ValNotNil
+		}
+		buf, _ = io.ReadAll(io.LimitReader(rc, limit+1))
+		_ = rc.Close()
+
+	case restore:
+		orig := *body
+		buf, _ = io.ReadAll(io.LimitReader(orig, limit+1))
+		*body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(buf), orig), orig}
+
+	default:
+		return ValNotNil
+	}
+
+	if int64(len(buf)) > limit {
+		return strconv.Quote(string(buf[:limit])) + "..."
+	}
+	return strconv.Quote(string(buf))
+}
@@ -0,0 +1,212 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+)
+
+func Test_HTTPRequestDumper(t *testing.T) {
+	t.Run("request", func(t *testing.T) {
+		// --- Given ---
+		body := strings.NewReader(`{"name":"bob"}`)
+		req, _ := http.NewRequest(http.MethodPost, "http://example.com/u", body)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Add("Accept", "text/plain")
+		req.Header.Add("Accept", "text/html")
+		dmp := New()
+
+		// --- When ---
+		have := HTTPRequestDumper(dmp, 0, reflect.ValueOf(req).Elem())
+
+		// --- Then ---
+		want := "http.Request{\n" +
+			"  Method: \"POST\",\n" +
+			"  URL: \"http://example.com/u\",\n" +
+			"  Proto: \"HTTP/1.1\",\n" +
+			"  Header: {\n" +
+			"    \"Accept\": \"text/plain, text/html\",\n" +
+			"    \"Content-Type\": \"application/json\",\n" +
+			"  },\n" +
+			"  Body: \"{\\\"name\\\":\\\"bob\\\"}\",\n" +
+			"}"
+		affirm.Equal(t, want, have)
+		data, err := io.ReadAll(req.Body)
+		affirm.Nil(t, err)
+		affirm.Equal(t, `{"name":"bob"}`, string(data))
+	})
+
+	t.Run("flat", func(t *testing.T) {
+		// --- Given ---
+		req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+		dmp := New(WithFlat)
+
+		// --- When ---
+		have := HTTPRequestDumper(dmp, 0, reflect.ValueOf(req).Elem())
+
+		// --- Then ---
+		want := "http.Request{Method: \"GET\", URL: \"http://example.com\", " +
+			"Proto: \"HTTP/1.1\", Header: {}, Body: nil}"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("selected headers", func(t *testing.T) {
+		// --- Given ---
+		req := &http.Request{
+			Method: http.MethodGet,
+			URL:    &url.URL{Path: "/"},
+			Header: http.Header{"A": {"1"}, "B": {"2"}, "C": {"3"}},
+		}
+		dmp := New(WithFlat, WithHTTPHeaders("c", "A"))
+
+		// --- When ---
+		have := HTTPRequestDumper(dmp, 0, reflect.ValueOf(req).Elem())
+
+		// --- Then ---
+		want := "http.Request{Method: \"GET\", URL: \"/\", Proto: \"\", " +
+			"Header: {\"A\": \"1\", \"C\": \"3\"}, Body: nil}"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("body without GetBody is put back", func(t *testing.T) {
+		// --- Given ---
+		req := &http.Request{
+			Method: http.MethodPost,
+			Body:   io.NopCloser(strings.NewReader("abcdef")),
+		}
+		dmp := New(WithFlat, WithHTTPBody(3))
+
+		// --- When ---
+		have := HTTPRequestDumper(dmp, 0, reflect.ValueOf(req).Elem())
+
+		// --- Then ---
+		want := "http.Request{Method: \"POST\", URL: nil, Proto: \"\", " +
+			"Header: {}, Body: \"abc\"...}"
+		affirm.Equal(t, want, have)
+		data, err := io.ReadAll(req.Body)
+		affirm.Nil(t, err)
+		affirm.Equal(t, "abcdef", string(data))
+	})
+
+	t.Run("not addressable value without GetBody", func(t *testing.T) {
+		// --- Given ---
+		req := http.Request{Body: io.NopCloser(strings.NewReader("abc"))}
+		dmp := New(WithFlat)
+
+		// --- When ---
+		have := HTTPRequestDumper(dmp, 0, reflect.ValueOf(req))
+
+		// --- Then ---
+		want := "http.Request{Method: \"\", URL: nil, Proto: \"\", " +
+			"Header: {}, Body: <not-nil>}"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("body preview turned off", func(t *testing.T) {
+		// --- Given ---
+		req, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader("a"))
+		dmp := New(WithFlat, WithHTTPBody(0))
+
+		// --- When ---
+		have := HTTPRequestDumper(dmp, 0, reflect.ValueOf(req).Elem())
+
+		// --- Then ---
+		want := "http.Request{Method: \"POST\", URL: \"/\", " +
+			"Proto: \"HTTP/1.1\", Header: {}, Body: <not-nil>}"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("uses indent and level", func(t *testing.T) {
+		// --- Given ---
+		req := &http.Request{Method: http.MethodGet}
+		dmp := New(WithIndent(1))
+
+		// --- When ---
+		have := HTTPRequestDumper(dmp, 1, reflect.ValueOf(req).Elem())
+
+		// --- Then ---
+		want := "    http.Request{\n" +
+			"      Method: \"GET\",\n" +
+			"      URL: nil,\n" +
+			"      Proto: \"\",\n" +
+			"      Header: {},\n" +
+			"      Body: nil,\n" +
+			"    }"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithIndent(1))
+
+		// --- When ---
+		have := HTTPRequestDumper(dmp, 2, reflect.ValueOf(123))
+
+		// --- Then ---
+		affirm.Equal(t, "      "+ValErrUsage, have)
+	})
+}
+
+func Test_HTTPResponseDumper(t *testing.T) {
+	t.Run("response", func(t *testing.T) {
+		// --- Given ---
+		res := &http.Response{
+			Status: "200 OK",
+			Proto:  "HTTP/1.1",
+			Header: http.Header{"Content-Type": {"text/plain"}},
+			Body:   io.NopCloser(strings.NewReader("hello")),
+		}
+		dmp := New()
+
+		// --- When ---
+		have := HTTPResponseDumper(dmp, 0, reflect.ValueOf(res).Elem())
+
+		// --- Then ---
+		want := "http.Response{\n" +
+			"  Status: \"200 OK\",\n" +
+			"  Proto: \"HTTP/1.1\",\n" +
+			"  Header: {\n" +
+			"    \"Content-Type\": \"text/plain\",\n" +
+			"  },\n" +
+			"  Body: \"hello\",\n" +
+			"}"
+		affirm.Equal(t, want, have)
+		data, err := io.ReadAll(res.Body)
+		affirm.Nil(t, err)
+		affirm.Equal(t, "hello", string(data))
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithIndent(1))
+
+		// --- When ---
+		have := HTTPResponseDumper(dmp, 2, reflect.ValueOf(123))
+
+		// --- Then ---
+		affirm.Equal(t, "      "+ValErrUsage, have)
+	})
+}
+
+func Test_Dump_Any_http(t *testing.T) {
+	// --- Given ---
+	type T struct{ Res *http.Response }
+	val := T{Res: &http.Response{Status: "404 Not Found", Body: http.NoBody}}
+	dmp := New(WithFlat)
+
+	// --- When ---
+	have := dmp.Any(val)
+
+	// --- Then ---
+	want := "{Res: http.Response{Status: \"404 Not Found\", Proto: \"\", " +
+		"Header: {}, Body: nil}}"
+	affirm.Equal(t, want, have)
+}
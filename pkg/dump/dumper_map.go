@@ -6,7 +6,6 @@ package dump
 import (
 	"fmt"
 	"reflect"
-	"slices"
 	"strings"
 )
 
@@ -18,10 +17,18 @@ import (
 // nolint: cyclop
 func MapDumper(dmp Dump, lvl int, val reflect.Value) string {
 	prn := NewPrinter(dmp)
+	dmp.writeMap(prn, lvl, val)
+	return prn.String()
+}
+
+// writeMap writes the map representation to the printer, one key-value pair
+// at a time. See [MapDumper].
+func (dmp Dump) writeMap(prn Printer, lvl int, val reflect.Value) {
 	prn.Tab(dmp.Indent + lvl)
 
 	if val.Kind() != reflect.Map {
-		return prn.Write(ValErrUsage).String()
+		prn.Write(ValErrUsage)
+		return
 	}
 
 	if dmp.PrintType {
@@ -32,21 +39,23 @@ func MapDumper(dmp Dump, lvl int, val reflect.Value) string {
 			valTypStr = "any"
 		}
 		str := fmt.Sprintf("map[%s]%s", keyTyp.String(), valTypStr)
-		prn.Write(str)
+		prn.Write(dmp.colorize(colorType, str))
 	}
 
 	keys := val.MapKeys()
-	slices.SortStableFunc(keys, valueCmp)
+	dmp.sortKeys(keys)
 
 	if val.IsNil() {
-		return prn.Write("(nil)").String()
+		prn.Write("(nil)")
+		return
 	}
 
 	num := val.Len()
+	cnt := dmp.maxItems(num)
 	prn.Write("{").NLI(num)
 
 	dmp.PrintType = false // Don't print types for map values.
-	for i, key := range keys {
+	for i, key := range keys[:cnt] {
 		last := i == num-1
 
 		sub, _ := dmp.value(lvl+1, key)
@@ -60,7 +69,6 @@ func MapDumper(dmp Dump, lvl int, val reflect.Value) string {
 		prn.Write(sub)
 		prn.Comma(last).Sep(last).NL()
 	}
+	dmp.moreItems(prn, lvl+1, num-cnt)
 	prn.Tab(dmp.Indent + lvl).Write("}")
-
-	return prn.String()
 }
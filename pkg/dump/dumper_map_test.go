@@ -23,6 +23,30 @@ func Test_MapDumper(t *testing.T) {
 		// --- Then ---
 		affirm.Equal(t, "      "+ValErrUsage, have)
 	})
+
+	t.Run("cyclic pointer keys", func(t *testing.T) {
+		// --- Given ---
+		type node struct {
+			Val  int
+			Next *node
+		}
+		a, b := &node{Val: 1}, &node{Val: 2}
+		a.Next, b.Next = b, a
+		val := map[*node]int{b: 2, a: 1}
+
+		dmp := New(WithFlat, WithCompact)
+
+		// --- When ---
+		have := MapDumper(dmp, 0, reflect.ValueOf(val))
+
+		// --- Then ---
+		want := "" +
+			"map[*dump.node]int{" +
+			"{Val:1,Next:{Val:2,Next:<cycle to node@1>}}:1," +
+			"{Val:2,Next:{Val:1,Next:<cycle to node@1>}}:2" +
+			"}"
+		affirm.Equal(t, want, have)
+	})
 }
 
 func Test_MapDumper_tabular(t *testing.T) {
@@ -53,6 +77,45 @@ func Test_MapDumper_tabular(t *testing.T) {
 			map[int]int{1: 10, 2: 20},
 			"map[int]int{\n  1: 10,\n  2: 20,\n}",
 		},
+		{
+			"int keys in numeric order",
+			New(WithFlat),
+			map[int]int{10: 1, 2: 2, -1: 3},
+			"map[int]int{-1: 3, 2: 2, 10: 1}",
+		},
+		{
+			"struct keys in field order",
+			New(WithFlat),
+			map[types.TIntStr]int{
+				{Int: 2}:           1,
+				{Int: 1, Str: "b"}: 2,
+				{Int: 1}:           3,
+			},
+			`map[types.TIntStr]int{` +
+				`{Int: 1, Str: ""}: 3, ` +
+				`{Int: 1, Str: "b"}: 2, ` +
+				`{Int: 2, Str: ""}: 1}`,
+		},
+		{
+			"custom key sort",
+			New(WithFlat, WithKeySort(func(a, b reflect.Value) bool {
+				return a.Int() > b.Int()
+			})),
+			map[int]int{1: 10, 3: 30, 2: 20},
+			"map[int]int{3: 30, 2: 20, 1: 10}",
+		},
+		{
+			"max items",
+			New(WithMaxItems(1)),
+			map[int]int{1: 10, 2: 20, 3: 30},
+			"map[int]int{\n  1: 10,\n  ... (+2 more)\n}",
+		},
+		{
+			"flat max items",
+			New(WithFlat, WithMaxItems(2)),
+			map[int]int{1: 10, 2: 20, 3: 30},
+			"map[int]int{1: 10, 2: 20, ... (+1 more)}",
+		},
 		{
 			"default map[int]int ith indent",
 			New(WithIndent(2)),
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"reflect"
+)
+
+// Types for reflection dumpers.
+var (
+	typReflectType  = reflect.TypeOf((*reflect.Type)(nil)).Elem()
+	typReflectRType = reflect.TypeOf(reflect.TypeOf(0))
+	typReflectValue = reflect.TypeOf(reflect.Value{})
+)
+
+// ReflectTypeDumper requires val to be a value representing [reflect.Type]
+// and returns its representation with the type name, for example,
+// "reflect.Type(mypkg.Foo)". Returns [ValErrUsage] ("<dump-usage-error>")
+// string if the type cannot be matched.
+func ReflectTypeDumper(dmp Dump, lvl int, val reflect.Value) string {
+	prn := NewPrinter(dmp).Tab(dmp.Indent + lvl)
+	if !val.IsValid() || !val.CanInterface() {
+		return prn.Write(ValErrUsage).String()
+	}
+	if val.Kind() == reflect.Interface && val.IsNil() {
+		return prn.Write(ValNil).String()
+	}
+	typ, ok := val.Interface().(reflect.Type)
+	if !ok {
+		return prn.Write(ValErrUsage).String()
+	}
+	str := "reflect.Type(" + dmp.colorize(colorType, typ.String()) + ")"
+	return prn.Write(str).String()
+}
+
+// ReflectValueDumper requires val to be a value representing [reflect.Value]
+// and returns the representation of the value it holds. Returns [ValErrUsage]
+// ("<dump-usage-error>") string if the type cannot be matched.
+func ReflectValueDumper(dmp Dump, lvl int, val reflect.Value) string {
+	if val.IsValid() && val.CanInterface() {
+		if inner, ok := val.Interface().(reflect.Value); ok {
+			str, _ := dmp.value(lvl, inner)
+			return str
+		}
+	}
+	prn := NewPrinter(dmp).Tab(dmp.Indent + lvl)
+	return prn.Write(ValErrUsage).String()
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/internal/types"
+)
+
+func Test_ReflectTypeDumper(t *testing.T) {
+	t.Run("type", func(t *testing.T) {
+		// --- Given ---
+		dmp := New()
+		val := reflect.ValueOf(reflect.TypeOf(types.TIntStr{}))
+
+		// --- When ---
+		have := ReflectTypeDumper(dmp, 0, val)
+
+		// --- Then ---
+		affirm.Equal(t, "reflect.Type(types.TIntStr)", have)
+	})
+
+	t.Run("struct field", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ Typ reflect.Type }
+		dmp := New()
+		val := reflect.ValueOf(T{Typ: reflect.TypeOf(42)}).Field(0)
+
+		// --- When ---
+		have := ReflectTypeDumper(dmp, 0, val)
+
+		// --- Then ---
+		affirm.Equal(t, "reflect.Type(int)", have)
+	})
+
+	t.Run("nil struct field", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ Typ reflect.Type }
+		dmp := New()
+		val := reflect.ValueOf(T{}).Field(0)
+
+		// --- When ---
+		have := ReflectTypeDumper(dmp, 0, val)
+
+		// --- Then ---
+		affirm.Equal(t, ValNil, have)
+	})
+
+	t.Run("uses indent and level", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithIndent(1))
+		val := reflect.ValueOf(reflect.TypeOf(42))
+
+		// --- When ---
+		have := ReflectTypeDumper(dmp, 1, val)
+
+		// --- Then ---
+		affirm.Equal(t, "    reflect.Type(int)", have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithIndent(1))
+
+		// --- When ---
+		have := ReflectTypeDumper(dmp, 2, reflect.ValueOf(123))
+
+		// --- Then ---
+		affirm.Equal(t, "      "+ValErrUsage, have)
+	})
+}
+
+func Test_ReflectValueDumper(t *testing.T) {
+	t.Run("value", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFlat)
+		val := reflect.ValueOf(reflect.ValueOf(types.TIntStr{Int: 1}))
+
+		// --- When ---
+		have := ReflectValueDumper(dmp, 0, val)
+
+		// --- Then ---
+		affirm.Equal(t, `{Int: 1, Str: ""}`, have)
+	})
+
+	t.Run("zero value", func(t *testing.T) {
+		// --- Given ---
+		dmp := New()
+		val := reflect.ValueOf(reflect.Value{})
+
+		// --- When ---
+		have := ReflectValueDumper(dmp, 0, val)
+
+		// --- Then ---
+		affirm.Equal(t, ValNil, have)
+	})
+
+	t.Run("uses indent and level", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithIndent(1))
+		val := reflect.ValueOf(reflect.ValueOf([]int{1}))
+
+		// --- When ---
+		have := ReflectValueDumper(dmp, 1, val)
+
+		// --- Then ---
+		affirm.Equal(t, "    []int{\n      1,\n    }", have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithIndent(1))
+
+		// --- When ---
+		have := ReflectValueDumper(dmp, 2, reflect.ValueOf(123))
+
+		// --- Then ---
+		affirm.Equal(t, "      "+ValErrUsage, have)
+	})
+}
+
+func Test_Dump_Any_reflection_fields(t *testing.T) {
+	// --- Given ---
+	type T struct {
+		Typ reflect.Type
+		Val reflect.Value
+	}
+	val := T{Typ: reflect.TypeOf(types.TIntStr{}), Val: reflect.ValueOf(42)}
+	dmp := New(WithFlat)
+
+	// --- When ---
+	have := dmp.Any(val)
+
+	// --- Then ---
+	affirm.Equal(t, "{Typ: reflect.Type(types.TIntStr), Val: 42}", have)
+}
@@ -8,6 +8,8 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 // SimpleDumper is a generic dumper for simple types. It expects val to
@@ -29,22 +31,30 @@ import (
 //   - [reflect.String]
 //
 // It returns string representation in the format defined by [Dump]
-// configuration.
+// configuration. Strings longer than [Dump.MaxStringLen] runes are truncated
+// and followed by the [ValMoreChars] marker. Non-printable characters in
+// multiline strings are escaped unless [Dump.RawStrings] is set.
 //
 // nolint: cyclop
 func SimpleDumper(dmp Dump, lvl int, val reflect.Value) string {
 	var v any
 
 	var format string
+	var color string
+	var suffix string
 	switch val.Kind() {
 	case reflect.Bool:
 		v = val.Bool()
 		format = "%v"
 
 	case reflect.String:
-		str := val.String()
+		color = colorString
+		str, more := dmp.truncString(val.String())
+		if more > 0 {
+			suffix = fmt.Sprintf(ValMoreChars, more)
+		}
 		v = str
-		length := val.Len()
+		length := len(str)
 		switch {
 		case dmp.flatStrings:
 			format = `%q`
@@ -54,25 +64,31 @@ func SimpleDumper(dmp Dump, lvl int, val reflect.Value) string {
 			format = `%q`
 		case strings.Contains(str, "\n"):
 			format = "%v"
+			if !dmp.RawStrings {
+				v = escapeString(str)
+			}
 		default:
 			format = "%q"
 		}
 
 	case reflect.Float32:
+		color = colorNumber
 		format = "%s"
-		f := float64(float32(val.Float()))
-		v = strconv.FormatFloat(f, 'f', -1, 32)
+		v = dmp.formatFloat(float64(float32(val.Float())), 32)
 
 	case reflect.Float64:
+		color = colorNumber
 		format = "%s"
-		v = strconv.FormatFloat(val.Float(), 'f', -1, 64)
+		v = dmp.formatFloat(val.Float(), 64)
 
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		color = colorNumber
 		v = val.Int()
 		format = "%d"
 
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
 		reflect.Uint64:
+		color = colorNumber
 		v = val.Uint()
 		format = "%d"
 
@@ -83,5 +99,56 @@ func SimpleDumper(dmp Dump, lvl int, val reflect.Value) string {
 
 	prn := NewPrinter(dmp)
 	str := fmt.Sprintf(format, v)
-	return prn.Tab(dmp.Indent + lvl).Write(str).String()
+	if color != "" {
+		str = dmp.colorize(color, str)
+	}
+	return prn.Tab(dmp.Indent + lvl).Write(str).Write(suffix).String()
+}
+
+// escapeString escapes non-printable characters and invalid UTF-8 bytes in
+// the string the same way as [strconv.Quote] does. New lines and tabs are not
+// escaped.
+func escapeString(str string) string {
+	var buf strings.Builder
+	for i := 0; i < len(str); {
+		r, size := utf8.DecodeRuneInString(str[i:])
+		switch {
+		case r == utf8.RuneError && size == 1:
+			_, _ = fmt.Fprintf(&buf, `\x%02x`, str[i])
+		case r == '\n' || r == '\t' || unicode.IsPrint(r):
+			buf.WriteString(str[i : i+size])
+		default:
+			quoted := strconv.Quote(str[i : i+size])
+			buf.WriteString(quoted[1 : len(quoted)-1])
+		}
+		i += size
+	}
+	return buf.String()
+}
+
+// truncString truncates the string to [Dump.MaxStringLen] runes. Returns the
+// truncated string and the number of removed runes.
+func (dmp Dump) truncString(str string) (string, int) {
+	if dmp.MaxStringLen < 1 {
+		return str, 0
+	}
+	cnt := 0
+	for i := range str {
+		if cnt == dmp.MaxStringLen {
+			return str[:i], utf8.RuneCountInString(str[i:])
+		}
+		cnt++
+	}
+	return str, 0
+}
+
+// formatFloat formats the floating point number with the given bit size using
+// [Dump.FloatFormat] and [Dump.FloatPrec]. When the format is not set, the
+// 'f' format with the smallest precision necessary to represent the value
+// exactly is used.
+func (dmp Dump) formatFloat(f float64, bits int) string {
+	if dmp.FloatFormat == 0 {
+		return strconv.FormatFloat(f, 'f', -1, bits)
+	}
+	return strconv.FormatFloat(f, dmp.FloatFormat, dmp.FloatPrec, bits)
 }
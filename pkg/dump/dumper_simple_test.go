@@ -56,6 +56,61 @@ func Test_SampleDumper_tabular(t *testing.T) {
 }
 
 func Test_SampleDumper(t *testing.T) {
+	t.Run("colors", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithIndent(1))
+		dmp.Color = true
+
+		// --- When ---
+		hInt := SimpleDumper(dmp, 0, reflect.ValueOf(123))
+		hUint := SimpleDumper(dmp, 0, reflect.ValueOf(uint(123)))
+		hFloat := SimpleDumper(dmp, 0, reflect.ValueOf(1.5))
+		hStr := SimpleDumper(dmp, 0, reflect.ValueOf("abc"))
+		hBool := SimpleDumper(dmp, 0, reflect.ValueOf(true))
+
+		// --- Then ---
+		affirm.Equal(t, "  \x1b[33m123\x1b[0m", hInt)
+		affirm.Equal(t, "  \x1b[33m123\x1b[0m", hUint)
+		affirm.Equal(t, "  \x1b[33m1.5\x1b[0m", hFloat)
+		affirm.Equal(t, "  \x1b[32m\"abc\"\x1b[0m", hStr)
+		affirm.Equal(t, "  true", hBool)
+	})
+
+	t.Run("float format", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFloatFormat('e', 3))
+
+		// --- When ---
+		h64 := SimpleDumper(dmp, 0, reflect.ValueOf(1234.5678))
+		h32 := SimpleDumper(dmp, 0, reflect.ValueOf(float32(0.000012345)))
+
+		// --- Then ---
+		affirm.Equal(t, "1.235e+03", h64)
+		affirm.Equal(t, "1.234e-05", h32)
+	})
+
+	t.Run("float format with shortest precision", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFloatFormat('g', -1))
+
+		// --- When ---
+		have := SimpleDumper(dmp, 0, reflect.ValueOf(1e21))
+
+		// --- Then ---
+		affirm.Equal(t, "1e+21", have)
+	})
+
+	t.Run("float format not set", func(t *testing.T) {
+		// --- Given ---
+		dmp := Dump{}
+
+		// --- When ---
+		have := SimpleDumper(dmp, 0, reflect.ValueOf(1e21))
+
+		// --- Then ---
+		affirm.Equal(t, "1000000000000000000000", have)
+	})
+
 	t.Run("string with Flat false and FlatStrings off", func(t *testing.T) {
 		// --- Given ---
 		dmp := New(WithFlatStrings(0))
@@ -130,6 +185,87 @@ func Test_SampleDumper(t *testing.T) {
 		affirm.Equal(t, "\"str0\"", have)
 	})
 
+	t.Run("string longer than MaxStringLen", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithMaxStringLen(3))
+
+		// --- When ---
+		have := SimpleDumper(dmp, 0, reflect.ValueOf("abcdef"))
+
+		// --- Then ---
+		affirm.Equal(t, `"abc"…(+3 chars)`, have)
+	})
+
+	t.Run("string not longer than MaxStringLen", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithMaxStringLen(3))
+
+		// --- When ---
+		have := SimpleDumper(dmp, 0, reflect.ValueOf("abc"))
+
+		// --- Then ---
+		affirm.Equal(t, `"abc"`, have)
+	})
+
+	t.Run("multiline string longer than MaxStringLen", func(t *testing.T) {
+		// --- Given ---
+		str := strings.Repeat("a", 100) + "\n" + strings.Repeat("a", 200)
+		dmp := New(WithMaxStringLen(202))
+
+		// --- When ---
+		have := SimpleDumper(dmp, 0, reflect.ValueOf(str))
+
+		// --- Then ---
+		want := strings.Repeat("a", 100) + "\n" + strings.Repeat("a", 101) +
+			"…(+99 chars)"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("truncated string with colors", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithMaxStringLen(1))
+		dmp.Color = true
+
+		// --- When ---
+		have := SimpleDumper(dmp, 0, reflect.ValueOf("ab"))
+
+		// --- Then ---
+		affirm.Equal(t, "\x1b[32m\"a\"\x1b[0m…(+1 chars)", have)
+	})
+
+	t.Run("multiline string with non-printable characters", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFlatStrings(0))
+
+		// --- When ---
+		have := SimpleDumper(dmp, 0, reflect.ValueOf("a\x00\n\u202eb\xff"))
+
+		// --- Then ---
+		affirm.Equal(t, `a\x00`+"\n"+`\u202eb\xff`, have)
+	})
+
+	t.Run("multiline string with RawStrings", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFlatStrings(0), WithRawStrings)
+
+		// --- When ---
+		have := SimpleDumper(dmp, 0, reflect.ValueOf("a\x00\nb"))
+
+		// --- Then ---
+		affirm.Equal(t, "a\x00\nb", have)
+	})
+
+	t.Run("quoted string with non-printable characters", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithRawStrings)
+
+		// --- When ---
+		have := SimpleDumper(dmp, 0, reflect.ValueOf("a\x00\u202e"))
+
+		// --- Then ---
+		affirm.Equal(t, `"a\x00\u202e"`, have)
+	})
+
 	t.Run("unsupported kind", func(t *testing.T) {
 		// --- Given ---
 		dmp := New(WithIndent(1))
@@ -141,3 +277,67 @@ func Test_SampleDumper(t *testing.T) {
 		affirm.Equal(t, "      "+ValErrUsage, have)
 	})
 }
+
+func Test_escapeString(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		str  string
+		want string
+	}{
+		{"printable", "abc żółw", "abc żółw"},
+		{"new line and tab", "a\n\tb", "a\n\tb"},
+		{"control character", "a\x00b\r", `a\x00b\r`},
+		{"bidi override", "a\u202eb", `a\u202eb`},
+		{"invalid UTF-8", "a\xffb", `a\xffb`},
+		{"backslash", `a\b`, `a\b`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := escapeString(tc.str)
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_Dump_truncString(t *testing.T) {
+	t.Run("no limit", func(t *testing.T) {
+		// --- Given ---
+		dmp := Dump{}
+
+		// --- When ---
+		have, more := dmp.truncString("abc")
+
+		// --- Then ---
+		affirm.Equal(t, "abc", have)
+		affirm.Equal(t, 0, more)
+	})
+
+	t.Run("shorter than limit", func(t *testing.T) {
+		// --- Given ---
+		dmp := Dump{MaxStringLen: 4}
+
+		// --- When ---
+		have, more := dmp.truncString("abc")
+
+		// --- Then ---
+		affirm.Equal(t, "abc", have)
+		affirm.Equal(t, 0, more)
+	})
+
+	t.Run("limit counts runes", func(t *testing.T) {
+		// --- Given ---
+		dmp := Dump{MaxStringLen: 2}
+
+		// --- When ---
+		have, more := dmp.truncString("żółw")
+
+		// --- Then ---
+		affirm.Equal(t, "żó", have)
+		affirm.Equal(t, 2, more)
+	})
+}
@@ -6,6 +6,7 @@ package dump
 import (
 	"reflect"
 	"strings"
+	"unsafe"
 )
 
 // StructDumper is a generic dumper for maps. It expects val to represent the
@@ -13,49 +14,95 @@ import (
 // if the kind cannot be matched. It returns string representation in the
 // format defined by [Dump] configuration.
 func StructDumper(dmp Dump, lvl int, val reflect.Value) string {
-	dmp.flatStrings = true
-
 	prn := NewPrinter(dmp)
+	dmp.writeStruct(prn, lvl, val)
+	return prn.String()
+}
+
+// writeStruct writes the struct representation to the printer, one field at
+// a time. See [StructDumper].
+func (dmp Dump) writeStruct(prn Printer, lvl int, val reflect.Value) {
+	dmp.flatStrings = true
 	prn.Tab(dmp.Indent + lvl)
 
 	if val.Kind() != reflect.Struct {
-		return prn.Write(ValErrUsage).String()
+		prn.Write(ValErrUsage)
+		return
 	}
 
+	if dmp.UnsafeUnexported {
+		val = addressable(val)
+	}
 	vTyp := val.Type()
 
 	num := val.NumField() // Total number of fields.
-	lastPrivate := false
+	lastIdx := num - 1    // Index of the last dumped field.
+	for !dmp.PrintPrivate && lastIdx >= 0 && !vTyp.Field(lastIdx).IsExported() {
+		lastIdx--
+	}
 	prn.Write("{").NLI(num)
 
-	for i := 0; i < num; i++ {
-		last := i == num-1
+	for i := 0; i <= lastIdx; i++ {
+		last := i == lastIdx
 
 		fld := vTyp.Field(i)
 
 		if !fld.IsExported() && !dmp.PrintPrivate {
-			lastPrivate = last
 			continue
 		}
 
 		// Field name.
 		prn.Tab(dmp.Indent + lvl + 1)
-		prn.Write(fld.Name)
+		prn.Write(dmp.colorize(colorField, fld.Name))
+		if tags := dmp.fieldTags(fld); tags != "" {
+			prn.Space().Write(tags)
+		}
 		prn.Write(":").Space()
 
 		// Field value.
-		dmp.PrintType = true
-		sub, _ := dmp.value(lvl+1, val.Field(i))
-		sub = strings.TrimLeft(sub, " \t")
+		sub := ValRedacted
+		if !dmp.IsRedacted(fld) {
+			dmp.PrintType = true
+			sub, _ = dmp.value(lvl+1, dmp.field(val, i))
+			sub = strings.TrimLeft(sub, " \t")
+		}
 
 		prn.Write(sub)
 		prn.Comma(last).Sep(last).NL()
 	}
 
-	if lastPrivate && dmp.Flat {
-		return strings.TrimRight(prn.String(), ",") + "}"
-	}
 	prn.Tab(dmp.Indent + lvl).Write("}")
+}
 
-	return prn.String()
+// field returns the value of the struct field with the given index. When
+// [Dump.UnsafeUnexported] is set and the struct is addressable, the value of
+// not exported field is read using the unsafe package, so it can be used with
+// [reflect.Value.Interface].
+func (dmp Dump) field(val reflect.Value, i int) reflect.Value {
+	if !dmp.UnsafeUnexported {
+		return val.Field(i)
+	}
+	return accessible(val.Field(i))
+}
+
+// accessible returns the value which can be used with
+// [reflect.Value.Interface]. When the value was obtained from not exported
+// field and is addressable, it is read using the unsafe package. Otherwise,
+// the value is returned unchanged.
+func accessible(val reflect.Value) reflect.Value {
+	if !val.IsValid() || val.CanInterface() || !val.CanAddr() {
+		return val
+	}
+	ptr := unsafe.Pointer(val.UnsafeAddr()) // nolint: gosec
+	return reflect.NewAt(val.Type(), ptr).Elem()
+}
+
+// addressable returns addressable copy of the value if it is not addressable.
+func addressable(val reflect.Value) reflect.Value {
+	if val.CanAddr() {
+		return val
+	}
+	cpy := reflect.New(val.Type()).Elem()
+	cpy.Set(val)
+	return cpy
 }
@@ -4,6 +4,7 @@
 package dump
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 	"time"
@@ -147,6 +148,77 @@ func Test_StructDumper(t *testing.T) {
 		affirm.Equal(t, want.String(), have)
 	})
 
+	t.Run("unsafe unexported fields", func(t *testing.T) {
+		// --- Given ---
+		type T struct {
+			tim time.Time
+			err error
+			str types.TStringer
+		}
+		s := T{
+			tim: time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC),
+			err: errors.New("msg"),
+			str: types.TStringer{Val: "v"},
+		}
+		dmp := New(WithFlat, WithUnsafeUnexported, WithStringer())
+
+		// --- When ---
+		have := StructDumper(dmp, 0, reflect.ValueOf(s))
+
+		// --- Then ---
+		want := `{tim: "2000-01-02T03:04:05Z", err: "msg", str: "str:v"}`
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("unsafe unexported fields of addressable struct", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ dur time.Duration }
+		s := &T{dur: time.Second}
+		dmp := New(WithFlat, WithUnsafeUnexported)
+
+		// --- When ---
+		have := StructDumper(dmp, 0, reflect.ValueOf(s).Elem())
+
+		// --- Then ---
+		affirm.Equal(t, `{dur: "1s"}`, have)
+	})
+
+	t.Run("redacted fields", func(t *testing.T) {
+		// --- Given ---
+		type T struct {
+			User  string
+			Pass  string `dump:"redact"`
+			Token *types.TIntStr
+		}
+		s := T{User: "u", Pass: "p", Token: &types.TIntStr{Int: 1}}
+		dmp := New(WithFlat, WithRedact("Token"))
+
+		// --- When ---
+		have := StructDumper(dmp, 0, reflect.ValueOf(s))
+
+		// --- Then ---
+		affirm.Equal(t, `{User: "u", Pass: <redacted>, Token: <redacted>}`, have)
+	})
+
+	t.Run("with tags", func(t *testing.T) {
+		// --- Given ---
+		type T struct {
+			Name string `json:"name" yaml:"nm"`
+			Age  int    `yaml:"age"`
+			Note string
+		}
+		s := T{Name: "n", Age: 1, Note: "x"}
+		dmp := New(WithFlat, WithTags("json", "yaml"))
+
+		// --- When ---
+		have := StructDumper(dmp, 0, reflect.ValueOf(s))
+
+		// --- Then ---
+		want := "{Name `json:\"name\" yaml:\"nm\"`: \"n\", " +
+			"Age `yaml:\"age\"`: 1, Note: \"x\"}"
+		affirm.Equal(t, want, have)
+	})
+
 	t.Run("error - invalid type", func(t *testing.T) {
 		// --- Given ---
 		dmp := New(WithIndent(1))
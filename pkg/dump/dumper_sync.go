@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"reflect"
+	"sync"
+)
+
+// syncTypes are the synchronization primitives dumped by default using
+// [SyncDumper].
+var syncTypes = []reflect.Type{
+	reflect.TypeOf(sync.Mutex{}),
+	reflect.TypeOf(sync.RWMutex{}),
+	reflect.TypeOf(sync.Once{}),
+	reflect.TypeOf(sync.WaitGroup{}),
+}
+
+// SyncDumper is a dumper for synchronization primitives like [sync.Mutex]. It
+// renders the value type without the internal state of the primitive, for
+// example, "sync.Mutex{<state omitted>}". Returns [ValErrUsage]
+// ("<dump-usage-error>") string for invalid values.
+func SyncDumper(dmp Dump, lvl int, val reflect.Value) string {
+	prn := NewPrinter(dmp).Tab(dmp.Indent + lvl)
+	if !val.IsValid() {
+		return prn.Write(ValErrUsage).String()
+	}
+	prn.Write(dmp.colorize(colorType, val.Type().String()))
+	return prn.Write("{" + ValOmitted + "}").String()
+}
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+)
+
+func Test_SyncDumper(t *testing.T) {
+	t.Run("mutex", func(t *testing.T) {
+		// --- Given ---
+		dmp := New()
+
+		// --- When ---
+		have := SyncDumper(dmp, 0, reflect.ValueOf(&sync.Mutex{}).Elem())
+
+		// --- Then ---
+		affirm.Equal(t, "sync.Mutex{<state omitted>}", have)
+	})
+
+	t.Run("uses indent and level", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithIndent(1))
+
+		// --- When ---
+		have := SyncDumper(dmp, 1, reflect.ValueOf(&sync.WaitGroup{}).Elem())
+
+		// --- Then ---
+		affirm.Equal(t, "    sync.WaitGroup{<state omitted>}", have)
+	})
+
+	t.Run("error - invalid value", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithIndent(1))
+
+		// --- When ---
+		have := SyncDumper(dmp, 2, reflect.Value{})
+
+		// --- Then ---
+		affirm.Equal(t, "      "+ValErrUsage, have)
+	})
+}
+
+func Test_SyncDumper_struct_fields(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		// --- Given ---
+		type T struct {
+			Mx   sync.Mutex
+			RW   *sync.RWMutex
+			Once sync.Once
+			WG   sync.WaitGroup
+		}
+		val := &T{RW: &sync.RWMutex{}}
+		val.Mx.Lock()
+		defer val.Mx.Unlock()
+		dmp := New(WithFlat)
+
+		// --- When ---
+		have := dmp.Any(val)
+
+		// --- Then ---
+		want := "{" +
+			"Mx: sync.Mutex{<state omitted>}, " +
+			"RW: sync.RWMutex{<state omitted>}, " +
+			"Once: sync.Once{<state omitted>}, " +
+			"WG: sync.WaitGroup{<state omitted>}" +
+			"}"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("with sync state", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ Mx *sync.Mutex }
+		val := T{Mx: &sync.Mutex{}}
+		dmp := New(WithFlat, WithSyncState)
+
+		// --- When ---
+		have := dmp.Any(val)
+
+		// --- Then ---
+		affirm.Equal(t, false, strings.Contains(have, ValOmitted))
+	})
+}
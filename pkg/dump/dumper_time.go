@@ -11,9 +11,10 @@ import (
 
 // Formats used by [GetTimeDumper].
 const (
-	TimeAsRFC3339  = ""         // Formats time as [time.RFC3339Nano].
-	TimeAsUnix     = "<unix>"   // Formats time as Unix timestamp (seconds).
-	TimeAsGoString = "<go-str>" // Formats time the same way as [time.GoString].
+	TimeAsRFC3339  = ""            // Formats time as [time.RFC3339Nano].
+	TimeAsUnix     = "<unix>"      // Formats time as Unix timestamp (seconds).
+	TimeAsUnixNano = "<unix-nano>" // Formats time as Unix timestamp (nanos).
+	TimeAsGoString = "<go-str>"    // Formats time as [time.Time.GoString].
 )
 
 // GetTimeDumper returns [time.Time] dumper based on format.
@@ -23,6 +24,8 @@ func GetTimeDumper(format string) Dumper {
 		return TimeDumperFmt(time.RFC3339Nano)
 	case TimeAsUnix:
 		return TimeDumperUnix
+	case TimeAsUnixNano:
+		return TimeDumperUnixNano
 	case TimeAsGoString:
 		return TimeDumperDate
 	default:
@@ -60,6 +63,20 @@ func TimeDumperUnix(dmp Dump, lvl int, val reflect.Value) string {
 	return SimpleDumper(dmp, lvl, val)
 }
 
+// TimeDumperUnixNano requires val to be a value representing [time.Time] and
+// returns its string representation as a Unix timestamp in nanoseconds.
+// Returns [valErrUsage] ("<dump-usage-error>") string if the type cannot be
+// matched.
+func TimeDumperUnixNano(dmp Dump, lvl int, val reflect.Value) string {
+	ts, ok := val.Interface().(time.Time)
+	if !ok {
+		prn := NewPrinter(dmp).Tab(dmp.Indent + lvl)
+		return prn.Write(ValErrUsage).String()
+	}
+	val = reflect.ValueOf(ts.UnixNano())
+	return SimpleDumper(dmp, lvl, val)
+}
+
 // TimeDumperDate requires val to be a value representing [time.Time] and
 // returns its representation using [time.Time.GoString] method. Returns
 // [valErrUsage] ("<dump-usage-error>") string if the type cannot be matched.
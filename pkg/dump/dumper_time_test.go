@@ -38,6 +38,12 @@ func Test_GetTimeDumper_tabular(t *testing.T) {
 			time.Date(2000, 1, 2, 3, 4, 5, 0, types.WAW),
 			"946778645",
 		},
+		{
+			"TimeAsUnixNano",
+			TimeAsUnixNano,
+			time.Date(2000, 1, 2, 3, 4, 5, 6, types.WAW),
+			"946778645000000006",
+		},
 		{
 			"custom",
 			time.TimeOnly,
@@ -189,6 +195,58 @@ func Test_TimeDumperUnix(t *testing.T) {
 	})
 }
 
+func Test_TimeDumperUnixNano(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		dmp := New()
+		tim := time.Date(2000, 1, 2, 3, 4, 5, 6, types.WAW)
+		val := reflect.ValueOf(tim)
+
+		// --- When ---
+		have := TimeDumperUnixNano(dmp, 0, val)
+
+		// --- Then ---
+		affirm.Equal(t, "946778645000000006", have)
+	})
+
+	t.Run("start of Unix epoch", func(t *testing.T) {
+		// --- Given ---
+		dmp := New()
+		tim := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+		val := reflect.ValueOf(tim)
+
+		// --- When ---
+		have := TimeDumperUnixNano(dmp, 0, val)
+
+		// --- Then ---
+		affirm.Equal(t, "0", have)
+	})
+
+	t.Run("uses indent and level", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithIndent(2))
+		tim := time.Date(2000, 1, 2, 3, 4, 5, 0, types.WAW)
+		val := reflect.ValueOf(tim)
+
+		// --- When ---
+		have := TimeDumperUnixNano(dmp, 1, val)
+
+		// --- Then ---
+		affirm.Equal(t, "      946778645000000000", have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithIndent(1))
+
+		// --- When ---
+		have := TimeDumperUnixNano(dmp, 2, reflect.ValueOf(123))
+
+		// --- Then ---
+		affirm.Equal(t, "      "+ValErrUsage, have)
+	})
+}
+
 func Test_TimeDumperDate(t *testing.T) {
 	t.Run("UTC", func(t *testing.T) {
 		// --- Given ---
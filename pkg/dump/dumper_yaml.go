@@ -0,0 +1,368 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// yamlKeywords are plain scalars which YAML parsers may resolve to values
+// other than strings.
+var yamlKeywords = []string{
+	"true", "false", "yes", "no", "on", "off", "y", "n", "null",
+}
+
+// YAMLDumper is a dumper rendering values as YAML. Structs and maps are
+// rendered as block mappings, slices and arrays as block sequences, and other
+// values as scalars. When [Dump.Flat] is set, the flow style is used instead.
+// Values with custom dumpers, stringers, and values which cannot be
+// represented in YAML (functions, channels) are rendered as strings. The
+// number of not dumped items is rendered as a comment. Colors are not used.
+//
+// Example:
+//
+//	Name: bob
+//	Tags:
+//	  - admin
+//	  - dev
+//	Address:
+//	  City: Warsaw
+func YAMLDumper(dmp Dump, lvl int, val reflect.Value) string {
+	dmp.Color = false
+	prn := NewPrinter(dmp)
+	lines, _ := dmp.yamlNode(lvl, val)
+	for i, line := range lines {
+		if i > 0 {
+			prn.NL()
+		}
+		prn.Tab(dmp.Indent + lvl).Write(line)
+	}
+	return prn.String()
+}
+
+// yamlNode returns lines of the YAML node representing the value. The lines
+// are not indented. Returns true when the node is a single line: a scalar, an
+// empty collection, or a collection in the flow style.
+//
+// nolint: cyclop
+func (dmp Dump) yamlNode(lvl int, val reflect.Value) ([]string, bool) {
+	if lvl > dmp.MaxDepth {
+		return []string{strconv.Quote(maxNesting(val))}, true
+	}
+	if !val.IsValid() {
+		return []string{"null"}, true
+	}
+
+	if str, ok := dmp.yamlSpecial(val); ok {
+		return []string{str}, true
+	}
+
+	switch val.Kind() {
+	case reflect.Interface:
+		if val.IsNil() {
+			return []string{"null"}, true
+		}
+		return dmp.yamlNode(lvl, val.Elem())
+
+	case reflect.Pointer:
+		if val.IsNil() {
+			return []string{"null"}, true
+		}
+		vis := visit{ptr: val.Pointer(), typ: val.Type()}
+		if at, ok := dmp.visited[vis]; ok {
+			str := fmt.Sprintf(ValCycle, typeName(val.Type().Elem()), at)
+			return []string{strconv.Quote(str)}, true
+		}
+		if dmp.visited == nil {
+			dmp.visited = make(map[visit]int)
+		}
+		dmp.visited[vis] = lvl
+		defer delete(dmp.visited, vis)
+		return dmp.yamlNode(lvl, val.Elem())
+
+	case reflect.Slice, reflect.Array:
+		if val.Kind() == reflect.Slice && val.IsNil() {
+			return []string{"null"}, true
+		}
+		return dmp.yamlSequence(lvl, val)
+
+	case reflect.Map:
+		if val.IsNil() {
+			return []string{"null"}, true
+		}
+		return dmp.yamlMap(lvl, val)
+
+	case reflect.Struct:
+		return dmp.yamlStruct(lvl, val)
+	}
+	return []string{dmp.yamlScalar(val)}, true
+}
+
+// yamlSpecial returns the YAML scalar for values which are not dumped based
+// on their kind: values with custom dumpers, contexts, stringers, and errors.
+func (dmp Dump) yamlSpecial(val reflect.Value) (string, bool) {
+	flat := dmp
+	flat.Flat = true
+	if fn, ok := dmp.Dumpers[val.Type()]; ok {
+		return yamlText(fn(flat, 0, val)), true
+	}
+	if isContext(val) {
+		return yamlText(ContextDumper(flat, 0, val)), true
+	}
+	if str, ok := dmp.stringer(val); ok {
+		return yamlText(str), true
+	}
+	typ := val.Type()
+	if typ == typError || typ.String() == "*errors.errorString" {
+		if err, ok := errorValue(val); ok {
+			return yamlString(err.Error()), true
+		}
+	}
+	return "", false
+}
+
+// yamlSequence returns lines of the YAML sequence representing the slice or
+// array.
+func (dmp Dump) yamlSequence(lvl int, val reflect.Value) ([]string, bool) {
+	num := val.Len()
+	cnt := dmp.maxItems(num)
+	if num == 0 {
+		return []string{"[]"}, true
+	}
+
+	if dmp.Flat {
+		items := make([]string, 0, cnt+1)
+		for i := 0; i < cnt; i++ {
+			sub, _ := dmp.yamlNode(lvl+1, val.Index(i))
+			items = append(items, sub[0])
+		}
+		if num > cnt {
+			more := fmt.Sprintf(ValMoreItems, num-cnt)
+			items = append(items, strconv.Quote(more))
+		}
+		return []string{"[" + strings.Join(items, ", ") + "]"}, true
+	}
+
+	var lines []string
+	for i := 0; i < cnt; i++ {
+		sub, _ := dmp.yamlNode(lvl+1, val.Index(i))
+		lines = append(lines, "- "+sub[0])
+		for _, line := range sub[1:] {
+			lines = append(lines, "  "+line)
+		}
+	}
+	if num > cnt {
+		lines = append(lines, "# "+fmt.Sprintf(ValMoreItems, num-cnt))
+	}
+	return lines, false
+}
+
+// yamlMap returns lines of the YAML mapping representing the map.
+func (dmp Dump) yamlMap(lvl int, val reflect.Value) ([]string, bool) {
+	keys := val.MapKeys()
+	dmp.sortKeys(keys)
+
+	num := len(keys)
+	cnt := dmp.maxItems(num)
+	entries := make([][2]reflect.Value, 0, cnt)
+	for _, key := range keys[:cnt] {
+		entries = append(entries, [2]reflect.Value{key, val.MapIndex(key)})
+	}
+
+	var more string
+	if num > cnt {
+		more = fmt.Sprintf(ValMoreItems, num-cnt)
+	}
+	return dmp.yamlMapping(lvl, entries, more)
+}
+
+// yamlStruct returns lines of the YAML mapping representing the struct.
+func (dmp Dump) yamlStruct(lvl int, val reflect.Value) ([]string, bool) {
+	if dmp.UnsafeUnexported {
+		val = addressable(val)
+	}
+	typ := val.Type()
+	entries := make([][2]reflect.Value, 0, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		fld := typ.Field(i)
+		if !fld.IsExported() && !dmp.PrintPrivate {
+			continue
+		}
+		sub := dmp.field(val, i)
+		if dmp.IsRedacted(fld) {
+			sub = reflect.ValueOf(ValRedacted)
+		}
+		entries = append(entries, [2]reflect.Value{
+			reflect.ValueOf(fld.Name),
+			sub,
+		})
+	}
+	return dmp.yamlMapping(lvl, entries, "")
+}
+
+// yamlMapping returns lines of the YAML mapping with given key and value
+// pairs. When "more" is not empty, it is added as the last entry in the flow
+// style or as a comment in the block style.
+func (dmp Dump) yamlMapping(
+	lvl int,
+	entries [][2]reflect.Value,
+	more string,
+) ([]string, bool) {
+
+	if len(entries) == 0 && more == "" {
+		return []string{"{}"}, true
+	}
+
+	tab := strings.Repeat(" ", max(dmp.TabWidth, 1))
+	items := make([]string, 0, len(entries)+1)
+	var lines []string
+	for _, entry := range entries {
+		key := dmp.yamlKey(lvl, entry[0])
+		sub, single := dmp.yamlNode(lvl+1, entry[1])
+		switch {
+		case dmp.Flat:
+			items = append(items, key+": "+sub[0])
+		case single:
+			lines = append(lines, key+": "+sub[0])
+		default:
+			lines = append(lines, key+":")
+			for _, line := range sub {
+				lines = append(lines, tab+line)
+			}
+		}
+	}
+
+	if dmp.Flat {
+		if more != "" {
+			items = append(items, strconv.Quote(more)+": null")
+		}
+		return []string{"{" + strings.Join(items, ", ") + "}"}, true
+	}
+	if more != "" {
+		lines = append(lines, "# "+more)
+	}
+	return lines, false
+}
+
+// yamlKey returns the YAML scalar representing the mapping key at the level
+// of the mapping. Keys which are not scalars are rendered as strings.
+func (dmp Dump) yamlKey(lvl int, key reflect.Value) string {
+	flat := dmp
+	flat.Flat = true
+	sub, _ := flat.yamlNode(lvl, key)
+	str := sub[0]
+	if strings.HasPrefix(str, "[") || strings.HasPrefix(str, "{") {
+		return strconv.Quote(str)
+	}
+	return str
+}
+
+// yamlScalar returns the YAML scalar representing the value of the kind other
+// than struct, map, slice, array, pointer, or interface.
+//
+// nolint: cyclop
+func (dmp Dump) yamlScalar(val reflect.Value) string {
+	switch val.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(val.Bool())
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(val.Int(), 10)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(val.Uint(), 10)
+
+	case reflect.Float32:
+		return dmp.yamlFloat(val.Float(), 32)
+
+	case reflect.Float64:
+		return dmp.yamlFloat(val.Float(), 64)
+
+	case reflect.Complex64, reflect.Complex128:
+		return yamlText(ComplexDumper(dmp, 0, val))
+
+	case reflect.String:
+		str, more := dmp.truncString(val.String())
+		if more > 0 {
+			return strconv.Quote(str + fmt.Sprintf(ValMoreChars, more))
+		}
+		return yamlString(str)
+
+	case reflect.Func:
+		return strconv.Quote(ValFunc)
+
+	case reflect.Chan:
+		return strconv.Quote(ValChan)
+
+	case reflect.UnsafePointer:
+		return strconv.Quote(ValAddr)
+
+	default:
+		return strconv.Quote(ValErrUsage)
+	}
+}
+
+// yamlFloat returns the YAML scalar representing the floating point number
+// with the given bit size.
+func (dmp Dump) yamlFloat(f float64, bits int) string {
+	switch {
+	case math.IsNaN(f):
+		return ".nan"
+	case math.IsInf(f, 1):
+		return ".inf"
+	case math.IsInf(f, -1):
+		return "-.inf"
+	}
+	return dmp.formatFloat(f, bits)
+}
+
+// yamlText returns the YAML scalar representing the text produced by other
+// dumpers. Quoted Go strings are unquoted first.
+func yamlText(str string) string {
+	if s, err := strconv.Unquote(str); err == nil {
+		return yamlString(s)
+	}
+	return yamlString(str)
+}
+
+// yamlString returns the YAML scalar representing the string. The string is
+// rendered as the plain scalar when it's safe, otherwise it's double-quoted.
+func yamlString(str string) string {
+	if !yamlPlain(str) {
+		return strconv.Quote(str)
+	}
+	return str
+}
+
+// yamlPlain returns true if the string can be rendered as the plain YAML
+// scalar without changing its meaning. To keep the rules simple, only strings
+// starting with a letter and containing letters, digits, spaces, and "_-./"
+// characters are considered plain.
+func yamlPlain(str string) bool {
+	if str == "" || strings.HasSuffix(str, " ") {
+		return false
+	}
+	for i, r := range str {
+		switch {
+		case unicode.IsLetter(r):
+		case i == 0:
+			return false
+		case unicode.IsDigit(r) || strings.ContainsRune("_-./ ", r):
+		default:
+			return false
+		}
+	}
+	for _, kw := range yamlKeywords {
+		if strings.EqualFold(str, kw) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,349 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/internal/types"
+)
+
+func Test_YAMLDumper(t *testing.T) {
+	t.Run("struct", func(t *testing.T) {
+		// --- Given ---
+		type A struct{ City string }
+		type T struct {
+			Name string
+			Tags []string
+			Addr *A
+			Tim  time.Time
+		}
+		val := T{
+			Name: "bob",
+			Tags: []string{"admin", "dev"},
+			Addr: &A{City: "Warsaw"},
+			Tim:  time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC),
+		}
+		dmp := New()
+
+		// --- When ---
+		have := YAMLDumper(dmp, 0, reflect.ValueOf(val))
+
+		// --- Then ---
+		want := "Name: bob\n" +
+			"Tags:\n" +
+			"  - admin\n" +
+			"  - dev\n" +
+			"Addr:\n" +
+			"  City: Warsaw\n" +
+			"Tim: \"2000-01-02T03:04:05Z\""
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("map", func(t *testing.T) {
+		// --- Given ---
+		val := map[string]any{"b": []int{1, 2}, "a": nil, "c": map[int]int{}}
+		dmp := New()
+
+		// --- When ---
+		have := YAMLDumper(dmp, 0, reflect.ValueOf(val))
+
+		// --- Then ---
+		want := "a: null\n" +
+			"b:\n" +
+			"  - 1\n" +
+			"  - 2\n" +
+			"c: {}"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("sequence of mappings and sequences", func(t *testing.T) {
+		// --- Given ---
+		val := []any{
+			map[string]int{"a": 1, "b": 2},
+			[]int{3, 4},
+			[]int{},
+		}
+		dmp := New()
+
+		// --- When ---
+		have := YAMLDumper(dmp, 0, reflect.ValueOf(val))
+
+		// --- Then ---
+		want := "- a: 1\n" +
+			"  b: 2\n" +
+			"- - 3\n" +
+			"  - 4\n" +
+			"- []"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("flat", func(t *testing.T) {
+		// --- Given ---
+		val := map[string]any{"a": []int{1, 2}, "b": &types.TIntStr{Int: 1}}
+		dmp := New(WithFlat)
+
+		// --- When ---
+		have := YAMLDumper(dmp, 0, reflect.ValueOf(val))
+
+		// --- Then ---
+		affirm.Equal(t, `{a: [1, 2], b: {Int: 1, Str: ""}}`, have)
+	})
+
+	t.Run("max items", func(t *testing.T) {
+		// --- Given ---
+		val := map[string][]int{"a": {1, 2, 3}, "b": {4}}
+		dmp := New(WithMaxItems(1))
+
+		// --- When ---
+		have := YAMLDumper(dmp, 0, reflect.ValueOf(val))
+
+		// --- Then ---
+		want := "a:\n" +
+			"  - 1\n" +
+			"  # ... (+2 more)\n" +
+			"# ... (+1 more)"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("flat max items", func(t *testing.T) {
+		// --- Given ---
+		val := []int{1, 2, 3}
+		dmp := New(WithFlat, WithMaxItems(1))
+
+		// --- When ---
+		have := YAMLDumper(dmp, 0, reflect.ValueOf(val))
+
+		// --- Then ---
+		affirm.Equal(t, `[1, "... (+2 more)"]`, have)
+	})
+
+	t.Run("max depth", func(t *testing.T) {
+		// --- Given ---
+		val := map[string]any{"a": map[string]int{"b": 1}}
+		dmp := New(WithMaxDepth(0))
+
+		// --- When ---
+		have := YAMLDumper(dmp, 0, reflect.ValueOf(val))
+
+		// --- Then ---
+		affirm.Equal(t, `a: "{...}"`, have)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		// --- Given ---
+		val := &types.TRec{Int: 1}
+		val.Rec = val
+		dmp := New()
+
+		// --- When ---
+		have := YAMLDumper(dmp, 0, reflect.ValueOf(val))
+
+		// --- Then ---
+		affirm.Equal(t, "Int: 1\nRec: \"<cycle to TRec@0>\"", have)
+	})
+
+	t.Run("redacted and private fields", func(t *testing.T) {
+		// --- Given ---
+		type T struct {
+			Pass string
+			prv  int
+		}
+		val := T{Pass: "secret", prv: 1}
+		dmp := New(WithRedact("Pass"), WithNoPrivate)
+
+		// --- When ---
+		have := YAMLDumper(dmp, 0, reflect.ValueOf(val))
+
+		// --- Then ---
+		affirm.Equal(t, `Pass: "<redacted>"`, have)
+	})
+
+	t.Run("empty struct", func(t *testing.T) {
+		// --- Given ---
+		dmp := New()
+
+		// --- When ---
+		have := YAMLDumper(dmp, 0, reflect.ValueOf(struct{}{}))
+
+		// --- Then ---
+		affirm.Equal(t, "{}", have)
+	})
+
+	t.Run("not scalar map keys", func(t *testing.T) {
+		// --- Given ---
+		val := map[[2]int]int{{1, 2}: 3}
+		dmp := New()
+
+		// --- When ---
+		have := YAMLDumper(dmp, 0, reflect.ValueOf(val))
+
+		// --- Then ---
+		affirm.Equal(t, `"[1, 2]": 3`, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		val := struct{ Err error }{Err: errors.New("a: b")}
+		dmp := New()
+
+		// --- When ---
+		have := YAMLDumper(dmp, 0, reflect.ValueOf(val))
+
+		// --- Then ---
+		affirm.Equal(t, `Err: "a: b"`, have)
+	})
+
+	t.Run("stringer", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithStringer())
+
+		// --- When ---
+		have := YAMLDumper(dmp, 0, reflect.ValueOf(types.TStringer{Val: "a"}))
+
+		// --- Then ---
+		affirm.Equal(t, `"str:a"`, have)
+	})
+
+	t.Run("uses indent and level", func(t *testing.T) {
+		// --- Given ---
+		val := map[string]int{"a": 1, "b": 2}
+		dmp := New(WithIndent(1))
+
+		// --- When ---
+		have := YAMLDumper(dmp, 1, reflect.ValueOf(val))
+
+		// --- Then ---
+		affirm.Equal(t, "    a: 1\n    b: 2", have)
+	})
+
+	t.Run("uses tab width", func(t *testing.T) {
+		// --- Given ---
+		val := map[string]any{"a": map[string]int{"b": 1}}
+		dmp := New(WithTabWidth(4))
+
+		// --- When ---
+		have := YAMLDumper(dmp, 0, reflect.ValueOf(val))
+
+		// --- Then ---
+		affirm.Equal(t, "a:\n    b: 1", have)
+	})
+
+	t.Run("with Dump.Any", func(t *testing.T) {
+		// --- Given ---
+		val := []types.TIntStr{{Int: 1, Str: "a"}}
+		dmp := New(WithFormatYAML)
+
+		// --- When ---
+		have := dmp.Any(val)
+
+		// --- Then ---
+		affirm.Equal(t, "- Int: 1\n  Str: a", have)
+	})
+}
+
+func Test_Dump_yamlScalar_tabular(t *testing.T) {
+	var ch chan int
+	tt := []struct {
+		testN string
+
+		val  any
+		want string
+	}{
+		{"bool", true, "true"},
+		{"int", -42, "-42"},
+		{"uint8", uint8(42), "42"},
+		{"float", 1.5, "1.5"},
+		{"NaN", math.NaN(), ".nan"},
+		{"plus infinity", math.Inf(1), ".inf"},
+		{"minus infinity", math.Inf(-1), "-.inf"},
+		{"complex", complex(1, 2), `"(1+2i)"`},
+		{"plain string", "abc def", "abc def"},
+		{"quoted string", "a: b", `"a: b"`},
+		{"func", func() {}, `"<func>"`},
+		{"chan", ch, `"<chan>"`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			dmp := New()
+
+			// --- When ---
+			have := dmp.yamlScalar(reflect.ValueOf(tc.val))
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+
+	t.Run("truncated string", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithMaxStringLen(2))
+
+		// --- When ---
+		have := dmp.yamlScalar(reflect.ValueOf("abcd"))
+
+		// --- Then ---
+		affirm.Equal(t, `"ab…(+2 chars)"`, have)
+	})
+}
+
+func Test_yamlText(t *testing.T) {
+	t.Run("quoted", func(t *testing.T) {
+		// --- When ---
+		have := yamlText(`"abc"`)
+
+		// --- Then ---
+		affirm.Equal(t, "abc", have)
+	})
+
+	t.Run("not quoted", func(t *testing.T) {
+		// --- When ---
+		have := yamlText(`T{A: 1}`)
+
+		// --- Then ---
+		affirm.Equal(t, `"T{A: 1}"`, have)
+	})
+}
+
+func Test_yamlPlain_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		str  string
+		want bool
+	}{
+		{"word", "abc", true},
+		{"words", "abc def", true},
+		{"path", "a/b.c_d-e", true},
+		{"unicode", "żółw", true},
+		{"empty", "", false},
+		{"starts with digit", "1abc", false},
+		{"starts with space", " abc", false},
+		{"ends with space", "abc ", false},
+		{"colon", "a:b", false},
+		{"hash", "a #b", false},
+		{"quote", `a"b`, false},
+		{"new line", "a\nb", false},
+		{"keyword true", "True", false},
+		{"keyword null", "null", false},
+		{"keyword y", "y", false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := yamlPlain(tc.str)
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
@@ -7,61 +7,38 @@ import (
 	"reflect"
 )
 
-// valueCmp returns whether the first value should sort before the second one.
-//
-// nolint: cyclop
-func valueCmp(a, b reflect.Value) int {
-	switch a.Kind() {
-	case reflect.Bool:
-		av, bv := a.Bool(), b.Bool()
-		if av == bv {
-			return 0
-		}
-		if av {
-			return 1
-		}
-		return -1
-
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		av, bv := a.Int(), b.Int()
-		if av == bv {
-			return 0
-		}
-		if av < bv {
-			return -1
-		}
-		return 1
-
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
-		reflect.Uint64, reflect.Uintptr:
-
-		av, bv := a.Uint(), b.Uint()
-		if av == bv {
-			return 0
-		}
-		if av < bv {
-			return -1
-		}
-		return 1
+// typeName returns the type name or its string representation for not named
+// types.
+func typeName(typ reflect.Type) string {
+	if name := typ.Name(); name != "" {
+		return name
+	}
+	return typ.String()
+}
 
-	case reflect.Float32, reflect.Float64:
-		av, bv := a.Float(), b.Float()
-		if av == bv {
-			return 0
-		}
-		if av < bv {
-			return -1
-		}
-		return 1
+// maxNesting returns the marker for a value nested deeper than the maximum
+// depth. Returns [ValMaxNestObj] for composite values (structs, maps, slices,
+// and arrays) and pointers or interfaces to them, otherwise [ValMaxNesting].
+func maxNesting(val reflect.Value) string {
+	if val.Kind() == reflect.Interface && !val.IsNil() {
+		val = val.Elem()
+	}
+	if val.Kind() == reflect.Pointer && !val.IsNil() {
+		val = val.Elem()
+	}
+	if isComposite(val.Kind()) {
+		return ValMaxNestObj
+	}
+	return ValMaxNesting
+}
 
+// isComposite returns true for composite kinds: structs, maps, slices, and
+// arrays.
+func isComposite(knd reflect.Kind) bool {
+	switch knd {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+		return true
 	default:
-		av, bv := a.String(), b.String()
-		if av == bv {
-			return 0
-		}
-		if av < bv {
-			return -1
-		}
-		return 1
+		return false
 	}
 }
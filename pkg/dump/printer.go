@@ -8,18 +8,96 @@ package dump
 
 import (
 	"bytes"
+	"io"
 	"strings"
+	"sync"
 )
 
+// maxPooledBuf is the maximum capacity of a buffer returned to the pool.
+// Bigger buffers are left for the garbage collector, so the pool doesn't hold
+// on to a lot of memory after dumping a big value.
+const maxPooledBuf = 64 << 10
+
+// spaces is used to write indentation without allocating.
+const spaces = "                                "
+
+// bufPool is the pool of buffers used by printers created with [NewPrinter].
+var bufPool = sync.Pool{New: func() any { return &bytes.Buffer{} }}
+
+// putBuf resets the buffer and returns it to the pool unless it's bigger than
+// [maxPooledBuf].
+func putBuf(buf *bytes.Buffer) {
+	if buf.Cap() <= maxPooledBuf {
+		buf.Reset()
+		bufPool.Put(buf)
+	}
+}
+
+// pooledBuf is the buffer used by printers created with [NewPrinter]. It takes
+// a buffer from the pool on the first write and returns it to the pool when
+// [pooledBuf.String] is called, caching the built string. Writing after that
+// takes a new buffer from the pool, starting with the cached string, so the
+// buffer is never returned to the pool twice or shared by two printers.
+type pooledBuf struct {
+	buf *bytes.Buffer // Buffer from the pool, nil when not taken.
+	str string        // String built before the buffer was returned.
+}
+
+// get returns the buffer taken from the pool.
+func (pb *pooledBuf) get() *bytes.Buffer {
+	if pb.buf == nil {
+		pb.buf = bufPool.Get().(*bytes.Buffer) // nolint: forcetypeassert
+		pb.buf.WriteString(pb.str)
+	}
+	return pb.buf
+}
+
+// Write implements [io.Writer] interface.
+func (pb *pooledBuf) Write(p []byte) (int, error) { return pb.get().Write(p) }
+
+// WriteByte implements [io.ByteWriter] interface.
+func (pb *pooledBuf) WriteByte(c byte) error { return pb.get().WriteByte(c) }
+
+// WriteString implements [io.StringWriter] interface.
+func (pb *pooledBuf) WriteString(s string) (int, error) {
+	return pb.get().WriteString(s)
+}
+
+// String returns the built string and returns the buffer to the pool. It can
+// be called many times.
+func (pb *pooledBuf) String() string {
+	if pb.buf == nil {
+		return pb.str
+	}
+	pb.str = pb.buf.String()
+	putBuf(pb.buf)
+	pb.buf = nil
+	return pb.str
+}
+
+// writer is the interface implemented by [Printer] buffers.
+type writer interface {
+	io.Writer
+	io.ByteWriter
+	io.StringWriter
+}
+
 // Printer represents code printer.
 type Printer struct {
 	dmp Dump
-	buf *strings.Builder
+	buf writer
 }
 
-// NewPrinter returns new [Printer] configured by [Dump].
+// NewPrinter returns new [Printer] configured by [Dump]. The printer uses a
+// buffer from the pool, which is returned to the pool by [Printer.String].
 func NewPrinter(dmp Dump) Printer {
-	return Printer{dmp: dmp, buf: &strings.Builder{}}
+	return Printer{dmp: dmp, buf: &pooledBuf{}}
+}
+
+// newWriterPrinter returns new [Printer] configured by [Dump] writing to the
+// given writer.
+func newWriterPrinter(dmp Dump, w writer) Printer {
+	return Printer{dmp: dmp, buf: w}
 }
 
 // NLI prints new line when not flat and at least one entry.
@@ -51,10 +129,9 @@ func (prn Printer) Tab(n int) Printer {
 	if prn.dmp.Flat {
 		return prn
 	}
-	if n < 0 {
-		n = 0
+	for n *= prn.dmp.TabWidth; n > 0; n -= len(spaces) {
+		prn.buf.WriteString(spaces[:min(n, len(spaces))])
 	}
-	prn.buf.Write(bytes.Repeat([]byte{' '}, n*prn.dmp.TabWidth))
 	return prn
 }
 
@@ -80,5 +157,16 @@ func (prn Printer) Write(s string) Printer {
 	return prn
 }
 
-// String returns built string.
-func (prn Printer) String() string { return prn.buf.String() }
+// String returns built string. Returns empty string for printers writing to
+// an [io.Writer]. The buffer of the printer created with [NewPrinter] is
+// returned to the pool, but the printer can still be used and the method can
+// be called many times.
+func (prn Printer) String() string {
+	switch buf := prn.buf.(type) {
+	case *strings.Builder:
+		return buf.String()
+	case *pooledBuf:
+		return buf.String()
+	}
+	return ""
+}
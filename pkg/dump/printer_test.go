@@ -4,6 +4,8 @@
 package dump
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/ctx42/testing/internal/affirm"
@@ -137,6 +139,17 @@ func Test_Printer_Tab(t *testing.T) {
 		affirm.Equal(t, "    ", have.String())
 	})
 
+	t.Run("indentation wider than spaces constant", func(t *testing.T) {
+		// --- Given ---
+		dmp := Dump{Flat: false, TabWidth: 2}
+
+		// --- When ---
+		have := NewPrinter(dmp).Tab(len(spaces))
+
+		// --- Then ---
+		affirm.Equal(t, strings.Repeat(" ", 2*len(spaces)), have.String())
+	})
+
 	t.Run("default and negative n", func(t *testing.T) {
 		// --- Given ---
 		dmp := Dump{Flat: false}
@@ -262,3 +275,104 @@ func Test_Printer_Write_String(t *testing.T) {
 	// --- Then ---
 	affirm.Equal(t, "test", have.String())
 }
+
+func Test_Printer_String(t *testing.T) {
+	t.Run("buffer is returned to the pool", func(t *testing.T) {
+		// --- Given ---
+		prn := NewPrinter(New()).Write("test")
+
+		// --- When ---
+		have := prn.String()
+
+		// --- Then ---
+		affirm.Equal(t, "test", have)
+		affirm.Nil(t, prn.buf.(*pooledBuf).buf)
+	})
+
+	t.Run("called many times", func(t *testing.T) {
+		// --- Given ---
+		prn := NewPrinter(New()).Write("test")
+
+		// --- When ---
+		have0 := prn.String()
+		have1 := prn.String()
+
+		// --- Then ---
+		affirm.Equal(t, "test", have0)
+		affirm.Equal(t, "test", have1)
+		prnA := NewPrinter(New()).Write("A")
+		prnB := NewPrinter(New()).Write("B")
+		affirm.Equal(t, "A", prnA.String())
+		affirm.Equal(t, "B", prnB.String())
+		affirm.Equal(t, "test", prn.String())
+	})
+
+	t.Run("write after string", func(t *testing.T) {
+		// --- Given ---
+		prn := NewPrinter(New()).Write("A")
+		affirm.Equal(t, "A", prn.String())
+
+		// --- When ---
+		have := prn.Write("B").String()
+
+		// --- Then ---
+		affirm.Equal(t, "AB", have)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// --- When ---
+		have := NewPrinter(New()).String()
+
+		// --- Then ---
+		affirm.Equal(t, "", have)
+	})
+
+	t.Run("writer", func(t *testing.T) {
+		// --- Given ---
+		buf := &bytes.Buffer{}
+		prn := newWriterPrinter(New(), buf).Write("test")
+
+		// --- When ---
+		have := prn.String()
+
+		// --- Then ---
+		affirm.Equal(t, "", have)
+		affirm.Equal(t, "test", buf.String())
+	})
+
+	t.Run("strings builder", func(t *testing.T) {
+		// --- Given ---
+		prn := newWriterPrinter(New(), &strings.Builder{}).Write("test")
+
+		// --- When ---
+		have := prn.String()
+
+		// --- Then ---
+		affirm.Equal(t, "test", have)
+	})
+}
+
+func Test_putBuf(t *testing.T) {
+	t.Run("buffer is reset", func(t *testing.T) {
+		// --- Given ---
+		buf := bytes.NewBufferString("test")
+
+		// --- When ---
+		putBuf(buf)
+
+		// --- Then ---
+		affirm.Equal(t, 0, buf.Len())
+	})
+
+	t.Run("big buffer is not reused", func(t *testing.T) {
+		// --- Given ---
+		str := strings.Repeat("a", maxPooledBuf+1)
+		buf := bytes.NewBufferString(str)
+
+		// --- When ---
+		putBuf(buf)
+
+		// --- Then ---
+		affirm.Equal(t, len(str), buf.Len())
+	})
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"maps"
+	"reflect"
+)
+
+// refs tracks pointers referenced more than once in the dumped value and the
+// labels assigned to them. See [WithSharedPtr].
+type refs struct {
+	seen   map[visit]bool // Pointers found when scanning the value.
+	shared map[visit]bool // Pointers referenced more than once.
+	labels map[visit]int  // Labels of the shared pointers already dumped.
+}
+
+// newRefs returns [refs] with the shared pointers found in the value.
+func newRefs(dmp Dump, val reflect.Value) *refs {
+	ref := &refs{
+		seen:   make(map[visit]bool),
+		shared: make(map[visit]bool),
+		labels: make(map[visit]int),
+	}
+	ref.scan(dmp, 0, val)
+	ref.seen = nil
+	return ref
+}
+
+// scan walks the value the same way it is dumped and marks pointers seen
+// more than once as shared.
+//
+// nolint: cyclop
+func (ref *refs) scan(dmp Dump, lvl int, val reflect.Value) {
+	if lvl > dmp.MaxDepth || !val.IsValid() {
+		return
+	}
+	if _, ok := dmp.Dumpers[val.Type()]; ok || isContext(val) {
+		return
+	}
+	if _, ok := dmp.stringer(val); ok {
+		return
+	}
+
+	switch val.Kind() {
+	case reflect.Interface:
+		ref.scan(dmp, lvl, val.Elem())
+
+	case reflect.Pointer:
+		if val.IsNil() {
+			return
+		}
+		vis := visit{ptr: val.Pointer(), typ: val.Type()}
+		if ref.seen[vis] {
+			ref.shared[vis] = true
+			return
+		}
+		ref.seen[vis] = true
+		ref.scan(dmp, lvl, val.Elem())
+
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < dmp.maxItems(val.Len()); i++ {
+			ref.scan(dmp, lvl+1, val.Index(i))
+		}
+
+	case reflect.Map:
+		keys := val.MapKeys()
+		dmp.sortKeys(keys)
+		for _, key := range keys[:dmp.maxItems(len(keys))] {
+			ref.scan(dmp, lvl+1, key)
+			ref.scan(dmp, lvl+1, val.MapIndex(key))
+		}
+
+	case reflect.Struct:
+		typ := val.Type()
+		for i := 0; i < val.NumField(); i++ {
+			fld := typ.Field(i)
+			if (!fld.IsExported() && !dmp.PrintPrivate) || dmp.IsRedacted(fld) {
+				continue
+			}
+			ref.scan(dmp, lvl+1, val.Field(i))
+		}
+	}
+}
+
+// isShared returns true if the pointer is referenced more than once.
+func (ref *refs) isShared(vis visit) bool {
+	return ref != nil && ref.shared[vis]
+}
+
+// label returns the label of the shared pointer and true if it was already
+// dumped. Otherwise, it assigns the next label to the pointer and returns it
+// with false.
+func (ref *refs) label(vis visit) (int, bool) {
+	if n, ok := ref.labels[vis]; ok {
+		return n, true
+	}
+	n := len(ref.labels) + 1
+	ref.labels[vis] = n
+	return n, false
+}
+
+// clone returns a copy of the instance. Labels assigned to the copy are not
+// visible in the original. Returns nil for nil instance.
+func (ref *refs) clone() *refs {
+	if ref == nil {
+		return nil
+	}
+	return &refs{shared: ref.shared, labels: maps.Clone(ref.labels)}
+}
@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/internal/types"
+)
+
+func Test_newRefs(t *testing.T) {
+	t.Run("shared pointer", func(t *testing.T) {
+		// --- Given ---
+		ptr := &types.TIntStr{Int: 1}
+		val := reflect.ValueOf([]any{ptr, 1, ptr})
+
+		// --- When ---
+		have := newRefs(New(), val)
+
+		// --- Then ---
+		vis := visit{
+			ptr: reflect.ValueOf(ptr).Pointer(),
+			typ: reflect.TypeOf(ptr),
+		}
+		affirm.Equal(t, true, have.isShared(vis))
+		affirm.Equal(t, 1, len(have.shared))
+		affirm.Equal(t, 0, len(have.labels))
+	})
+
+	t.Run("not shared pointers", func(t *testing.T) {
+		// --- Given ---
+		val := reflect.ValueOf([]*types.TIntStr{{Int: 1}, {Int: 1}})
+
+		// --- When ---
+		have := newRefs(New(), val)
+
+		// --- Then ---
+		affirm.Equal(t, 0, len(have.shared))
+	})
+
+	t.Run("not dumped items are not scanned", func(t *testing.T) {
+		// --- Given ---
+		ptr := &types.TIntStr{Int: 1}
+		val := reflect.ValueOf([]any{ptr, 1, ptr})
+
+		// --- When ---
+		have := newRefs(New(WithMaxItems(2)), val)
+
+		// --- Then ---
+		affirm.Equal(t, 0, len(have.shared))
+	})
+
+	t.Run("not dumped private fields are not scanned", func(t *testing.T) {
+		// --- Given ---
+		type T struct {
+			Pub *types.TIntStr
+			prv *types.TIntStr
+		}
+		ptr := &types.TIntStr{Int: 1}
+		val := reflect.ValueOf(T{Pub: ptr, prv: ptr})
+
+		// --- When ---
+		have := newRefs(New(WithNoPrivate), val)
+
+		// --- Then ---
+		affirm.Equal(t, 0, len(have.shared))
+	})
+
+	t.Run("values with custom dumpers are not scanned", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ A, B *types.TIntStr }
+		ptr := &types.TIntStr{Int: 1}
+		val := reflect.ValueOf(T{A: ptr, B: ptr})
+		dmp := New(WithDumper(T{}, SimpleDumper))
+
+		// --- When ---
+		have := newRefs(dmp, val)
+
+		// --- Then ---
+		affirm.Equal(t, 0, len(have.shared))
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		// --- Given ---
+		val := &types.TRec{Int: 1}
+		val.Rec = val
+
+		// --- When ---
+		have := newRefs(New(), reflect.ValueOf(val))
+
+		// --- Then ---
+		affirm.Equal(t, 1, len(have.shared))
+	})
+}
+
+func Test_refs_isShared(t *testing.T) {
+	t.Run("nil instance", func(t *testing.T) {
+		// --- Given ---
+		var ref *refs
+
+		// --- When ---
+		have := ref.isShared(visit{ptr: 1})
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("not shared", func(t *testing.T) {
+		// --- Given ---
+		ref := &refs{shared: map[visit]bool{{ptr: 1}: true}}
+
+		// --- When ---
+		have := ref.isShared(visit{ptr: 2})
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+}
+
+func Test_refs_label(t *testing.T) {
+	// --- Given ---
+	ref := &refs{labels: make(map[visit]int)}
+
+	// --- When ---
+	n0, seen0 := ref.label(visit{ptr: 1})
+	n1, seen1 := ref.label(visit{ptr: 2})
+	n2, seen2 := ref.label(visit{ptr: 1})
+
+	// --- Then ---
+	affirm.Equal(t, 1, n0)
+	affirm.Equal(t, false, seen0)
+	affirm.Equal(t, 2, n1)
+	affirm.Equal(t, false, seen1)
+	affirm.Equal(t, 1, n2)
+	affirm.Equal(t, true, seen2)
+}
+
+func Test_refs_clone(t *testing.T) {
+	t.Run("nil instance", func(t *testing.T) {
+		// --- Given ---
+		var ref *refs
+
+		// --- When ---
+		have := ref.clone()
+
+		// --- Then ---
+		affirm.Nil(t, have)
+	})
+
+	t.Run("labels are not shared", func(t *testing.T) {
+		// --- Given ---
+		ref := &refs{
+			shared: map[visit]bool{{ptr: 1}: true},
+			labels: map[visit]int{{ptr: 1}: 1},
+		}
+
+		// --- When ---
+		have := ref.clone()
+
+		// --- Then ---
+		have.label(visit{ptr: 2})
+		affirm.Equal(t, 1, len(ref.labels))
+		affirm.Equal(t, 2, len(have.labels))
+		affirm.Equal(t, true, have.isShared(visit{ptr: 1}))
+	})
+}
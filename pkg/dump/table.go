@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+)
+
+// Table dumps a slice or an array of structs (or pointers to structs) as an
+// aligned text table with one row per element and one column per field.
+// Cells are dumped in the flat format using the [Dump] configuration, so the
+// redaction, string truncation, and the maximum number of items are honored.
+// Colors are not used. Values other than slices and arrays of structs are
+// dumped with [Dump.Any].
+//
+// Example:
+//
+//	Int | Str
+//	----+------
+//	1   | "abc"
+//	2   | "def"
+func (dmp Dump) Table(rows any) string {
+	val := reflect.ValueOf(rows)
+	typ, ok := tableType(val)
+	if !ok {
+		return dmp.Any(rows)
+	}
+
+	cell := dmp
+	cell.Flat = true
+	cell.Color = false
+	cell.Indent = 0
+
+	var fields []int
+	head := make([]string, 0, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		if typ.Field(i).IsExported() || dmp.PrintPrivate {
+			fields = append(fields, i)
+			head = append(head, typ.Field(i).Name)
+		}
+	}
+
+	num := val.Len()
+	cnt := dmp.maxItems(num)
+	table := [][]string{head}
+	for i := 0; i < cnt; i++ {
+		table = append(table, cell.tableRow(val.Index(i), fields))
+	}
+
+	widths := make([]int, len(head))
+	for _, row := range table {
+		for col, str := range row {
+			widths[col] = max(widths[col], utf8.RuneCountInString(str))
+		}
+	}
+
+	prn := NewPrinter(dmp)
+	sep := make([]string, len(head))
+	for col, width := range widths {
+		sep[col] = strings.Repeat("-", width)
+	}
+	for i, row := range table {
+		if i == 1 {
+			dmp.tableLine(prn, sep, widths, "-+-")
+		}
+		dmp.tableLine(prn, row, widths, " | ")
+	}
+	if len(table) == 1 {
+		dmp.tableLine(prn, sep, widths, "-+-")
+	}
+	if num > cnt {
+		prn.Tab(dmp.Indent).Write(fmt.Sprintf(ValMoreItems, num-cnt))
+	}
+	return strings.TrimSuffix(prn.String(), "\n")
+}
+
+// tableType returns the type of structs in the slice or array represented by
+// the value and true. Returns false if the value doesn't represent a slice or
+// an array of structs or pointers to structs.
+func tableType(val reflect.Value) (reflect.Type, bool) {
+	if !val.IsValid() {
+		return nil, false
+	}
+	if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
+		return nil, false
+	}
+	if val.Kind() == reflect.Slice && val.IsNil() {
+		return nil, false
+	}
+	typ := val.Type().Elem()
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	return typ, typ.Kind() == reflect.Struct
+}
+
+// tableRow returns the table cells for the given struct fields. All the cells
+// of the nil pointer are [ValNil].
+func (dmp Dump) tableRow(val reflect.Value, fields []int) []string {
+	if val.Kind() == reflect.Pointer {
+		if val.IsNil() {
+			row := make([]string, len(fields))
+			for i := range row {
+				row[i] = ValNil
+			}
+			return row
+		}
+		val = val.Elem()
+	}
+	if dmp.UnsafeUnexported {
+		val = addressable(val)
+	}
+
+	row := make([]string, 0, len(fields))
+	for _, idx := range fields {
+		if dmp.IsRedacted(val.Type().Field(idx)) {
+			row = append(row, ValRedacted)
+			continue
+		}
+		str, _ := dmp.value(1, dmp.field(val, idx))
+		row = append(row, str)
+	}
+	return row
+}
+
+// tableLine writes the table line with cells aligned to the column widths
+// and separated with the given separator. The last cell is not padded.
+func (dmp Dump) tableLine(prn Printer, row []string, widths []int, sep string) {
+	prn.Tab(dmp.Indent)
+	for col, str := range row {
+		if col > 0 {
+			prn.Write(sep)
+		}
+		prn.Write(str)
+		if col < len(row)-1 {
+			pad := widths[col] - utf8.RuneCountInString(str)
+			prn.Write(strings.Repeat(" ", pad))
+		}
+	}
+	prn.Write("\n")
+}
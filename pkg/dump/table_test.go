@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/internal/types"
+)
+
+func Test_Dump_Table(t *testing.T) {
+	t.Run("slice of structs", func(t *testing.T) {
+		// --- Given ---
+		rows := []types.TIntStr{{Int: 1, Str: "abc"}, {Int: 22, Str: "żółw"}}
+		dmp := New()
+
+		// --- When ---
+		have := dmp.Table(rows)
+
+		// --- Then ---
+		want := "Int | Str\n" +
+			"----+-------\n" +
+			"1   | \"abc\"\n" +
+			"22  | \"żółw\""
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("array of pointers to structs", func(t *testing.T) {
+		// --- Given ---
+		rows := [2]*types.TIntStr{{Int: 1, Str: "abc"}, nil}
+		dmp := New()
+
+		// --- When ---
+		have := dmp.Table(rows)
+
+		// --- Then ---
+		want := "Int | Str\n" +
+			"----+------\n" +
+			"1   | \"abc\"\n" +
+			"nil | nil"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("composite cells are flat", func(t *testing.T) {
+		// --- Given ---
+		type T struct {
+			Ints []int
+			Map  map[string]int
+		}
+		rows := []T{{Ints: []int{1, 2}, Map: map[string]int{"a": 1}}}
+		dmp := New()
+
+		// --- When ---
+		have := dmp.Table(rows)
+
+		// --- Then ---
+		want := "Ints        | Map\n" +
+			"------------+-----------------------\n" +
+			"[]int{1, 2} | map[string]int{\"a\": 1}"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// --- Given ---
+		dmp := New()
+
+		// --- When ---
+		have := dmp.Table([]types.TIntStr{})
+
+		// --- Then ---
+		affirm.Equal(t, "Int | Str\n----+----", have)
+	})
+
+	t.Run("redacted and private fields", func(t *testing.T) {
+		// --- Given ---
+		type T struct {
+			Name string
+			Pass string
+			prv  int
+		}
+		rows := []T{{Name: "bob", Pass: "secret", prv: 1}}
+		dmp := New(WithRedact("Pass"), WithNoPrivate)
+
+		// --- When ---
+		have := dmp.Table(rows)
+
+		// --- Then ---
+		want := "Name  | Pass\n" +
+			"------+-----------\n" +
+			"\"bob\" | <redacted>"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("truncated strings", func(t *testing.T) {
+		// --- Given ---
+		rows := []types.TIntStr{{Int: 1, Str: "abcdef"}}
+		dmp := New(WithMaxStringLen(3))
+
+		// --- When ---
+		have := dmp.Table(rows)
+
+		// --- Then ---
+		want := "Int | Str\n" +
+			"----+-----------------\n" +
+			"1   | \"abc\"…(+3 chars)"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("max items", func(t *testing.T) {
+		// --- Given ---
+		rows := []types.TIntStr{{Int: 1}, {Int: 2}, {Int: 3}}
+		dmp := New(WithMaxItems(1))
+
+		// --- When ---
+		have := dmp.Table(rows)
+
+		// --- Then ---
+		want := "Int | Str\n" +
+			"----+----\n" +
+			"1   | \"\"\n" +
+			"... (+2 more)"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("uses indent", func(t *testing.T) {
+		// --- Given ---
+		rows := []types.TIntStr{{Int: 1, Str: "a"}}
+		dmp := New(WithIndent(1))
+
+		// --- When ---
+		have := dmp.Table(rows)
+
+		// --- Then ---
+		want := "  Int | Str\n" +
+			"  ----+----\n" +
+			"  1   | \"a\""
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("not slice of structs", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithFlat)
+
+		// --- When ---
+		have := dmp.Table([]int{1, 2})
+
+		// --- Then ---
+		affirm.Equal(t, "[]int{1, 2}", have)
+	})
+
+	t.Run("nil slice", func(t *testing.T) {
+		// --- Given ---
+		dmp := New()
+
+		// --- When ---
+		have := dmp.Table([]types.TIntStr(nil))
+
+		// --- Then ---
+		affirm.Equal(t, ValNil, have)
+	})
+}
+
+func Test_tableType(t *testing.T) {
+	t.Run("slice of structs", func(t *testing.T) {
+		// --- When ---
+		have, ok := tableType(reflect.ValueOf([]types.TIntStr{}))
+
+		// --- Then ---
+		affirm.Equal(t, true, ok)
+		affirm.Equal(t, reflect.TypeOf(types.TIntStr{}), have)
+	})
+
+	t.Run("slice of pointers to structs", func(t *testing.T) {
+		// --- When ---
+		have, ok := tableType(reflect.ValueOf([]*types.TIntStr{}))
+
+		// --- Then ---
+		affirm.Equal(t, true, ok)
+		affirm.Equal(t, reflect.TypeOf(types.TIntStr{}), have)
+	})
+
+	t.Run("slice of not structs", func(t *testing.T) {
+		// --- When ---
+		_, ok := tableType(reflect.ValueOf([]int{}))
+
+		// --- Then ---
+		affirm.Equal(t, false, ok)
+	})
+
+	t.Run("not slice", func(t *testing.T) {
+		// --- When ---
+		_, ok := tableType(reflect.ValueOf(types.TIntStr{}))
+
+		// --- Then ---
+		affirm.Equal(t, false, ok)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		// --- When ---
+		_, ok := tableType(reflect.ValueOf(nil))
+
+		// --- Then ---
+		affirm.Equal(t, false, ok)
+	})
+}
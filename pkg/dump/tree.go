@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Node represents a value in the tree returned by [Dump.Tree].
+type Node struct {
+	// Struct field name, map key dumped in the flat format, or slice and
+	// array index. Empty for the root node.
+	Key string
+
+	// Type of the value. Empty for untyped nil values.
+	Type string
+
+	// Kind of the value.
+	Kind reflect.Kind
+
+	// Struct fields, map entries, or slice and array elements. Pointers and
+	// interfaces have children of the values they point to.
+	Children []Node
+
+	// Value dumped in the flat format.
+	Rendered string
+}
+
+// Tree returns the value as a tree of nodes, so tools like HTML reporters can
+// build their own presentation of it. Nodes are rendered in the flat format
+// using the [Dump] configuration, so the redaction, maximum depth, and the
+// maximum number of items are honored. Colors are not used. Values with
+// custom dumpers, stringers, errors, and contexts have no children.
+func (dmp Dump) Tree(val any) Node {
+	dmp.Flat = true
+	dmp.Color = false
+	dmp.Indent = 0
+	return dmp.node(0, "", reflect.ValueOf(val))
+}
+
+// node returns the tree node representing the value at the given level.
+func (dmp Dump) node(lvl int, key string, val reflect.Value) Node {
+	str, _ := dmp.value(lvl, val)
+	nod := Node{Key: key, Kind: val.Kind(), Rendered: str}
+	if !val.IsValid() {
+		return nod
+	}
+	nod.Type = val.Type().String()
+	if dmp.UseAny {
+		nod.Type = strings.ReplaceAll(nod.Type, "interface {}", "any")
+	}
+	if lvl >= dmp.MaxDepth {
+		return nod
+	}
+
+	for {
+		if dmp.treeLeaf(val) {
+			return nod
+		}
+		if val.Kind() != reflect.Interface && val.Kind() != reflect.Pointer {
+			break
+		}
+		if val.IsNil() {
+			return nod
+		}
+		if val.Kind() == reflect.Pointer {
+			vis := visit{ptr: val.Pointer(), typ: val.Type()}
+			if _, ok := dmp.visited[vis]; ok {
+				return nod
+			}
+			if dmp.visited == nil {
+				dmp.visited = make(map[visit]int)
+			}
+			dmp.visited[vis] = lvl
+			defer delete(dmp.visited, vis)
+		}
+		val = val.Elem()
+	}
+
+	switch val.Kind() {
+	case reflect.Struct:
+		nod.Children = dmp.structNodes(lvl, val)
+
+	case reflect.Map:
+		nod.Children = dmp.mapNodes(lvl, val)
+
+	case reflect.Slice, reflect.Array:
+		nod.Children = dmp.arrayNodes(lvl, val)
+	}
+	return nod
+}
+
+// treeLeaf returns true if the value is not dumped based on its kind, so its
+// node has no children.
+func (dmp Dump) treeLeaf(val reflect.Value) bool {
+	if _, ok := dmp.Dumpers[val.Type()]; ok || isContext(val) {
+		return true
+	}
+	if _, ok := dmp.stringer(val); ok {
+		return true
+	}
+	_, ok := errorValue(val)
+	return ok
+}
+
+// structNodes returns nodes representing the struct fields.
+func (dmp Dump) structNodes(lvl int, val reflect.Value) []Node {
+	if dmp.UnsafeUnexported {
+		val = addressable(val)
+	}
+	typ := val.Type()
+	nodes := make([]Node, 0, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		fld := typ.Field(i)
+		if !fld.IsExported() && !dmp.PrintPrivate {
+			continue
+		}
+		if dmp.IsRedacted(fld) {
+			nodes = append(nodes, Node{
+				Key:      fld.Name,
+				Type:     fld.Type.String(),
+				Kind:     fld.Type.Kind(),
+				Rendered: ValRedacted,
+			})
+			continue
+		}
+		nodes = append(nodes, dmp.node(lvl+1, fld.Name, dmp.field(val, i)))
+	}
+	return nodes
+}
+
+// mapNodes returns nodes representing the map entries.
+func (dmp Dump) mapNodes(lvl int, val reflect.Value) []Node {
+	keys := val.MapKeys()
+	dmp.sortKeys(keys)
+	cnt := dmp.maxItems(len(keys))
+	nodes := make([]Node, 0, cnt)
+	for _, key := range keys[:cnt] {
+		str, _ := dmp.value(lvl+1, key)
+		nodes = append(nodes, dmp.node(lvl+1, str, val.MapIndex(key)))
+	}
+	return nodes
+}
+
+// arrayNodes returns nodes representing the slice or array elements.
+func (dmp Dump) arrayNodes(lvl int, val reflect.Value) []Node {
+	cnt := dmp.maxItems(val.Len())
+	nodes := make([]Node, 0, cnt)
+	for i := 0; i < cnt; i++ {
+		nodes = append(nodes, dmp.node(lvl+1, strconv.Itoa(i), val.Index(i)))
+	}
+	return nodes
+}
@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/internal/types"
+)
+
+func Test_Dump_Tree(t *testing.T) {
+	t.Run("simple value", func(t *testing.T) {
+		// --- Given ---
+		dmp := New()
+
+		// --- When ---
+		have := dmp.Tree(42)
+
+		// --- Then ---
+		want := Node{Type: "int", Kind: reflect.Int, Rendered: "42"}
+		affirm.DeepEqual(t, want, have)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		// --- Given ---
+		dmp := New()
+
+		// --- When ---
+		have := dmp.Tree(nil)
+
+		// --- Then ---
+		affirm.DeepEqual(t, Node{Rendered: ValNil}, have)
+	})
+
+	t.Run("struct", func(t *testing.T) {
+		// --- Given ---
+		val := types.TIntStr{Int: 1, Str: "abc"}
+		dmp := New()
+
+		// --- When ---
+		have := dmp.Tree(val)
+
+		// --- Then ---
+		want := Node{
+			Type:     "types.TIntStr",
+			Kind:     reflect.Struct,
+			Rendered: `{Int: 1, Str: "abc"}`,
+			Children: []Node{
+				{Key: "Int", Type: "int", Kind: reflect.Int, Rendered: "1"},
+				{
+					Key:      "Str",
+					Type:     "string",
+					Kind:     reflect.String,
+					Rendered: `"abc"`,
+				},
+			},
+		}
+		affirm.DeepEqual(t, want, have)
+	})
+
+	t.Run("pointer to map of slices", func(t *testing.T) {
+		// --- Given ---
+		val := &map[string][]int{"a": {1}}
+		dmp := New()
+
+		// --- When ---
+		have := dmp.Tree(val)
+
+		// --- Then ---
+		want := Node{
+			Type:     "*map[string][]int",
+			Kind:     reflect.Pointer,
+			Rendered: `map[string][]int{"a": {1}}`,
+			Children: []Node{
+				{
+					Key:      `"a"`,
+					Type:     "[]int",
+					Kind:     reflect.Slice,
+					Rendered: "[]int{1}",
+					Children: []Node{{
+						Key:      "0",
+						Type:     "int",
+						Kind:     reflect.Int,
+						Rendered: "1",
+					}},
+				},
+			},
+		}
+		affirm.DeepEqual(t, want, have)
+	})
+
+	t.Run("interface", func(t *testing.T) {
+		// --- Given ---
+		val := []any{[]int{1}}
+		dmp := New()
+
+		// --- When ---
+		have := dmp.Tree(val)
+
+		// --- Then ---
+		affirm.Equal(t, 1, len(have.Children))
+		affirm.Equal(t, "any", have.Children[0].Type)
+		affirm.Equal(t, reflect.Interface, have.Children[0].Kind)
+		affirm.Equal(t, 1, len(have.Children[0].Children))
+		affirm.Equal(t, "1", have.Children[0].Children[0].Rendered)
+	})
+
+	t.Run("custom dumper has no children", func(t *testing.T) {
+		// --- Given ---
+		val := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+		dmp := New()
+
+		// --- When ---
+		have := dmp.Tree(val)
+
+		// --- Then ---
+		affirm.Equal(t, `"2000-01-02T03:04:05Z"`, have.Rendered)
+		affirm.Nil(t, have.Children)
+	})
+
+	t.Run("redacted and private fields", func(t *testing.T) {
+		// --- Given ---
+		type T struct {
+			Pass string
+			prv  int
+		}
+		val := T{Pass: "secret", prv: 1}
+		dmp := New(WithRedact("Pass"), WithNoPrivate)
+
+		// --- When ---
+		have := dmp.Tree(val)
+
+		// --- Then ---
+		want := []Node{
+			{
+				Key:      "Pass",
+				Type:     "string",
+				Kind:     reflect.String,
+				Rendered: ValRedacted,
+			},
+		}
+		affirm.DeepEqual(t, want, have.Children)
+	})
+
+	t.Run("max items", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithMaxItems(2))
+
+		// --- When ---
+		have := dmp.Tree([]int{1, 2, 3})
+
+		// --- Then ---
+		affirm.Equal(t, "[]int{1, 2, ... (+1 more)}", have.Rendered)
+		affirm.Equal(t, 2, len(have.Children))
+	})
+
+	t.Run("max depth", func(t *testing.T) {
+		// --- Given ---
+		val := [][]int{{1}}
+		dmp := New(WithMaxDepth(1))
+
+		// --- When ---
+		have := dmp.Tree(val)
+
+		// --- Then ---
+		affirm.Equal(t, 1, len(have.Children))
+		affirm.Nil(t, have.Children[0].Children)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		// --- Given ---
+		val := &types.TRec{Int: 1}
+		val.Rec = val
+		dmp := New()
+
+		// --- When ---
+		have := dmp.Tree(val)
+
+		// --- Then ---
+		affirm.Equal(t, 2, len(have.Children))
+		affirm.Equal(t, "<cycle to TRec@0>", have.Children[1].Rendered)
+		affirm.Nil(t, have.Children[1].Children)
+	})
+}
@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+// Package fixture provides helpers loading test fixtures from the testdata
+// directory.
+//
+// Fixture names are relative to the "testdata" directory next to the source
+// file of the caller, so helpers work the same regardless of the working
+// directory of the test.
+package fixture
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/ctx42/testing/pkg/notice"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// Dir is the name of the directory with fixtures.
+const Dir = "testdata"
+
+// Decoder represents a function decoding fixture data into the value pointed
+// to by "v".
+type Decoder func(data []byte, v any) error
+
+// Guards decoders registered for file extensions.
+var (
+	decodersMx sync.RWMutex
+	decoders   = map[string]Decoder{".json": json.Unmarshal}
+)
+
+// RegisterDecoder registers the decoder used by [Load] for fixtures with the
+// given file extensions. The extensions are case-insensitive and must start
+// with a dot. The JSON decoder is registered for the ".json" extension by
+// default. The package has no dependencies, so decoders for other formats,
+// like YAML, must be registered by the user.
+//
+// Example:
+//
+//	fixture.RegisterDecoder(yaml.Unmarshal, ".yaml", ".yml")
+func RegisterDecoder(fn Decoder, exts ...string) {
+	decodersMx.Lock()
+	defer decodersMx.Unlock()
+	for _, ext := range exts {
+		decoders[strings.ToLower(ext)] = fn
+	}
+}
+
+// Path returns the path to the fixture with the given name in the [Dir]
+// directory next to the source file of the caller. Absolute names are
+// returned as they are.
+func Path(t tester.T, name string) string {
+	t.Helper()
+	return path(name, 2)
+}
+
+// MustBytes returns the content of the fixture with the given name (see
+// [Path]). When the fixture cannot be read, it marks the test as failed,
+// writes an error message to the test log and stops the test execution.
+func MustBytes(t tester.T, name string) []byte {
+	t.Helper()
+	return read(t, path(name, 2))
+}
+
+// Load decodes the fixture with the given name (see [Path]) into the value
+// pointed to by "v". The decoder is selected based on the file extension, see
+// [RegisterDecoder]. When the fixture cannot be read or decoded, it marks the
+// test as failed, writes an error message with the location of the syntax
+// error, if known, to the test log and stops the test execution.
+//
+// Example:
+//
+//	var users []User
+//	fixture.Load(t, "users.json", &users)
+func Load(t tester.T, name string, v any) {
+	t.Helper()
+	pth := path(name, 2)
+	ext := strings.ToLower(filepath.Ext(pth))
+	decodersMx.RLock()
+	fn, ok := decoders[ext]
+	decodersMx.RUnlock()
+	if !ok {
+		t.Fatal(notice.New("unsupported fixture format").
+			Append("path", "%s", pth).
+			Append("extension", "%q", ext).
+			SetFooter("Use fixture.RegisterDecoder to register the decoder."))
+		return
+	}
+	data := read(t, pth)
+	if err := fn(data, v); err != nil {
+		t.Fatal(decodeError(pth, data, err))
+	}
+}
+
+// Copy copies the fixture with the given name (see [Path]) to the temporary
+// directory and returns the path to the copy. Tests can modify the copy
+// without changing the fixture. The temporary directory is removed when the
+// test and all its subtests complete. When the fixture cannot be read or the
+// copy cannot be written, it marks the test as failed, writes an error
+// message to the test log and stops the test execution.
+func Copy(t tester.T, name string) string {
+	t.Helper()
+	pth := path(name, 2)
+	data := read(t, pth)
+	dst := filepath.Join(t.TempDir(), filepath.Base(pth))
+	if err := os.WriteFile(dst, data, 0600); err != nil {
+		t.Fatal(notice.New("error copying fixture").
+			Append("path", "%s", pth).
+			Append("copy", "%s", dst).
+			Append("error", "%s", err).
+			Wrap(err))
+	}
+	return dst
+}
+
+// path returns the path to the fixture with the given name in the [Dir]
+// directory next to the source file of the function "skip" frames up the
+// stack. When the source file path is not absolute, for example, when tests
+// are built with the -trimpath flag, the path relative to the working
+// directory is returned.
+func path(name string, skip int) string {
+	if filepath.IsAbs(name) {
+		return name
+	}
+	_, file, _, ok := runtime.Caller(skip)
+	if !ok || !filepath.IsAbs(file) {
+		return filepath.Join(Dir, name)
+	}
+	return filepath.Join(filepath.Dir(file), Dir, name)
+}
+
+// read returns the content of the fixture file. When the file cannot be
+// read, it marks the test as failed, writes an error message to the test log
+// and stops the test execution.
+func read(t tester.T, pth string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(pth)
+	if err != nil {
+		t.Fatal(notice.New("error reading fixture").
+			Append("path", "%s", pth).
+			Append("error", "%s", err).
+			Wrap(err))
+	}
+	return data
+}
+
+// decodeError returns the error for the fixture which cannot be decoded. For
+// JSON syntax and type errors, the line and column of the error are added.
+func decodeError(pth string, data []byte, err error) error {
+	msg := notice.New("error decoding fixture").Append("path", "%s", pth)
+
+	var off int64
+	var synErr *json.SyntaxError
+	var typErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &synErr):
+		off = synErr.Offset
+	case errors.As(err, &typErr):
+		off = typErr.Offset
+	}
+	if off > 0 {
+		// The offset is just after the byte that caused the error.
+		line, col := position(data, off-1)
+		msg.Append("location", "line %d, column %d", line, col)
+	}
+	return msg.Append("error", "%s", err).Wrap(err)
+}
+
+// position returns the one-based line and column of the byte at the offset.
+func position(data []byte, off int64) (int, int) {
+	off = min(off, int64(len(data)))
+	line, col := 1, 1
+	for _, b := range data[:off] {
+		if b == '\n' {
+			line++
+			col = 1
+			continue
+		}
+		col++
+	}
+	return line, col
+}
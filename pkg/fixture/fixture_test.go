@@ -0,0 +1,286 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package fixture
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+type tUser struct {
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+// abs returns the absolute path to the file in the test data directory.
+func abs(t *testing.T, name string) string {
+	t.Helper()
+	pth, err := filepath.Abs(filepath.Join(Dir, name))
+	affirm.Nil(t, err)
+	return pth
+}
+
+func Test_RegisterDecoder(t *testing.T) {
+	// --- Given ---
+	t.Cleanup(func() {
+		decodersMx.Lock()
+		delete(decoders, ".txt")
+		delete(decoders, ".text")
+		decodersMx.Unlock()
+	})
+	fn := func(data []byte, v any) error {
+		*v.(*string) = string(data) // nolint: forcetypeassert
+		return nil
+	}
+
+	// --- When ---
+	RegisterDecoder(fn, ".TXT", ".text")
+
+	// --- Then ---
+	_, ok := decoders[".txt"]
+	affirm.Equal(t, true, ok)
+	_, ok = decoders[".text"]
+	affirm.Equal(t, true, ok)
+
+	tspy := tester.New(t).Close()
+	var have string
+	Load(tspy, "data.txt", &have)
+	affirm.Equal(t, "abc\n", have)
+}
+
+func Test_Path(t *testing.T) {
+	t.Run("relative to the caller", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := Path(tspy, "users.json")
+
+		// --- Then ---
+		affirm.Equal(t, abs(t, "users.json"), have)
+	})
+
+	t.Run("absolute", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		pth := filepath.Join(t.TempDir(), "users.json")
+
+		// --- When ---
+		have := Path(tspy, pth)
+
+		// --- Then ---
+		affirm.Equal(t, pth, have)
+	})
+}
+
+func Test_MustBytes(t *testing.T) {
+	t.Run("read", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := MustBytes(tspy, "data.txt")
+
+		// --- Then ---
+		affirm.Equal(t, "abc\n", string(have))
+	})
+
+	t.Run("error - does not exist", func(t *testing.T) {
+		// --- Given ---
+		pth := abs(t, "not_existing.txt")
+
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		wMsg := "error reading fixture:\n" +
+			"   path: " + pth + "\n" +
+			"  error: open " + pth + ": no such file or directory"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { MustBytes(tspy, "not_existing.txt") })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+}
+
+func Test_Load(t *testing.T) {
+	t.Run("JSON", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		var have []tUser
+
+		// --- When ---
+		Load(tspy, "users.json", &have)
+
+		// --- Then ---
+		affirm.Equal(t, 2, len(have))
+		affirm.Equal(t, tUser{Name: "bob", Age: 42}, have[0])
+		affirm.Equal(t, tUser{Name: "alice", Age: 35}, have[1])
+	})
+
+	t.Run("error - JSON syntax error", func(t *testing.T) {
+		// --- Given ---
+		pth := abs(t, "invalid.json")
+
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		wMsg := "error decoding fixture:\n" +
+			"      path: " + pth + "\n" +
+			"  location: line 3, column 20\n" +
+			"     error: invalid character '\"' after object key:value pair"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		var have []tUser
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { Load(tspy, "invalid.json", &have) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("error - JSON type error", func(t *testing.T) {
+		// --- Given ---
+		pth := abs(t, "type.json")
+
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		// The error message depends on the Go version.
+		tspy.ExpectLogContain("error decoding fixture:\n")
+		tspy.ExpectLogContain("      path: " + pth + "\n")
+		tspy.ExpectLogContain("  location: line 2, column 29\n")
+		tspy.Close()
+
+		var have []tUser
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { Load(tspy, "type.json", &have) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("error - unsupported format", func(t *testing.T) {
+		// --- Given ---
+		pth := abs(t, "data.txt")
+
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		wMsg := "unsupported fixture format:\n" +
+			"       path: " + pth + "\n" +
+			"  extension: \".txt\"\n" +
+			"\n" +
+			"  Use fixture.RegisterDecoder to register the decoder."
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		var have string
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { Load(tspy, "data.txt", &have) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("error - does not exist", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("error reading fixture:\n")
+		tspy.Close()
+
+		var have []tUser
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { Load(tspy, "not_existing.json", &have) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+}
+
+func Test_Copy(t *testing.T) {
+	t.Run("copy", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).ExpectTempDir(1).Close()
+
+		// --- When ---
+		have := Copy(tspy, "data.txt")
+
+		// --- Then ---
+		want := filepath.Join(tspy.GetTempDir(0), "data.txt")
+		affirm.Equal(t, want, have)
+		content, err := os.ReadFile(have)
+		affirm.Nil(t, err)
+		affirm.Equal(t, "abc\n", string(content))
+	})
+
+	t.Run("error - does not exist", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("error reading fixture:\n")
+		tspy.Close()
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { Copy(tspy, "not_existing.txt") })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+}
+
+func Test_decodeError(t *testing.T) {
+	t.Run("other error", func(t *testing.T) {
+		// --- Given ---
+		err := errors.New("test")
+
+		// --- When ---
+		have := decodeError("users.yaml", nil, err)
+
+		// --- Then ---
+		affirm.Equal(t, true, errors.Is(have, err))
+		wMsg := "error decoding fixture:\n" +
+			"   path: users.yaml\n" +
+			"  error: test"
+		affirm.Equal(t, wMsg, have.Error())
+	})
+}
+
+func Test_position_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		data string
+		off  int64
+		line int
+		col  int
+	}{
+		{"start", "abc", 0, 1, 1},
+		{"first line", "abc", 2, 1, 3},
+		{"second line", "a\nbc", 3, 2, 2},
+		{"after new line", "a\nbc", 2, 2, 1},
+		{"beyond data", "a\nb", 10, 2, 2},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			line, col := position([]byte(tc.data), tc.off)
+
+			// --- Then ---
+			affirm.Equal(t, tc.line, line)
+			affirm.Equal(t, tc.col, col)
+		})
+	}
+}
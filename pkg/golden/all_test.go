@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package golden
+
+import (
+	"flag"
+	"os"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	// Tests set the update flag explicitly, running them with the -update
+	// flag must not overwrite the package test data.
+	_ = flag.Set("update", "false")
+	os.Exit(m.Run())
+}
@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package golden
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/ctx42/testing/pkg/notice"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// Hexdump diff configuration.
+const (
+	rowSize     = 16 // Number of bytes in a hexdump row.
+	maxDiffRows = 8  // Maximum number of differing rows in the diff.
+)
+
+// AssertBytes asserts "have" is equal to the content of the binary golden
+// file at "pth". It's meant for binary artifacts like serialized messages,
+// images, or archives. On mismatch, instead of dumping all bytes, the offset
+// of the first differing byte and the hexdump diff of the first differing
+// 16-byte rows are written to the test log. Returns true if they are equal,
+// otherwise marks the test as failed, writes an error message to the test
+// log, calls the reporters set with [SetReporters] and returns false. When
+// the golden file cannot be read, it marks the test as failed, writes an
+// error message to the test log and stops the test execution.
+//
+// When the tests run with the -update flag, the golden file is written with
+// "have", creating missing directories, and the function returns true.
+func AssertBytes(t tester.T, have []byte, pth string) bool {
+	t.Helper()
+	if Updating() {
+		if err := write(pth, have); err != nil {
+			t.Fatal(err)
+			return false
+		}
+		return true
+	}
+
+	want, err := os.ReadFile(pth)
+	if err != nil {
+		t.Fatal(readError(pth, err))
+		return false
+	}
+	if err = compareBytes(want, have, pth); err != nil {
+		t.Error(err)
+		Report(t, Mismatch{Path: pth, Want: string(want), Have: string(have)})
+		return false
+	}
+	return true
+}
+
+// compareBytes compares the golden file content with "have". Returns nil if
+// they are equal, otherwise returns an error with the hexdump diff.
+func compareBytes(want, have []byte, pth string) error {
+	if bytes.Equal(want, have) {
+		return nil
+	}
+	off := firstDiff(want, have)
+	return notice.New("expected bytes to match the golden file").
+		Append("path", "%s", pth).
+		Append("want len", "%d", len(want)).
+		Append("have len", "%d", len(have)).
+		Append("offset", "%d (0x%08x)", off, off).
+		Append("diff", "%s", hexDiff(want, have)).
+		SetFooter("Run tests with the -update flag to update golden files.")
+}
+
+// firstDiff returns the offset of the first differing byte. When one of the
+// slices is the prefix of the other, it returns the length of the shorter.
+func firstDiff(want, have []byte) int {
+	n := min(len(want), len(have))
+	for i := 0; i < n; i++ {
+		if want[i] != have[i] {
+			return i
+		}
+	}
+	return n
+}
+
+// hexDiff returns the hexdump diff of the first [maxDiffRows] differing
+// rows. Differing rows are prefixed with "-" for "want" and "+" for "have",
+// and are surrounded with one equal row of context. Skipped rows are marked
+// with "...".
+func hexDiff(want, have []byte) string {
+	cnt := (max(len(want), len(have)) + rowSize - 1) / rowSize
+	var diffs []int
+	for r := 0; r < cnt; r++ {
+		if !bytes.Equal(row(want, r), row(have, r)) {
+			diffs = append(diffs, r)
+		}
+	}
+	more := max(len(diffs)-maxDiffRows, 0)
+	diffs = diffs[:len(diffs)-more]
+
+	// Rows to show: the differing rows with the equal context rows.
+	show := make(map[int]bool, 3*len(diffs))
+	for _, r := range diffs {
+		show[r] = true
+		for _, i := range []int{r - 1, r + 1} {
+			if i >= 0 && i < cnt && bytes.Equal(row(want, i), row(have, i)) {
+				show[i] = true
+			}
+		}
+	}
+
+	var lines []string
+	last := -1 // Last shown row.
+	for r := 0; r < cnt; r++ {
+		if !show[r] {
+			continue
+		}
+		if r > last+1 {
+			lines = append(lines, "...")
+		}
+		last = r
+		if !slices.Contains(diffs, r) {
+			lines = append(lines, hexLine(" ", r, row(want, r)))
+			continue
+		}
+		if w := row(want, r); len(w) > 0 {
+			lines = append(lines, hexLine("-", r, w))
+		}
+		if h := row(have, r); len(h) > 0 {
+			lines = append(lines, hexLine("+", r, h))
+		}
+	}
+	if more > 0 {
+		const format = "... and %d more differing rows"
+		lines = append(lines, fmt.Sprintf(format, more))
+	} else if last < cnt-1 {
+		lines = append(lines, "...")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// row returns bytes of the row with the given index. Returns nil if the row
+// doesn't exist.
+func row(data []byte, idx int) []byte {
+	start := idx * rowSize
+	if idx < 0 || start >= len(data) {
+		return nil
+	}
+	return data[start:min(start+rowSize, len(data))]
+}
+
+// hexLine returns the row in the classic hexdump format with offsets, hex
+// values, and ASCII characters, prefixed with the marker.
+func hexLine(marker string, idx int, data []byte) string {
+	buf := &strings.Builder{}
+	_, _ = fmt.Fprintf(buf, "%s%08x  ", marker, idx*rowSize)
+	for i := 0; i < rowSize; i++ {
+		if i == rowSize/2 {
+			buf.WriteByte(' ')
+		}
+		if i < len(data) {
+			_, _ = fmt.Fprintf(buf, "%02x ", data[i])
+		} else {
+			buf.WriteString("   ")
+		}
+	}
+	buf.WriteString(" |")
+	for _, b := range data {
+		if b < 32 || b > 126 {
+			b = '.'
+		}
+		buf.WriteByte(b)
+	}
+	buf.WriteString("|")
+	return buf.String()
+}
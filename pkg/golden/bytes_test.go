@@ -0,0 +1,255 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package golden
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// tBin returns bytes equal to the "testdata/case.bin" golden file content.
+func tBin() []byte {
+	data := make([]byte, 40)
+	for i := range data {
+		data[i] = byte('A' + i)
+	}
+	return data
+}
+
+func Test_AssertBytes(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := AssertBytes(tspy, tBin(), "testdata/case.bin")
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("error - not equal", func(t *testing.T) {
+		// --- Given ---
+		data := tBin()
+		data[20] = 0
+
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "expected bytes to match the golden file:\n" +
+			"      path: testdata/case.bin\n" +
+			"  want len: 40\n" +
+			"  have len: 40\n" +
+			"    offset: 20 (0x00000014)\n" +
+			"      diff:\n" +
+			"             00000000  41 42 43 44 45 46 47 48  " +
+			"49 4a 4b 4c 4d 4e 4f 50  |ABCDEFGHIJKLMNOP|\n" +
+			"            -00000010  51 52 53 54 55 56 57 58  " +
+			"59 5a 5b 5c 5d 5e 5f 60  |QRSTUVWXYZ[\\]^_`|\n" +
+			"            +00000010  51 52 53 54 00 56 57 58  " +
+			"59 5a 5b 5c 5d 5e 5f 60  |QRST.VWXYZ[\\]^_`|\n" +
+			"             00000020  61 62 63 64 65 66 67 68  " +
+			"                         |abcdefgh|\n" +
+			"\n" +
+			"  Run tests with the -update flag to update golden files."
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		// --- When ---
+		have := AssertBytes(tspy, data, "testdata/case.bin")
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("error - golden file does not exist", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("error reading golden file:\n")
+		tspy.Close()
+
+		// --- When ---
+		msg := affirm.Panic(t, func() {
+			AssertBytes(tspy, nil, "testdata/not_existing.bin")
+		})
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		// --- Given ---
+		setUpdate(t)
+		tspy := tester.New(t).Close()
+		pth := filepath.Join(t.TempDir(), "dir", "case.bin")
+
+		// --- When ---
+		have := AssertBytes(tspy, []byte{0, 1, 2}, pth)
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+		content, err := os.ReadFile(pth)
+		affirm.Nil(t, err)
+		affirm.Equal(t, true, bytes.Equal([]byte{0, 1, 2}, content))
+	})
+
+	t.Run("error - update cannot create directory", func(t *testing.T) {
+		// --- Given ---
+		setUpdate(t)
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("error creating golden file directory:\n")
+		tspy.Close()
+
+		file := filepath.Join(t.TempDir(), "file")
+		affirm.Nil(t, os.WriteFile(file, nil, 0600))
+		pth := filepath.Join(file, "case.bin")
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { AssertBytes(tspy, nil, pth) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+}
+
+func Test_compareBytes(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- When ---
+		err := compareBytes([]byte{1, 2}, []byte{1, 2}, "case.bin")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("have is shorter", func(t *testing.T) {
+		// --- When ---
+		err := compareBytes([]byte("abcd"), []byte("ab"), "case.bin")
+
+		// --- Then ---
+		wMsg := "expected bytes to match the golden file:\n" +
+			"      path: case.bin\n" +
+			"  want len: 4\n" +
+			"  have len: 2\n" +
+			"    offset: 2 (0x00000002)\n" +
+			"      diff:\n" +
+			"            -00000000  61 62 63 64                         " +
+			"              |abcd|\n" +
+			"            +00000000  61 62                               " +
+			"              |ab|\n" +
+			"\n" +
+			"  Run tests with the -update flag to update golden files."
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_firstDiff_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		want []byte
+		have []byte
+		exp  int
+	}{
+		{"both empty", nil, nil, 0},
+		{"first byte", []byte{1}, []byte{2}, 0},
+		{"middle byte", []byte{1, 2, 3}, []byte{1, 0, 3}, 1},
+		{"have is prefix", []byte{1, 2, 3}, []byte{1, 2}, 2},
+		{"want is prefix", []byte{1}, []byte{1, 2}, 1},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := firstDiff(tc.want, tc.have)
+
+			// --- Then ---
+			affirm.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_hexDiff(t *testing.T) {
+	t.Run("skipped rows", func(t *testing.T) {
+		// --- Given ---
+		want := make([]byte, 16*8)
+		have := bytes.Clone(want)
+		have[16*3] = 1
+
+		// --- When ---
+		str := hexDiff(want, have)
+
+		// --- Then ---
+		wStr := "...\n" +
+			" 00000020  00 00 00 00 00 00 00 00  " +
+			"00 00 00 00 00 00 00 00  |................|\n" +
+			"-00000030  00 00 00 00 00 00 00 00  " +
+			"00 00 00 00 00 00 00 00  |................|\n" +
+			"+00000030  01 00 00 00 00 00 00 00  " +
+			"00 00 00 00 00 00 00 00  |................|\n" +
+			" 00000040  00 00 00 00 00 00 00 00  " +
+			"00 00 00 00 00 00 00 00  |................|\n" +
+			"..."
+		affirm.Equal(t, wStr, str)
+	})
+
+	t.Run("adjacent differing rows share context", func(t *testing.T) {
+		// --- Given ---
+		want := make([]byte, 16*4)
+		have := bytes.Clone(want)
+		have[0] = 1
+		have[16*2] = 1
+
+		// --- When ---
+		str := hexDiff(want, have)
+
+		// --- Then ---
+		wStr := "" +
+			"-00000000  00 00 00 00 00 00 00 00  " +
+			"00 00 00 00 00 00 00 00  |................|\n" +
+			"+00000000  01 00 00 00 00 00 00 00  " +
+			"00 00 00 00 00 00 00 00  |................|\n" +
+			" 00000010  00 00 00 00 00 00 00 00  " +
+			"00 00 00 00 00 00 00 00  |................|\n" +
+			"-00000020  00 00 00 00 00 00 00 00  " +
+			"00 00 00 00 00 00 00 00  |................|\n" +
+			"+00000020  01 00 00 00 00 00 00 00  " +
+			"00 00 00 00 00 00 00 00  |................|\n" +
+			" 00000030  00 00 00 00 00 00 00 00  " +
+			"00 00 00 00 00 00 00 00  |................|"
+		affirm.Equal(t, wStr, str)
+	})
+
+	t.Run("limited number of differing rows", func(t *testing.T) {
+		// --- Given ---
+		want := make([]byte, 16*(maxDiffRows+3))
+		have := bytes.Repeat([]byte{1}, len(want))
+
+		// --- When ---
+		str := hexDiff(want, have)
+
+		// --- Then ---
+		lines := bytes.Count([]byte(str), []byte("\n")) + 1
+		affirm.Equal(t, 2*maxDiffRows+1, lines)
+		affirm.Equal(t, true, bytes.HasSuffix(
+			[]byte(str),
+			[]byte("\n... and 3 more differing rows"),
+		))
+	})
+
+	t.Run("have is longer", func(t *testing.T) {
+		// --- When ---
+		str := hexDiff(nil, []byte("abc"))
+
+		// --- Then ---
+		wStr := "+00000000  61 62 63                                      " +
+			"    |abc|"
+		affirm.Equal(t, wStr, str)
+	})
+}
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+// Package golden provides assertions comparing values with golden files.
+//
+// Golden files store the expected output of the code under test. When the
+// tests are run with the -update flag, the golden files are rewritten with
+// the actual values instead of being compared:
+//
+//	go test ./... -update
+//
+// When the -update flag is already defined by a package initialized before
+// this one, the existing flag is used instead of defining a new one.
+package golden
+
+import (
+	"errors"
+	"flag"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ctx42/testing/pkg/diff"
+	"github.com/ctx42/testing/pkg/notice"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// updateFlag is the name of the flag enabling golden files updates.
+const updateFlag = "update"
+
+func init() { defineUpdate(flag.CommandLine) }
+
+// Updating returns true when the tests run with the -update flag. Packages
+// storing expected values in files can use it to update them with the same
+// flag.
+func Updating() bool { return isTrue(flag.CommandLine.Lookup(updateFlag)) }
+
+// defineUpdate defines the -update flag in "set" unless a flag with the same
+// name is already defined there, in which case the existing flag is reused.
+// It prevents the "flag redefined" panic when a package imported before this
+// one defines its own -update flag.
+func defineUpdate(set *flag.FlagSet) {
+	if set.Lookup(updateFlag) == nil {
+		set.Bool(updateFlag, false, "update golden files")
+	}
+}
+
+// isTrue returns true when the flag is set to true. Returns false for nil
+// flags and flags which value is not a boolean true.
+func isTrue(f *flag.Flag) bool {
+	if f == nil {
+		return false
+	}
+	if get, ok := f.Value.(flag.Getter); ok {
+		if val, ok := get.Get().(bool); ok {
+			return val
+		}
+	}
+	return f.Value.String() == "true"
+}
+
+// Assert asserts "have" is equal to the content of the golden file at "pth".
+// Line endings are normalized to "\n" before comparison, so golden files
+// checked out with CRLF line endings still match. Returns true if they are
+// equal, otherwise marks the test as failed, writes an error message with the
+// unified diff to the test log, calls the reporters set with [SetReporters]
+// and returns false. When the golden file cannot be read, it marks the test
+// as failed, writes an error message to the test log and stops the test
+// execution.
+//
+// When the tests run with the -update flag, the golden file is written with
+// "have", creating missing directories, and the function returns true.
+func Assert(t tester.T, have, pth string) bool {
+	t.Helper()
+	if Updating() {
+		if err := write(pth, []byte(have)); err != nil {
+			t.Fatal(err)
+			return false
+		}
+		return true
+	}
+
+	want, err := os.ReadFile(pth)
+	if err != nil {
+		t.Fatal(readError(pth, err))
+		return false
+	}
+	if err = compare(string(want), have, pth); err != nil {
+		t.Error(err)
+		Report(t, Mismatch{Path: pth, Want: string(want), Have: have})
+		return false
+	}
+	return true
+}
+
+// compare compares the golden file content with "have". Returns nil if they
+// are equal, otherwise returns an error with the unified diff.
+func compare(want, have, pth string) error {
+	want = normalize(want)
+	have = normalize(have)
+	if want == have {
+		return nil
+	}
+	return notice.New("expected value to match the golden file").
+		Append("path", "%s", pth).
+		Append("diff", "%s", diff.Unified(want, have, 3)).
+		SetFooter("Run tests with the -update flag to update golden files.")
+}
+
+// normalize replaces CRLF line endings with LF.
+func normalize(str string) string {
+	return strings.ReplaceAll(str, "\r\n", "\n")
+}
+
+// write writes the golden file creating missing directories.
+func write(pth string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(pth), 0700); err != nil {
+		return notice.New("error creating golden file directory").
+			Append("path", "%s", pth).
+			Append("error", "%s", err).
+			Wrap(err)
+	}
+	if err := os.WriteFile(pth, data, 0600); err != nil {
+		return notice.New("error writing golden file").
+			Append("path", "%s", pth).
+			Append("error", "%s", err).
+			Wrap(err)
+	}
+	return nil
+}
+
+// readError returns the error for the golden file which cannot be read.
+func readError(pth string, err error) error {
+	msg := notice.New("error reading golden file").
+		Append("path", "%s", pth).
+		Append("error", "%s", err).
+		Wrap(err)
+	if errors.Is(err, fs.ErrNotExist) {
+		msg.SetFooter("Run tests with the -update flag to create golden files.")
+	}
+	return msg
+}
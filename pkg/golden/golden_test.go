@@ -0,0 +1,355 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package golden
+
+import (
+	"errors"
+	"flag"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// setUpdate sets the update flag for the duration of the test.
+func setUpdate(t *testing.T) {
+	t.Helper()
+	_ = flag.Set("update", "true")
+	t.Cleanup(func() { _ = flag.Set("update", "false") })
+}
+
+func Test_Updating(t *testing.T) {
+	t.Run("not updating", func(t *testing.T) {
+		// --- When ---
+		have := Updating()
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("updating", func(t *testing.T) {
+		// --- Given ---
+		setUpdate(t)
+
+		// --- When ---
+		have := Updating()
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+}
+
+func Test_defineUpdate(t *testing.T) {
+	t.Run("defines the flag", func(t *testing.T) {
+		// --- Given ---
+		set := flag.NewFlagSet("test", flag.ContinueOnError)
+
+		// --- When ---
+		defineUpdate(set)
+
+		// --- Then ---
+		affirm.NotNil(t, set.Lookup("update"))
+		affirm.Nil(t, set.Parse([]string{"-update"}))
+		affirm.Equal(t, true, isTrue(set.Lookup("update")))
+	})
+
+	t.Run("reuses the existing flag", func(t *testing.T) {
+		// --- Given ---
+		set := flag.NewFlagSet("test", flag.ContinueOnError)
+		set.Bool("update", false, "custom usage")
+
+		// --- When ---
+		defineUpdate(set)
+
+		// --- Then ---
+		affirm.Equal(t, "custom usage", set.Lookup("update").Usage)
+		affirm.Nil(t, set.Parse([]string{"-update"}))
+		affirm.Equal(t, true, isTrue(set.Lookup("update")))
+	})
+}
+
+func Test_isTrue(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		// --- When ---
+		have := isTrue(nil)
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("bool false", func(t *testing.T) {
+		// --- Given ---
+		set := flag.NewFlagSet("test", flag.ContinueOnError)
+		set.Bool("update", false, "")
+
+		// --- When ---
+		have := isTrue(set.Lookup("update"))
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("bool true", func(t *testing.T) {
+		// --- Given ---
+		set := flag.NewFlagSet("test", flag.ContinueOnError)
+		set.Bool("update", true, "")
+
+		// --- When ---
+		have := isTrue(set.Lookup("update"))
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("string true", func(t *testing.T) {
+		// --- Given ---
+		set := flag.NewFlagSet("test", flag.ContinueOnError)
+		set.String("update", "true", "")
+
+		// --- When ---
+		have := isTrue(set.Lookup("update"))
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("string other", func(t *testing.T) {
+		// --- Given ---
+		set := flag.NewFlagSet("test", flag.ContinueOnError)
+		set.String("update", "all", "")
+
+		// --- When ---
+		have := isTrue(set.Lookup("update"))
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+}
+
+func Test_Assert(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := Assert(tspy, "line 1\nline 2\nline 3\n", "testdata/case.golden")
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("golden file with CRLF line endings", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := Assert(tspy, "line 1\nline 2\nline 3\n", "testdata/crlf.golden")
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("value with CRLF line endings", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		val := "line 1\r\nline 2\r\nline 3\r\n"
+
+		// --- When ---
+		have := Assert(tspy, val, "testdata/case.golden")
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("error - not equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "expected value to match the golden file:\n" +
+			"  path: testdata/case.golden\n" +
+			"  diff:\n" +
+			"        @@ -1,3 +1,3 @@\n" +
+			"         line 1\n" +
+			"        -line 2\n" +
+			"        +line X\n" +
+			"         line 3\n" +
+			"\n" +
+			"  Run tests with the -update flag to update golden files."
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		// --- When ---
+		have := Assert(tspy, "line 1\nline X\nline 3\n", "testdata/case.golden")
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("error - golden file does not exist", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("error reading golden file:\n")
+		tspy.ExpectLogContain("  path: testdata/not_existing.golden\n")
+		tspy.ExpectLogContain("  Run tests with the -update flag to create")
+		tspy.Close()
+
+		// --- When ---
+		msg := affirm.Panic(t, func() {
+			Assert(tspy, "abc", "testdata/not_existing.golden")
+		})
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		// --- Given ---
+		setUpdate(t)
+		tspy := tester.New(t).Close()
+		pth := filepath.Join(t.TempDir(), "case.golden")
+
+		// --- When ---
+		have := Assert(tspy, "abc\n", pth)
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+		content, err := os.ReadFile(pth)
+		affirm.Nil(t, err)
+		affirm.Equal(t, "abc\n", string(content))
+	})
+
+	t.Run("update overwrites existing file", func(t *testing.T) {
+		// --- Given ---
+		setUpdate(t)
+		tspy := tester.New(t).Close()
+		pth := filepath.Join(t.TempDir(), "case.golden")
+		affirm.Nil(t, os.WriteFile(pth, []byte("old"), 0600))
+
+		// --- When ---
+		have := Assert(tspy, "new", pth)
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+		content, err := os.ReadFile(pth)
+		affirm.Nil(t, err)
+		affirm.Equal(t, "new", string(content))
+	})
+
+	t.Run("update creates directories", func(t *testing.T) {
+		// --- Given ---
+		setUpdate(t)
+		tspy := tester.New(t).Close()
+		pth := filepath.Join(t.TempDir(), "a", "b", "case.golden")
+
+		// --- When ---
+		have := Assert(tspy, "abc", pth)
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+		content, err := os.ReadFile(pth)
+		affirm.Nil(t, err)
+		affirm.Equal(t, "abc", string(content))
+	})
+
+	t.Run("error - update cannot create directory", func(t *testing.T) {
+		// --- Given ---
+		setUpdate(t)
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("error creating golden file directory:\n")
+		tspy.Close()
+
+		dir := t.TempDir()
+		file := filepath.Join(dir, "file")
+		affirm.Nil(t, os.WriteFile(file, nil, 0600))
+		pth := filepath.Join(file, "case.golden")
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { Assert(tspy, "abc", pth) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+}
+
+func Test_compare(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- When ---
+		err := compare("abc\n", "abc\n", "case.golden")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal after normalization", func(t *testing.T) {
+		// --- When ---
+		err := compare("a\r\nb\r\n", "a\nb\n", "case.golden")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		// --- When ---
+		err := compare("abc\n", "xyz\n", "case.golden")
+
+		// --- Then ---
+		wMsg := "expected value to match the golden file:\n" +
+			"  path: case.golden\n" +
+			"  diff:\n" +
+			"        @@ -1 +1 @@\n" +
+			"        -abc\n" +
+			"        +xyz\n" +
+			"\n" +
+			"  Run tests with the -update flag to update golden files."
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_normalize(t *testing.T) {
+	// --- When ---
+	have := normalize("a\r\nb\nc\r")
+
+	// --- Then ---
+	affirm.Equal(t, "a\nb\nc\r", have)
+}
+
+func Test_readError(t *testing.T) {
+	t.Run("not existing", func(t *testing.T) {
+		// --- Given ---
+		_, err := os.ReadFile("testdata/not_existing.golden")
+
+		// --- When ---
+		have := readError("testdata/not_existing.golden", err)
+
+		// --- Then ---
+		affirm.Equal(t, true, errors.Is(have, fs.ErrNotExist))
+		wMsg := "error reading golden file:\n" +
+			"   path: testdata/not_existing.golden\n" +
+			"  error: open testdata/not_existing.golden: " +
+			"no such file or directory\n" +
+			"\n" +
+			"  Run tests with the -update flag to create golden files."
+		affirm.Equal(t, wMsg, have.Error())
+	})
+
+	t.Run("other error", func(t *testing.T) {
+		// --- Given ---
+		err := errors.New("test")
+
+		// --- When ---
+		have := readError("case.golden", err)
+
+		// --- Then ---
+		affirm.Equal(t, true, errors.Is(have, err))
+		wMsg := "error reading golden file:\n" +
+			"   path: case.golden\n" +
+			"  error: test"
+		affirm.Equal(t, wMsg, have.Error())
+	})
+}
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package golden
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strconv"
+
+	"github.com/ctx42/testing/pkg/notice"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// Redacted is the value replacing redacted JSON values.
+const Redacted = "<redacted>"
+
+// AssertJSON asserts the JSON document "have" is equal to the JSON document
+// in the golden file at "pth". Both documents are canonicalized before
+// comparison: object keys are sorted, and the documents are indented with
+// two spaces, so the key order and whitespace don't matter. Values at paths
+// matching any of the "redact" patterns are replaced with the [Redacted]
+// string in both documents, so volatile fields like identifiers and
+// timestamps don't cause mismatches. Patterns use the [notice.Trail.Match]
+// format where object keys are fields and array elements are indexes, for
+// example, "id", "users[*].created_at" or "**.id". Returns true if they are
+// equal, otherwise marks the test as failed, writes an error message with
+// the diff of canonical documents to the test log, calls the reporters set
+// with [SetReporters] and returns false. When
+// the golden file cannot be read or is not a valid JSON document, it marks
+// the test as failed, writes an error message to the test log and stops the
+// test execution.
+//
+// When the tests run with the -update flag, the golden file is written with
+// the canonical and redacted "have" document, creating missing directories,
+// and the function returns true.
+//
+// Example:
+//
+//	golden.AssertJSON(t, body, "testdata/user.json", "id", "**.created_at")
+func AssertJSON(t tester.T, have, pth string, redact ...string) bool {
+	t.Helper()
+	hav, err := canonical(have, redact)
+	if err != nil {
+		t.Error(jsonError("have", pth, err))
+		return false
+	}
+
+	if Updating() {
+		if err = write(pth, []byte(hav)); err != nil {
+			t.Fatal(err)
+			return false
+		}
+		return true
+	}
+
+	data, err := os.ReadFile(pth)
+	if err != nil {
+		t.Fatal(readError(pth, err))
+		return false
+	}
+	want, err := canonical(string(data), redact)
+	if err != nil {
+		t.Fatal(jsonError("want", pth, err))
+		return false
+	}
+	if err = compare(want, hav, pth); err != nil {
+		t.Error(err)
+		Report(t, Mismatch{Path: pth, Want: want, Have: hav})
+		return false
+	}
+	return true
+}
+
+// canonical returns the JSON document with sorted object keys, indented with
+// two spaces and ending with a new line. Values at paths matching any of the
+// patterns are replaced with [Redacted]. Numbers are kept as they are in the
+// document.
+func canonical(doc string, patterns []string) (string, error) {
+	dec := json.NewDecoder(bytes.NewReader([]byte(doc)))
+	dec.UseNumber()
+	var val any
+	if err := dec.Decode(&val); err != nil {
+		return "", err
+	}
+	if len(patterns) > 0 {
+		val = redactJSON(nil, val, patterns)
+	}
+
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	// Error can't happen: the value was decoded from JSON.
+	_ = enc.Encode(val)
+	return buf.String(), nil
+}
+
+// redactJSON returns the decoded JSON value with values at paths matching any
+// of the patterns replaced with [Redacted]. The "trail" is the path to the
+// value.
+func redactJSON(trail notice.Trail, val any, patterns []string) any {
+	if len(trail) > 0 {
+		for _, pattern := range patterns {
+			if trail.Match(pattern) {
+				return Redacted
+			}
+		}
+	}
+	switch v := val.(type) {
+	case map[string]any:
+		for key, elem := range v {
+			seg := notice.Segment{Kind: notice.SegField, Name: key}
+			v[key] = redactJSON(append(trail, seg), elem, patterns)
+		}
+	case []any:
+		for i, elem := range v {
+			seg := notice.Segment{Kind: notice.SegIndex, Name: strconv.Itoa(i)}
+			v[i] = redactJSON(append(trail, seg), elem, patterns)
+		}
+	}
+	return val
+}
+
+// jsonError returns the error for the JSON document which cannot be decoded.
+// The "arg" is the name of the document.
+func jsonError(arg, pth string, err error) error {
+	return notice.New("did not expect the unmarshalling error").
+		Append("argument", "%s", arg).
+		Append("path", "%s", pth).
+		Append("error", "%s", err).
+		Wrap(err)
+}
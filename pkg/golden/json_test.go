@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package golden
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// tUserJSON is the document matching "testdata/user.json" golden file after
+// redaction.
+const tUserJSON = `{"tags":[{"name":"<go>","id":7}],"score":1.50,
+	"name":"bob","id":42,"created_at":"2025-01-02T03:04:05Z"}`
+
+func Test_AssertJSON(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := AssertJSON(
+			tspy,
+			tUserJSON,
+			"testdata/user.json",
+			"**.id",
+			"created_at",
+		)
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("error - not equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "expected value to match the golden file:\n" +
+			"  path: testdata/user.json\n" +
+			"  diff:\n" +
+			"        @@ -5,7 +5,7 @@\n" +
+			"           \"score\": 1.50,\n" +
+			"           \"tags\": [\n" +
+			"             {\n" +
+			"        -      \"id\": \"<redacted>\",\n" +
+			"        +      \"id\": 7,\n" +
+			"               \"name\": \"<go>\"\n" +
+			"             }\n" +
+			"           ]\n" +
+			"\n" +
+			"  Run tests with the -update flag to update golden files."
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+		pth := "testdata/user.json"
+
+		// --- When ---
+		have := AssertJSON(tspy, tUserJSON, pth, "id", "created_at")
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("error - invalid have", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "did not expect the unmarshalling error:\n" +
+			"  argument: have\n" +
+			"      path: testdata/user.json\n" +
+			"     error: unexpected EOF"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		// --- When ---
+		have := AssertJSON(tspy, "{", "testdata/user.json")
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("error - invalid golden file", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("  argument: want\n")
+		tspy.Close()
+
+		// --- When ---
+		msg := affirm.Panic(t, func() {
+			AssertJSON(tspy, "{}", "testdata/case.golden")
+		})
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("error - golden file does not exist", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("error reading golden file:\n")
+		tspy.Close()
+
+		// --- When ---
+		msg := affirm.Panic(t, func() {
+			AssertJSON(tspy, "{}", "testdata/not-existing.json")
+		})
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		// --- Given ---
+		setUpdate(t)
+		pth := filepath.Join(t.TempDir(), "dir", "user.json")
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := AssertJSON(tspy, `{"b":[1,2],"a":"x","id":1}`, pth, "id")
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+		content, err := os.ReadFile(pth)
+		affirm.Nil(t, err)
+		want := "{\n" +
+			"  \"a\": \"x\",\n" +
+			"  \"b\": [\n" +
+			"    1,\n" +
+			"    2\n" +
+			"  ],\n" +
+			"  \"id\": \"<redacted>\"\n" +
+			"}\n"
+		affirm.Equal(t, want, string(content))
+	})
+
+	t.Run("error - update with invalid have", func(t *testing.T) {
+		// --- Given ---
+		setUpdate(t)
+		pth := filepath.Join(t.TempDir(), "user.json")
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  argument: have\n")
+		tspy.Close()
+
+		// --- When ---
+		have := AssertJSON(tspy, "{", pth)
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+		_, err := os.Stat(pth)
+		affirm.NotNil(t, err)
+	})
+}
+
+func Test_canonical(t *testing.T) {
+	t.Run("sorts keys and indents", func(t *testing.T) {
+		// --- When ---
+		have, err := canonical(`{"b":{"d":1,"c":2},"a":[]}`, nil)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		want := "{\n" +
+			"  \"a\": [],\n" +
+			"  \"b\": {\n" +
+			"    \"c\": 2,\n" +
+			"    \"d\": 1\n" +
+			"  }\n" +
+			"}\n"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("numbers and HTML characters are kept", func(t *testing.T) {
+		// --- When ---
+		have, err := canonical(`["<&>", 1.50, 12345678901234567890]`, nil)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		want := "[\n" +
+			"  \"<&>\",\n" +
+			"  1.50,\n" +
+			"  12345678901234567890\n" +
+			"]\n"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("scalar document", func(t *testing.T) {
+		// --- When ---
+		have, err := canonical(`"abc"`, []string{"*"})
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, "\"abc\"\n", have)
+	})
+
+	t.Run("error - invalid document", func(t *testing.T) {
+		// --- When ---
+		have, err := canonical(`{"a":`, nil)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		affirm.Equal(t, "", have)
+	})
+}
+
+func Test_redactJSON_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		pattern string
+		want    string // The "R" is replaced with the redacted value.
+	}{
+		{"no match", "other", `{"a":{"id":1},"b":[{"id":2}],"id":3}`},
+		{"top level key", "id", `{"a":{"id":1},"b":[{"id":2}],"id":R}`},
+		{"nested key", "a.id", `{"a":{"id":R},"b":[{"id":2}],"id":3}`},
+		{"array element", "b[0]", `{"a":{"id":1},"b":[R],"id":3}`},
+		{"any element", "b[*].id", `{"a":{"id":1},"b":[{"id":R}],"id":3}`},
+		{"any depth", "**.id", `{"a":{"id":R},"b":[{"id":R}],"id":R}`},
+		{"whole object", "a", `{"a":R,"b":[{"id":2}],"id":3}`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			doc := `{"a":{"id":1},"b":[{"id":2}],"id":3}`
+
+			// --- When ---
+			have, err := canonical(doc, []string{tc.pattern})
+
+			// --- Then ---
+			affirm.Nil(t, err)
+			want := strings.ReplaceAll(tc.want, "R", `"<redacted>"`)
+			want, _ = canonical(want, nil)
+			affirm.Equal(t, want, have)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package golden
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+
+	"github.com/ctx42/testing/pkg/notice"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// Mismatch represents a value which doesn't match the approved value stored
+// in a golden or snapshot file.
+type Mismatch struct {
+	Path string // Path to the approved file.
+	Want string // The approved value.
+	Have string // The received value.
+}
+
+// Received returns the path to the file with the received value. It's the
+// approved file path with the ".received" inserted before the extension, for
+// example, "testdata/render.received.golden" for "testdata/render.golden".
+func (mis Mismatch) Received() string {
+	ext := filepath.Ext(mis.Path)
+	return strings.TrimSuffix(mis.Path, ext) + ".received" + ext
+}
+
+// Reporter represents a function called when a value doesn't match the
+// approved value.
+type Reporter func(t tester.T, mis Mismatch) error
+
+// reporters holds the reporters set with [SetReporters].
+var reporters atomic.Pointer[[]Reporter]
+
+// SetReporters sets the global reporters called, in order, when a value
+// doesn't match the golden file or the snapshot. They are called after the
+// mismatch is written to the test log, so they can mirror approval-testing
+// workflows: write the received value next to the approved one, open it in a
+// diff tool, and print the command approving it. Reporter errors are written
+// to the test log. Calling it without reporters removes them.
+//
+// Example:
+//
+//	golden.SetReporters(
+//	    golden.ReceivedFile,
+//	    golden.DiffTool("meld"),
+//	    golden.ApproveCommand,
+//	)
+func SetReporters(rps ...Reporter) {
+	if len(rps) == 0 {
+		reporters.Store(nil)
+		return
+	}
+	rps = append([]Reporter(nil), rps...)
+	reporters.Store(&rps)
+}
+
+// Report calls the reporters set with [SetReporters] for the mismatch.
+// Reporter errors mark the test as failed and are written to the test log.
+// Packages storing approved values in files use it to report mismatches
+// the same way golden files do.
+func Report(t tester.T, mis Mismatch) {
+	t.Helper()
+	rps := reporters.Load()
+	if rps == nil {
+		return
+	}
+	for _, rp := range *rps {
+		if err := rp(t, mis); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+// ReceivedFile is the [Reporter] writing the received value to the file
+// returned by [Mismatch.Received].
+func ReceivedFile(t tester.T, mis Mismatch) error {
+	t.Helper()
+	pth := mis.Received()
+	if err := os.WriteFile(pth, []byte(mis.Have), 0600); err != nil {
+		return notice.New("error writing received file").
+			Append("path", "%s", pth).
+			Append("error", "%s", err).
+			Wrap(err)
+	}
+	return nil
+}
+
+// DiffTool returns the [Reporter] writing the received file (see
+// [ReceivedFile]) and running the external diff tool with the "args" followed
+// by the received and approved file paths. The reporter waits for the tool
+// to exit.
+//
+// Example:
+//
+//	golden.SetReporters(golden.DiffTool("code", "--diff", "--wait"))
+func DiffTool(name string, args ...string) Reporter {
+	return func(t tester.T, mis Mismatch) error {
+		t.Helper()
+		if err := ReceivedFile(t, mis); err != nil {
+			return err
+		}
+		cmd := exec.Command(name, args...)
+		cmd.Args = append(cmd.Args, mis.Received(), mis.Path)
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			return notice.New("error running diff tool").
+				Append("command", "%s", strings.Join(cmd.Args, " ")).
+				Append("error", "%s", err).
+				AppendOptional("output", "%s", strings.TrimSpace(string(out))).
+				Wrap(err)
+		}
+		return nil
+	}
+}
+
+// ApproveCommand is the [Reporter] writing the command, which approves the
+// received value, to the test log. The command moves the received file over
+// the approved one, so it should be used with [ReceivedFile] or [DiffTool].
+func ApproveCommand(t tester.T, mis Mismatch) error {
+	t.Helper()
+	const format = "To approve the received value run:\n  mv %s %s"
+	t.Logf(format, mis.Received(), mis.Path)
+	return nil
+}
@@ -0,0 +1,275 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package golden
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// setReporters sets the reporters for the duration of the test.
+func setReporters(t *testing.T, rps ...Reporter) {
+	t.Helper()
+	SetReporters(rps...)
+	t.Cleanup(func() { SetReporters() })
+}
+
+func Test_Mismatch_Received_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		pth  string
+		want string
+	}{
+		{"with extension", "dir/case.golden", "dir/case.received.golden"},
+		{"without extension", "testdata/case", "testdata/case.received"},
+		{"many dots", "dir/case.v1.json", "dir/case.v1.received.json"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			mis := Mismatch{Path: tc.pth}
+
+			// --- When ---
+			have := mis.Received()
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_SetReporters(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { SetReporters() })
+
+		// --- When ---
+		SetReporters(ReceivedFile, ApproveCommand)
+
+		// --- Then ---
+		affirm.NotNil(t, reporters.Load())
+		affirm.Equal(t, 2, len(*reporters.Load()))
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		// --- Given ---
+		SetReporters(ReceivedFile)
+
+		// --- When ---
+		SetReporters()
+
+		// --- Then ---
+		affirm.Nil(t, reporters.Load())
+	})
+}
+
+func Test_Report(t *testing.T) {
+	t.Run("no reporters", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		Report(tspy, Mismatch{})
+
+		// --- Then ---
+		affirm.Nil(t, reporters.Load())
+	})
+
+	t.Run("reporters are called in order", func(t *testing.T) {
+		// --- Given ---
+		var calls []string
+		rp0 := func(_ tester.T, mis Mismatch) error {
+			calls = append(calls, "rp0 "+mis.Path)
+			return nil
+		}
+		rp1 := func(_ tester.T, mis Mismatch) error {
+			calls = append(calls, "rp1 "+mis.Path)
+			return nil
+		}
+		setReporters(t, rp0, rp1)
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		Report(tspy, Mismatch{Path: "case.golden"})
+
+		// --- Then ---
+		want := []string{"rp0 case.golden", "rp1 case.golden"}
+		affirm.DeepEqual(t, want, calls)
+	})
+
+	t.Run("error - reporter error", func(t *testing.T) {
+		// --- Given ---
+		var called bool
+		rp0 := func(tester.T, Mismatch) error { return errors.New("test") }
+		rp1 := func(tester.T, Mismatch) error { called = true; return nil }
+		setReporters(t, rp0, rp1)
+
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual("test")
+		tspy.Close()
+
+		// --- When ---
+		Report(tspy, Mismatch{Path: "case.golden"})
+
+		// --- Then ---
+		affirm.Equal(t, true, called)
+	})
+
+	t.Run("called by Assert on mismatch", func(t *testing.T) {
+		// --- Given ---
+		var have Mismatch
+		setReporters(t, func(_ tester.T, mis Mismatch) error {
+			have = mis
+			return nil
+		})
+
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		Assert(tspy, "abc\n", "testdata/case.golden")
+
+		// --- Then ---
+		want := Mismatch{
+			Path: "testdata/case.golden",
+			Want: "line 1\nline 2\nline 3\n",
+			Have: "abc\n",
+		}
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("called by AssertJSON on mismatch", func(t *testing.T) {
+		// --- Given ---
+		var have Mismatch
+		setReporters(t, func(_ tester.T, mis Mismatch) error {
+			have = mis
+			return nil
+		})
+
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		AssertJSON(tspy, `{"b":1}`, "testdata/user.json")
+
+		// --- Then ---
+		affirm.Equal(t, "testdata/user.json", have.Path)
+		affirm.Equal(t, "{\n  \"b\": 1\n}\n", have.Have)
+	})
+}
+
+func Test_ReceivedFile(t *testing.T) {
+	t.Run("write", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "case.golden")
+		mis := Mismatch{Path: pth, Want: "want", Have: "have"}
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		err := ReceivedFile(tspy, mis)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		content, err := os.ReadFile(mis.Received())
+		affirm.Nil(t, err)
+		affirm.Equal(t, "have", string(content))
+	})
+
+	t.Run("error - cannot write", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "dir", "case.golden")
+		mis := Mismatch{Path: pth}
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		err := ReceivedFile(tspy, mis)
+
+		// --- Then ---
+		affirm.Equal(t, true, errors.Is(err, os.ErrNotExist))
+		wMsg := "error writing received file:\n" +
+			"   path: " + mis.Received() + "\n" +
+			"  error: open " + mis.Received() + ": no such file or directory"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_DiffTool(t *testing.T) {
+	t.Run("run", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "case.golden")
+		affirm.Nil(t, os.WriteFile(pth, []byte("want\n"), 0600))
+		mis := Mismatch{Path: pth, Want: "want\n", Have: "have\n"}
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		err := DiffTool("sh", "-c", `cat "$0" "$1" > "$1.out"`)(tspy, mis)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		content, err := os.ReadFile(pth + ".out")
+		affirm.Nil(t, err)
+		affirm.Equal(t, "have\nwant\n", string(content))
+	})
+
+	t.Run("error - tool fails", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "case.golden")
+		mis := Mismatch{Path: pth}
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		err := DiffTool("sh", "-c", "echo failed; exit 1")(tspy, mis)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "error running diff tool:\n" +
+			"  command: sh -c echo failed; exit 1 " +
+			mis.Received() + " " + pth + "\n" +
+			"    error: exit status 1\n" +
+			"   output: failed"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - cannot write received file", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "dir", "case.golden")
+		mis := Mismatch{Path: pth}
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		err := DiffTool("true")(tspy, mis)
+
+		// --- Then ---
+		affirm.Equal(t, true, errors.Is(err, os.ErrNotExist))
+	})
+}
+
+func Test_ApproveCommand(t *testing.T) {
+	// --- Given ---
+	mis := Mismatch{Path: "testdata/case.golden"}
+
+	tspy := tester.New(t)
+	wMsg := "To approve the received value run:\n" +
+		"  mv testdata/case.received.golden testdata/case.golden"
+	tspy.ExpectLogEqual(wMsg)
+	tspy.Close()
+
+	// --- When ---
+	err := ApproveCommand(tspy, mis)
+
+	// --- Then ---
+	affirm.Nil(t, err)
+}
@@ -11,6 +11,8 @@ import (
 	"strings"
 	"unicode"
 	"unicode/utf8"
+
+	"github.com/ctx42/testing/pkg/dump"
 )
 
 // callStack returns an array of strings containing the file and line number
@@ -92,16 +94,22 @@ func formatMethod(method string, args, rets Arguments) string {
 }
 
 // formatArgs returns formated multi-line string representing arguments. Uses
-// internal [dump.Dump].
+// [dump.Dump] with the default configuration, including the globally
+// registered dumpers.
 func formatArgs(args Arguments) string {
 	if len(args) == 0 {
 		return ""
 	}
-	var out []string
+	dumper := dump.New()
+	var buf strings.Builder
 	for idx, arg := range args {
-		out = append(out, fmt.Sprintf("%d: %s", idx, dumper.Any(arg)))
+		if idx > 0 {
+			buf.WriteByte('\n')
+		}
+		_, _ = fmt.Fprintf(&buf, "%d: ", idx)
+		_ = dumper.Write(&buf, arg) // Writing to the builder never fails.
 	}
-	return strings.Join(out, "\n")
+	return buf.String()
 }
 
 // isTestName checks if the given name starts with the provided prefix (e.g.,
@@ -12,7 +12,6 @@ import (
 	"strings"
 	"sync"
 
-	"github.com/ctx42/testing/pkg/dump"
 	"github.com/ctx42/testing/pkg/notice"
 	"github.com/ctx42/testing/pkg/tester"
 )
@@ -50,9 +49,6 @@ const (
 	hNotFoundCall   = "[mock] method call not found"
 )
 
-// dumper represents default value dumper.
-var dumper = dump.New()
-
 // Option represents a [NewMock] option.
 type Option func(*Mock)
 
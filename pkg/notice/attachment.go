@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package notice
+
+import (
+	"slices"
+)
+
+// Attachment represents a named payload attached to the [Notice], for
+// example, a response body or golden file contents.
+type Attachment struct {
+	Name string // Attachment name, for example, a file name.
+	Data []byte // Attachment payload.
+}
+
+// Attach attaches the full payload to the notice under the given name. The
+// attachments are not rendered by [Notice.Error], so checkers can provide
+// payloads too big to be inlined in the message. If the attachment with the
+// same name already exists, its data is replaced. Use [AttachmentsFrom] to
+// get them back. Implements fluent interface.
+func (msg *Notice) Attach(name string, data []byte) *Notice {
+	fn := func(att Attachment) bool { return att.Name == name }
+	if idx := slices.IndexFunc(msg.Attachments, fn); idx >= 0 {
+		msg.Attachments[idx].Data = data
+		return msg
+	}
+	msg.Attachments = append(msg.Attachments, Attachment{name, data})
+	return msg
+}
+
+// AttachmentsFrom returns attachments of all notices in the error. Notices
+// are searched the same way as for [ToJSON], and the attachments are returned
+// in the notice order. Returns nil if there are no attachments.
+func AttachmentsFrom(err error) []Attachment {
+	var atts []Attachment
+	for _, msg := range appendNotices(nil, err) {
+		atts = append(atts, msg.Attachments...)
+	}
+	return atts
+}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package notice
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/internal/core"
+)
+
+func Test_Notice_Attach(t *testing.T) {
+	t.Run("attach", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").Want("%d", 1)
+
+		// --- When ---
+		have := msg.Attach("body.json", []byte(`{}`))
+
+		// --- Then ---
+		affirm.Equal(t, true, core.Same(msg, have))
+		want := []Attachment{{Name: "body.json", Data: []byte(`{}`)}}
+		affirm.DeepEqual(t, want, msg.Attachments)
+		affirm.Equal(t, "header:\n  want: 1", msg.Error())
+	})
+
+	t.Run("attach an existing name replaces data", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").
+			Attach("a", []byte("1")).
+			Attach("b", []byte("2"))
+
+		// --- When ---
+		_ = msg.Attach("a", []byte("3"))
+
+		// --- Then ---
+		want := []Attachment{
+			{Name: "a", Data: []byte("3")},
+			{Name: "b", Data: []byte("2")},
+		}
+		affirm.DeepEqual(t, want, msg.Attachments)
+	})
+}
+
+func Test_AttachmentsFrom(t *testing.T) {
+	t.Run("nil error", func(t *testing.T) {
+		// --- When ---
+		have := AttachmentsFrom(nil)
+
+		// --- Then ---
+		affirm.Nil(t, have)
+	})
+
+	t.Run("not notice", func(t *testing.T) {
+		// --- When ---
+		have := AttachmentsFrom(errors.New("my error"))
+
+		// --- Then ---
+		affirm.Nil(t, have)
+	})
+
+	t.Run("notice", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").Attach("a", []byte("1"))
+
+		// --- When ---
+		have := AttachmentsFrom(msg)
+
+		// --- Then ---
+		want := []Attachment{{Name: "a", Data: []byte("1")}}
+		affirm.DeepEqual(t, want, have)
+	})
+
+	t.Run("joined", func(t *testing.T) {
+		// --- Given ---
+		msg0 := New("header0").Attach("a", []byte("1"))
+		msg1 := New("header1")
+		msg2 := New("header2").Attach("b", []byte("2"))
+		err := errors.Join(Join(msg0, msg1), msg2)
+
+		// --- When ---
+		have := AttachmentsFrom(err)
+
+		// --- Then ---
+		want := []Attachment{
+			{Name: "a", Data: []byte("1")},
+			{Name: "b", Data: []byte("2")},
+		}
+		affirm.DeepEqual(t, want, have)
+	})
+}
@@ -4,6 +4,9 @@
 package notice
 
 import (
+	"fmt"
+	"maps"
+	"slices"
 	"strings"
 )
 
@@ -124,3 +127,77 @@ func SortNotices(head *Notice, cmp func(a, b *Notice) int) *Notice {
 
 	return teil
 }
+
+// Dedup collapses identical notices in the chain, for example, produced when
+// comparing a slice of identical wrong structs. Notices are identical when
+// they have the same header, footer, rows, metadata, code, base error and
+// cause, only their trails may differ. A copy of the first of the identical
+// notices is kept, and the "repeated" row with the number of collapsed notices
+// and the "trails" row with their trails are added to it. The input chain is
+// not modified. Returns the last notice in the new chain, so it can be used
+// the same way as the error returned by [Join]. Errors which are not
+// instances of [Notice] are returned unchanged.
+func Dedup(err error) error {
+	msg, ok := err.(*Notice) // nolint: errorlint
+	if !ok || msg == nil {
+		return err
+	}
+
+	var keys []string
+	groups := make(map[string][]*Notice)
+	for _, m := range msg.collect() {
+		key := m.dedupKey()
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], m)
+	}
+
+	var last *Notice
+	for _, key := range keys {
+		group := groups[key]
+		m := group[0].clone()
+		if len(group) > 1 {
+			trails := make([]string, 0, len(group))
+			for _, g := range group {
+				if g.Trail != "" {
+					trails = append(trails, g.Trail)
+				}
+			}
+			m.Trail = ""
+			_ = m.Append("repeated", "%d times", len(group))
+			if len(trails) > 0 {
+				_ = m.Append("trails", "%s", strings.Join(trails, "\n"))
+			}
+		}
+		if last != nil {
+			m = m.Chain(last)
+		}
+		last = m
+	}
+	return last
+}
+
+// dedupKey returns the key identifying notices which differ only in trails.
+func (msg *Notice) dedupKey() string {
+	buf := &strings.Builder{}
+	buf.WriteString(msg.Header)
+	_, _ = fmt.Fprintf(buf, "\x00%s\x00%s", msg.Footer, msg.code)
+	_, _ = fmt.Fprintf(buf, "\x00%T\x00%v", msg.err, msg.err)
+	_, _ = fmt.Fprintf(buf, "\x00%T\x00%v", msg.cause, msg.cause)
+	_, _ = fmt.Fprintf(buf, "\x00%v", msg.Meta) // Map keys are sorted.
+	for _, row := range msg.Rows {
+		_, _ = fmt.Fprintf(buf, "\x00%s\x00%s", row.Name, row.String())
+	}
+	return buf.String()
+}
+
+// clone returns a copy of the notice which is not linked to any chain.
+func (msg *Notice) clone() *Notice {
+	cpy := *msg
+	cpy.Rows = slices.Clone(msg.Rows)
+	cpy.Meta = maps.Clone(msg.Meta)
+	cpy.Attachments = slices.Clone(msg.Attachments)
+	cpy.prev, cpy.next = nil, nil
+	return &cpy
+}
@@ -4,6 +4,7 @@
 package notice
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/ctx42/testing/internal/affirm"
@@ -288,3 +289,139 @@ func Test_SortNotices(t *testing.T) {
 		affirm.Equal(t, "| hdrA (A) -> hdr2 () -> hdr0 ()", REV(have))
 	})
 }
+
+func Test_Dedup(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		// --- When ---
+		have := Dedup(nil)
+
+		// --- Then ---
+		affirm.Nil(t, have)
+	})
+
+	t.Run("not notice", func(t *testing.T) {
+		// --- Given ---
+		err := errors.New("test")
+
+		// --- When ---
+		have := Dedup(err)
+
+		// --- Then ---
+		affirm.Equal(t, true, core.Same(err, have))
+	})
+
+	t.Run("identical notices", func(t *testing.T) {
+		// --- Given ---
+		msg0 := New("header").SetTrail("T[0].Name").Want("%s", "a")
+		msg1 := New("header").SetTrail("T[1].Name").Want("%s", "a")
+		msg2 := New("other").SetTrail("T[1].Age").Want("%d", 1)
+		msg3 := New("header").SetTrail("T[2].Name").Want("%s", "a")
+		err := Join(msg0, msg1, msg2, msg3)
+
+		// --- When ---
+		have := Dedup(err)
+
+		// --- Then ---
+		affirm.Equal(t, false, core.Same(msg2, have))
+		wFWD := "| header () -> other (T[1].Age)"
+		affirm.Equal(t, wFWD, FWD(From(have).Head()))
+		wMsg := "multiple expectations violated:\n" +
+			"     error: header\n" +
+			"      want: a\n" +
+			"  repeated: 3 times\n" +
+			"    trails:\n" +
+			"            T[0].Name\n" +
+			"            T[1].Name\n" +
+			"            T[2].Name\n" +
+			"         ---\n" +
+			"     error: other\n" +
+			"     trail: T[1].Age\n" +
+			"      want: 1"
+		affirm.Equal(t, wMsg, have.Error())
+	})
+
+	t.Run("different rows", func(t *testing.T) {
+		// --- Given ---
+		msg0 := New("header").Want("%s", "a")
+		msg1 := New("header").Want("%s", "b")
+		err := Join(msg0, msg1)
+
+		// --- When ---
+		have := Dedup(err)
+
+		// --- Then ---
+		affirm.Equal(t, false, core.Same(msg1, have))
+		affirm.Equal(t, "| header () -> header ()", FWD(From(have).Head()))
+	})
+
+	t.Run("input chain is not modified", func(t *testing.T) {
+		// --- Given ---
+		msg0 := New("header").SetTrail("T[0].Name").Want("%s", "a")
+		msg1 := New("header").SetTrail("T[1].Name").Want("%s", "a")
+		err := Join(msg0, msg1)
+		want := err.Error()
+
+		// --- When ---
+		have := Dedup(err)
+
+		// --- Then ---
+		affirm.Equal(t, want, err.Error())
+		affirm.Equal(t, "T[0].Name", msg0.Trail)
+		affirm.Equal(t, 1, len(msg0.Rows))
+		affirm.Equal(t, true, core.Same(msg1, msg0.Next()))
+		affirm.Nil(t, From(have).Prev())
+	})
+
+	t.Run("different footers", func(t *testing.T) {
+		// --- Given ---
+		msg0 := New("header").Want("%s", "a").SetFooter("f0")
+		msg1 := New("header").Want("%s", "a").SetFooter("f1")
+
+		// --- When ---
+		have := Dedup(Join(msg0, msg1))
+
+		// --- Then ---
+		affirm.Equal(t, "| header () -> header ()", FWD(From(have).Head()))
+	})
+
+	t.Run("different codes", func(t *testing.T) {
+		// --- Given ---
+		msg0 := New("header").Want("%s", "a").Code("c0")
+		msg1 := New("header").Want("%s", "a").Code("c1")
+
+		// --- When ---
+		have := Dedup(Join(msg0, msg1))
+
+		// --- Then ---
+		affirm.Equal(t, "| header () -> header ()", FWD(From(have).Head()))
+		affirm.Equal(t, "c0", CodeFrom(From(have).Head()))
+	})
+
+	t.Run("different causes", func(t *testing.T) {
+		// --- Given ---
+		cause0 := errors.New("c0")
+		cause1 := errors.New("c1")
+		msg0 := New("header").Want("%s", "a").Cause(cause0)
+		msg1 := New("header").Want("%s", "a").Cause(cause1)
+
+		// --- When ---
+		have := Dedup(Join(msg0, msg1))
+
+		// --- Then ---
+		affirm.Equal(t, "| header () -> header ()", FWD(From(have).Head()))
+		affirm.Equal(t, true, errors.Is(From(have).Head(), cause0))
+		affirm.Equal(t, true, errors.Is(have, cause1))
+	})
+
+	t.Run("different meta", func(t *testing.T) {
+		// --- Given ---
+		msg0 := New("header").Want("%s", "a").MetaSet("key", 0)
+		msg1 := New("header").Want("%s", "a").MetaSet("key", 1)
+
+		// --- When ---
+		have := Dedup(Join(msg0, msg1))
+
+		// --- Then ---
+		affirm.Equal(t, "| header () -> header ()", FWD(From(have).Head()))
+	})
+}
@@ -5,20 +5,124 @@
 package notice
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"slices"
 	"strings"
+	"sync/atomic"
+
+	"github.com/ctx42/testing/internal/core"
 )
 
 const (
 	// trail represents the header name containing the field trail (path) name.
 	trail = "trail"
 
+	// source represents the row name containing the location of the notice.
+	source = "source"
+
 	// multiHeader is a header for multiple notices.
 	multiHeader = "multiple expectations violated"
 )
 
+// ANSI escape codes used when rendering notices with colors.
+const (
+	colorReset = "\x1b[0m"  // Resets the color.
+	colorRed   = "\x1b[31m" // Color of headers and "have" rows.
+	colorGreen = "\x1b[32m" // Color of "want" rows.
+	colorDim   = "\x1b[2m"  // Color of trails.
+)
+
+// color is set when notices are rendered with colors. See [EnableColor].
+var color atomic.Bool
+
+// EnableColor turns on colorizing notices rendered by [Notice.Error] using
+// ANSI escape codes: headers and "have" rows are red, "want" rows are green,
+// and trails are dimmed. The colors are used only when the standard output is
+// a terminal and the NO_COLOR environment variable is not set.
+func EnableColor() { color.Store(core.ColorSupported()) }
+
+// DisableColor turns off colorizing notices. See [EnableColor].
+func DisableColor() { color.Store(false) }
+
+// colorize wraps the string in the ANSI color escape codes when colors are
+// turned on with [EnableColor].
+func colorize(code, str string) string {
+	if !color.Load() || str == "" {
+		return str
+	}
+	return code + str + colorReset
+}
+
+// maxRows is the default maximum number of rows rendered by [Notice.Error].
+// See [WithMaxRows].
+var maxRows atomic.Int64
+
+// WithMaxRows sets the default maximum number of rows rendered by
+// [Notice.Error] for all notices in the chain. The rows above the limit are
+// cut off and replaced with the "… N more rows" line, which also counts the
+// notices with cut off rows when there is more than one. Headers, footers,
+// stacks and sources of all notices are still rendered. It keeps CI logs
+// manageable for enormous failure messages. Zero or negative value turns off
+// the limit (default). The limit set with [Notice.Truncate] takes precedence.
+func WithMaxRows(n int) { maxRows.Store(int64(max(n, 0))) }
+
+// maxPad is the maximum length row names are padded to. See [WithMaxPad].
+var maxPad atomic.Int64
+
+// WithMaxPad sets the maximum length the row names are padded to by
+// [Notice.Error]. By default, all row names are padded to the length of the
+// longest one, so their colons are aligned. With the limit set, the names are
+// aligned to the longest one but not longer than the limit, and the longer
+// names are not padded. It keeps the common rows, like "want" and "have",
+// readable when a notice has a very long custom row name. Zero or negative
+// value turns off the limit (default).
+func WithMaxPad(n int) { maxPad.Store(int64(max(n, 0))) }
+
+// withStack is set when notices record the call stack. See [WithStack].
+var withStack atomic.Bool
+
+// WithStack turns on recording the call stack when notices are created with
+// [New] and rendering it by [Notice.Error] as the final section. It's useful
+// when a failing assertion lives deep inside shared test helpers. Frames of
+// the Go runtime, the "testing" package, and non-test files of this module are
+// excluded from the stack.
+func WithStack() { withStack.Store(true) }
+
+// withSource is set when notices record their source. See [WithSource].
+var withSource atomic.Bool
+
+// WithSource turns on recording the file and line of the code which caused
+// the notice, when it's created with [New]. The frames in non-test files of
+// this module, for example, in "check" and "assert" packages, are skipped, so
+// it's usually the location of the assertion in the test. The location is
+// rendered by [Notice.Error] as the last row named "source", so failures
+// copied out of CI logs can be located without the testing framework prefix.
+func WithSource() { withSource.Store(true) }
+
+// rowColors maps row names to their colors.
+var rowColors = map[string]string{
+	trail:  colorDim,
+	"want": colorGreen,
+	"have": colorRed,
+}
+
+// Canonical order of rows used by [Notice.SortRows]. The rows named in
+// rowsFirst are moved to the beginning and the rows named in rowsLast to the
+// end of the notice.
+var (
+	rowsFirst = []string{"want", "have"}
+	rowsLast  = []string{"types", "hint"}
+)
+
+// Metadata keys used by checkers to attach the raw values they compared, so
+// downstream tools don't have to parse the rendered rows.
+const (
+	MetaWant = "want" // The raw expected value.
+	MetaHave = "have" // The raw actual value.
+)
+
 // ErrNotice is a sentinel error automatically wrapped by all instances of
 // [Notice] unless changed with the [Notice.Wrap] method.
 var ErrNotice = errors.New("notice error")
@@ -33,11 +137,26 @@ type Notice struct {
 	// Is a trail to the field, element or key the notice message is about.
 	Trail string
 
+	// Footer message rendered after all rows.
+	Footer string
+
 	Rows []Row          // Context rows.
 	Meta map[string]any // Useful metadata.
-	err  error          // Base error (default: [ErrNotice]).
-	prev *Notice        // Next message in the chain.
-	next *Notice        // Previous message in the chain.
+
+	// Payloads attached to the notice, not rendered by [Notice.Error].
+	Attachments []Attachment
+
+	err   error   // Base error (default: [ErrNotice]).
+	cause error   // The cause of the notice (default: nil).
+	limit int     // Maximum number of rendered rows (default: 0).
+	stack string  // Call stack recorded by [New] (default: "").
+	src   string  // Source location recorded by [New] (default: "").
+	code  string  // Stable identifier of the failure kind (default: "").
+	prev  *Notice // Next message in the chain.
+	next  *Notice // Previous message in the chain.
+
+	// Level of details used by [Notice.Error] (default: [Normal]).
+	verbosity Verbosity
 }
 
 // New creates a new [Notice] with a header formatted using [fmt.Sprintf] from
@@ -51,13 +170,21 @@ type Notice struct {
 //	n := New("generic error")      // Header: "generic error"
 func New(header string, args ...any) *Notice {
 	msg := &Notice{err: ErrNotice}
+	if withStack.Load() {
+		msg.stack = callStack(3)
+	}
+	if withSource.Load() {
+		msg.src = callSource(3)
+	}
 	return msg.SetHeader(header, args...)
 }
 
 // From returns instance of [Notice] if it is in err's tree. If the prefix is
 // not empty, the header will be prefixed with the first element in the slice.
 // If "err" is not an instance of [Notice], it will create a new one and wrap
-// the "err".
+// the "err". The wrapped error is returned by [Notice.Unwrap], so [errors.Is]
+// and [errors.As] find it, and the returned [Notice] can be enriched with a
+// trail and rows without losing the identity of the original error.
 func From(err error, prefix ...string) *Notice {
 	if err == nil {
 		return nil
@@ -85,6 +212,17 @@ func (msg *Notice) SetHeader(header string, args ...any) *Notice {
 	return msg
 }
 
+// SetFooter sets the footer message rendered after all rows, for example, a
+// hint how to fix the failure or a link to the documentation. If no arguments
+// are provided, the format string is used as-is.
+func (msg *Notice) SetFooter(format string, args ...any) *Notice {
+	if len(args) > 0 {
+		format = fmt.Sprintf(format, args...)
+	}
+	msg.Footer = format
+	return msg
+}
+
 // Append appends a new row with the specified name and value build using
 // [fmt.Sprintf] from format and args. Implements fluent interface.
 func (msg *Notice) Append(name, format string, args ...any) *Notice {
@@ -98,14 +236,37 @@ func (msg *Notice) Append(name, format string, args ...any) *Notice {
 	return msg
 }
 
-// AppendRow appends description rows to the message.
+// AppendRow appends description rows to the message. If a row with the same
+// name already exists, it will be replaced.
 func (msg *Notice) AppendRow(desc ...Row) *Notice {
 	for _, row := range desc {
-		_ = msg.Append(row.Name, row.Format, row.Args...)
+		fn := func(r Row) bool { return r.Name == row.Name }
+		if idx := slices.IndexFunc(msg.Rows, fn); idx >= 0 {
+			msg.Rows[idx] = row
+			continue
+		}
+		msg.Rows = append(msg.Rows, row)
 	}
 	return msg
 }
 
+// AppendOptional works like [Notice.Append] but the row is not rendered when
+// its value is an empty string. Implements fluent interface.
+func (msg *Notice) AppendOptional(name, format string, args ...any) *Notice {
+	row := NewRow(name, format, args...)
+	row.Optional = true
+	return msg.AppendRow(row)
+}
+
+// AppendLazy works like [Notice.Append] but the row value is computed by the
+// "fn" function only when the notice is rendered, and it's computed only
+// once. Use it for expensive values, like large dumps or diffs, of notices
+// which may be inspected and discarded without rendering. Implements fluent
+// interface.
+func (msg *Notice) AppendLazy(name string, fn func() string) *Notice {
+	return msg.Append(name, "%s", Lazy(fn))
+}
+
 // Prepend prepends a new row with the specified name and value built using
 // [fmt.Sprintf] from format and args. Implements fluent interface.
 func (msg *Notice) Prepend(name, format string, args ...any) *Notice {
@@ -119,6 +280,36 @@ func (msg *Notice) Prepend(name, format string, args ...any) *Notice {
 	return msg
 }
 
+// SortRows sorts rows, so failure messages are consistent regardless of the
+// order the rows were added in. The rows with names given in the order are
+// moved to the beginning in that order, and the remaining rows keep their
+// relative order. Without arguments, the canonical order is used: the "want"
+// and "have" rows go first, and the "types" and "hint" rows go last.
+// Implements fluent interface.
+func (msg *Notice) SortRows(order ...string) *Notice {
+	rank := func(name string) int {
+		if idx := slices.Index(order, name); idx >= 0 {
+			return idx
+		}
+		return len(order)
+	}
+	if len(order) == 0 {
+		rank = func(name string) int {
+			if idx := slices.Index(rowsFirst, name); idx >= 0 {
+				return idx
+			}
+			if idx := slices.Index(rowsLast, name); idx >= 0 {
+				return len(rowsFirst) + 1 + idx
+			}
+			return len(rowsFirst)
+		}
+	}
+	slices.SortStableFunc(msg.Rows, func(a, b Row) int {
+		return rank(a.Name) - rank(b.Name)
+	})
+	return msg
+}
+
 // SetTrail adds trail row if "tr" is not an empty string. If the trail row
 // already exists, it overwrites it. Implements fluent interface.
 //
@@ -133,13 +324,15 @@ func (msg *Notice) SetTrail(trail string) *Notice {
 }
 
 // Want uses the Append method to append a row with the "want" name. If the
-// "want" row already exists, it will just replace its value.
+// "want" row already exists, it will just replace its value. The rendered row
+// name may be changed globally with [SetTexts].
 func (msg *Notice) Want(format string, args ...any) *Notice {
 	return msg.Append("want", format, args...)
 }
 
 // Have uses the Append method to append a row with the "have" name. If the
-// "have" row already exists, it will just replace its value.
+// "have" row already exists, it will just replace its value. The rendered row
+// name may be changed globally with [SetTexts].
 func (msg *Notice) Have(format string, args ...any) *Notice {
 	return msg.Append("have", format, args...)
 }
@@ -151,11 +344,50 @@ func (msg *Notice) Wrap(err error) *Notice {
 }
 
 // Unwrap returns wrapped error. By default, it returns [ErrNotice] unless a
-// different error was specified using [Notice.Wrap].
+// different error was specified using [Notice.Wrap]. The cause set with
+// [Notice.Cause] is not returned, it's matched by [Notice.Is] and
+// [Notice.As] methods instead.
 func (msg *Notice) Unwrap() error {
 	return msg.err
 }
 
+// Cause sets the underlying error which caused the notice, for example, the
+// parse error which made the check fail. Unlike [Notice.Wrap], it does not
+// change the base error, so the notice is still [ErrNotice], but [errors.Is]
+// and [errors.As] find the cause too.
+func (msg *Notice) Cause(err error) *Notice {
+	msg.cause = err
+	return msg
+}
+
+// Code sets the stable identifier of the failure kind, for example,
+// "equal.type-mismatch" or "json.parse-error", so tooling can classify and
+// aggregate failures without parsing messages. The code is not rendered by
+// [Notice.Error]. Use [CodeFrom] to get it back. Implements fluent interface.
+func (msg *Notice) Code(code string) *Notice {
+	msg.code = code
+	return msg
+}
+
+// CodeFrom returns the code set with [Notice.Code] on the first [Notice] in
+// the err's tree. Returns an empty string if there is no [Notice] in the tree
+// or its code was not set.
+func CodeFrom(err error) string {
+	var msg *Notice
+	if !errors.As(err, &msg) {
+		return ""
+	}
+	return msg.code
+}
+
+// Truncate sets the maximum number of rows rendered by [Notice.Error] for all
+// notices in the chain. It overrides the default set with [WithMaxRows] unless
+// it's zero or negative. See [WithMaxRows] for details.
+func (msg *Notice) Truncate(n int) *Notice {
+	msg.limit = max(n, 0)
+	return msg
+}
+
 // Remove removes named row.
 func (msg *Notice) Remove(name string) *Notice {
 	fn := func(row Row) bool { return row.Name == name }
@@ -163,12 +395,24 @@ func (msg *Notice) Remove(name string) *Notice {
 	return msg
 }
 
-func (msg *Notice) Is(target error) bool { return errors.Is(msg.err, target) }
+// Is returns true if the base error or the cause matches the target.
+func (msg *Notice) Is(target error) bool {
+	return errors.Is(msg.err, target) || errors.Is(msg.cause, target)
+}
+
+// As finds the first error in the cause tree that matches the target, and if
+// one is found, sets target to that error value and returns true.
+func (msg *Notice) As(target any) bool {
+	return msg.cause != nil && errors.As(msg.cause, target)
+}
 
 // Notice returns a formatted string representation of the Notice.
 //
 // nolint: gocognit, cyclop
 func (msg *Notice) Error() string {
+	if msg.verbosity == Quiet {
+		return msg.summary()
+	}
 	mgs := msg.collect()
 
 	var longest int
@@ -178,32 +422,53 @@ func (msg *Notice) Error() string {
 		}
 	}
 
+	limit := msg.limit
+	if limit == 0 {
+		limit = int(maxRows.Load())
+	}
+	var total, printed int
+	counts := make([]int, len(mgs)) // Number of limited rows of every notice.
+	for i, m := range mgs {
+		counts[i] = m.limited()
+		total += counts[i]
+	}
+	var cut bool // Set when the line about omitted rows was written.
+
 	buf := &strings.Builder{}
 	multiMsg := len(mgs) > 1
+	errName := Text("error")
 
 	if multiMsg {
-		if longest < len("error") {
-			longest = len("error")
+		if longest < len(errName) {
+			longest = len(errName)
 		}
-		buf.WriteString(multiHeader)
+	}
+	if pad := int(maxPad.Load()); pad > 0 {
+		longest = min(longest, pad)
+	}
+
+	if multiMsg {
+		buf.WriteString(colorize(colorRed, Text(multiHeader)))
 		buf.WriteString(":\n")
 	}
 
 	for im, m := range mgs {
 		lastMsg := im == len(mgs)-1
 
-		rows := m.Rows
-		if m.Trail != "" {
-			rows = append([]Row{NewRow(trail, "%s", m.Trail)}, m.Rows...)
+		rows, shown := m.rows(), counts[im]
+		if limit > 0 && shown > limit-printed {
+			shown = limit - printed
+			rows = append(rows[:shown:shown], rows[counts[im]:]...)
 		}
+		printed += shown
 
 		if multiMsg && m.Header != "" {
 			buf.WriteString("  ")
-			buf.WriteString(Pad("error", longest))
+			buf.WriteString(Pad(errName, longest))
 			buf.WriteString(": ")
-			buf.WriteString(m.Header)
+			buf.WriteString(colorize(colorRed, Text(m.Header)))
 		} else {
-			buf.WriteString(m.Header)
+			buf.WriteString(colorize(colorRed, Text(m.Header)))
 		}
 
 		if len(rows) > 0 && m.Header != "" {
@@ -215,7 +480,7 @@ func (msg *Notice) Error() string {
 
 		for ir, r := range rows {
 			lastRow := ir == len(rows)-1
-			name := r.PadName(longest)
+			name := Pad(Text(r.Name), longest)
 			value := r.String()
 
 			buf.WriteString("  ")
@@ -230,6 +495,9 @@ func (msg *Notice) Error() string {
 			} else {
 				buf.WriteString(" ")
 			}
+			if code, ok := rowColors[r.Name]; ok {
+				value = colorize(code, value)
+			}
 			buf.WriteString(value)
 
 			if !lastRow {
@@ -237,6 +505,34 @@ func (msg *Notice) Error() string {
 			}
 		}
 
+		if !cut && limit > 0 && printed == limit && total > printed {
+			var omitted int // Number of notices with omitted rows.
+			for i := im; i < len(mgs); i++ {
+				if i == im && shown == counts[i] {
+					continue
+				}
+				if counts[i] > 0 {
+					omitted++
+				}
+			}
+			if buf.Len() > 0 && !strings.HasSuffix(buf.String(), "\n") {
+				buf.WriteString("\n")
+			}
+			buf.WriteString(moreRows(total-printed, omitted))
+			cut = true
+		}
+
+		if m.Footer != "" {
+			// The leading newline makes Indent indent single line footers.
+			buf.WriteString("\n")
+			buf.WriteString(Indent(2, ' ', "\n"+Text(m.Footer)))
+		}
+
+		if m.stack != "" {
+			buf.WriteString("\n\n  stack:\n")
+			buf.WriteString(Indent(4, ' ', m.stack))
+		}
+
 		if !lastMsg {
 			buf.WriteString("\n")
 			buf.WriteString(Pad("---", longest+4))
@@ -247,6 +543,68 @@ func (msg *Notice) Error() string {
 	return buf.String()
 }
 
+// moreRows returns the line about "n" rows of "msgs" notices cut off by the
+// row limit. The number of notices is included only when it's more than one.
+func moreRows(n, msgs int) string {
+	str := fmt.Sprintf("  … %d more rows", n)
+	if n == 1 {
+		str = "  … 1 more row"
+	}
+	if msgs > 1 {
+		str += fmt.Sprintf(" in %d notices", msgs)
+	}
+	return colorize(colorDim, str)
+}
+
+// jsonNotice represents [Notice] serialized to JSON.
+type jsonNotice struct {
+	Header string    `json:"header"`
+	Code   string    `json:"code,omitempty"`
+	Trail  string    `json:"trail,omitempty"`
+	Rows   []jsonRow `json:"rows"`
+	Footer string    `json:"footer,omitempty"`
+}
+
+// jsonRow represents [Row] serialized to JSON.
+type jsonRow struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// MarshalJSON implements [json.Marshaler] interface. The notice is serialized
+// as an object with the header, the code, the trail, the rows with their
+// names and formatted values, and the footer. The rows are in the same order
+// as they are rendered by [Notice.Error], but without the trail row. Other
+// notices in the chain are not serialized, use [ToJSON] for them.
+//
+// Example:
+//
+//	{
+//	  "header": "expected values to be equal",
+//	  "trail": "T.Field",
+//	  "rows": [
+//	    {"name": "want", "value": "1"},
+//	    {"name": "have", "value": "2"}
+//	  ]
+//	}
+func (msg *Notice) MarshalJSON() ([]byte, error) {
+	jn := jsonNotice{
+		Header: msg.Header,
+		Code:   msg.code,
+		Trail:  msg.Trail,
+		Rows:   make([]jsonRow, 0, len(msg.Rows)),
+		Footer: msg.Footer,
+	}
+	for _, row := range msg.Rows {
+		val := row.String()
+		if row.Optional && val == "" {
+			continue
+		}
+		jn.Rows = append(jn.Rows, jsonRow{Name: row.Name, Value: val})
+	}
+	return json.Marshal(jn)
+}
+
 // MetaSet sets data. To get it back, use the [Notice.MetaLookup] method.
 func (msg *Notice) MetaSet(key string, val any) *Notice {
 	if msg.Meta == nil {
@@ -266,20 +624,60 @@ func (msg *Notice) MetaLookup(key string) (any, bool) {
 	return val, ok
 }
 
-// The longest returns the length of the longest row name among all [Notice.Rows]
-// and the [Notice.Trail] string. If there are no rows and the trail is empty,
-// it returns 0.
+// MetaFrom returns the metadata set with [Notice.MetaSet] on the first
+// [Notice] in the err's tree. The metadata is not rendered by [Notice.Error],
+// so it may hold structured values, for example, [MetaWant] and [MetaHave].
+// Returns nil if there is no [Notice] in the tree or it has no metadata.
+func MetaFrom(err error) map[string]any {
+	var msg *Notice
+	if !errors.As(err, &msg) {
+		return nil
+	}
+	return msg.Meta
+}
+
+// The longest returns the length of the longest row name among all rendered
+// rows, including the [Notice.Trail] row. If there are no rows and the trail
+// is empty, it returns 0. The row name replacements set with [SetTexts] are
+// taken into account.
 func (msg *Notice) longest() int {
 	var maxLen int
+	for _, row := range msg.rows() {
+		if name := Text(row.Name); maxLen < len(name) {
+			maxLen = len(name)
+		}
+	}
+	return maxLen
+}
+
+// rows returns rows to render. The trail row is added as the first one when
+// the [Notice.Trail] is not empty, the source row is added as the last one
+// when it was recorded, and optional rows with empty values are skipped.
+func (msg *Notice) rows() []Row {
+	rows := make([]Row, 0, len(msg.Rows)+2)
 	if msg.Trail != "" {
-		maxLen = len(trail)
+		rows = append(rows, NewRow(trail, "%s", msg.Trail))
 	}
 	for _, row := range msg.Rows {
-		if maxLen < len(row.Name) {
-			maxLen = len(row.Name)
+		if row.Optional && row.String() == "" {
+			continue
 		}
+		rows = append(rows, row)
 	}
-	return maxLen
+	if msg.src != "" {
+		rows = append(rows, NewRow(source, "%s", msg.src))
+	}
+	return rows
+}
+
+// limited returns the number of rows returned by [Notice.rows] which are
+// subject to the row limit. The source row is never cut off.
+func (msg *Notice) limited() int {
+	cnt := len(msg.rows())
+	if msg.src != "" {
+		cnt--
+	}
+	return cnt
 }
 
 // Chain adds the current [Notice] as next in the chain after "prev" and
@@ -342,3 +740,28 @@ func Join(ers ...error) error {
 	}
 	return err
 }
+
+// ToJSON serializes all notices in the error to a JSON array, so CI tooling
+// can collect assertion failures. Notices joined with [Join] are serialized
+// in the chain order, errors joined with [errors.Join] are serialized in the
+// order they were joined, and errors which are not notices are serialized the
+// same way as [From] sees them. Returns an empty array for nil error.
+func ToJSON(err error) ([]byte, error) {
+	return json.Marshal(appendNotices(make([]*Notice, 0), err))
+}
+
+// appendNotices appends notices found in the error to the slice.
+func appendNotices(mgs []*Notice, err error) []*Notice {
+	if err == nil {
+		return mgs
+	}
+	if _, ok := err.(*Notice); !ok { // nolint: errorlint
+		if ers, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, e := range ers.Unwrap() {
+				mgs = appendNotices(mgs, e)
+			}
+			return mgs
+		}
+	}
+	return append(mgs, From(err).collect()...)
+}
@@ -4,7 +4,12 @@
 package notice
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"strings"
 	"testing"
 
 	"github.com/ctx42/testing/internal/affirm"
@@ -117,6 +122,20 @@ func Test_From(t *testing.T) {
 		affirm.Equal(t, false, errors.Is(have, ErrNotice))
 	})
 
+	t.Run("wrapped error can be found with errors.As", func(t *testing.T) {
+		// --- Given ---
+		orig := &fs.PathError{Op: "open", Path: "file.txt", Err: fs.ErrNotExist}
+
+		// --- When ---
+		have := From(orig).SetTrail("T.Path").Append("first", "%d", 1)
+
+		// --- Then ---
+		var pe *fs.PathError
+		affirm.Equal(t, true, errors.As(have, &pe))
+		affirm.Equal(t, true, core.Same(orig, pe))
+		affirm.Equal(t, true, errors.Is(have, fs.ErrNotExist))
+	})
+
 	t.Run("nil error", func(t *testing.T) {
 		// --- When ---
 		have := From(nil)
@@ -152,6 +171,32 @@ func Test_Notice_SetHeader(t *testing.T) {
 	})
 }
 
+func Test_Notice_SetFooter(t *testing.T) {
+	t.Run("without args", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header")
+
+		// --- When ---
+		have := msg.SetFooter("footer %s")
+
+		// --- Then ---
+		affirm.Equal(t, true, core.Same(msg, have))
+		affirm.Equal(t, "footer %s", have.Footer)
+	})
+
+	t.Run("with args", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header")
+
+		// --- When ---
+		have := msg.SetFooter("footer %s", "row")
+
+		// --- Then ---
+		affirm.Equal(t, true, core.Same(msg, have))
+		affirm.Equal(t, "footer row", have.Footer)
+	})
+}
+
 func Test_Notice_Append(t *testing.T) {
 	t.Run("append first", func(t *testing.T) {
 		// --- Given ---
@@ -243,6 +288,103 @@ func Test_Notice_AppendRow(t *testing.T) {
 		}
 		affirm.DeepEqual(t, wRows, msg.Rows)
 	})
+
+	t.Run("optional row", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header")
+		row := NewRow("first", "%d", 1)
+		row.Optional = true
+
+		// --- When ---
+		_ = msg.AppendRow(row)
+
+		// --- Then ---
+		wRows := []Row{
+			{Name: "first", Format: "%d", Args: []any{1}, Optional: true},
+		}
+		affirm.DeepEqual(t, wRows, msg.Rows)
+	})
+}
+
+func Test_Notice_AppendOptional(t *testing.T) {
+	t.Run("append", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").Append("first", "%d", 1)
+
+		// --- When ---
+		have := msg.AppendOptional("second", "%d", 2)
+
+		// --- Then ---
+		affirm.Equal(t, true, core.Same(msg, have))
+		wRows := []Row{
+			{Name: "first", Format: "%d", Args: []any{1}},
+			{Name: "second", Format: "%d", Args: []any{2}, Optional: true},
+		}
+		affirm.DeepEqual(t, wRows, msg.Rows)
+	})
+
+	t.Run("append an existing name overwrites", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").Append("first", "%d", 1).Append("second", "%d", 2)
+
+		// --- When ---
+		_ = msg.AppendOptional("first", "%d", 3)
+
+		// --- Then ---
+		wRows := []Row{
+			{Name: "first", Format: "%d", Args: []any{3}, Optional: true},
+			{Name: "second", Format: "%d", Args: []any{2}},
+		}
+		affirm.DeepEqual(t, wRows, msg.Rows)
+	})
+}
+
+func Test_Notice_AppendLazy(t *testing.T) {
+	t.Run("not called until rendered", func(t *testing.T) {
+		// --- Given ---
+		var calls int
+		fn := func() string { calls++; return "value" }
+		msg := New("header").Append("first", "%d", 1)
+
+		// --- When ---
+		have := msg.AppendLazy("second", fn)
+
+		// --- Then ---
+		affirm.Equal(t, true, core.Same(msg, have))
+		affirm.Equal(t, 0, calls)
+		affirm.Equal(t, 2, len(msg.Rows))
+		affirm.Equal(t, "second", msg.Rows[1].Name)
+	})
+
+	t.Run("called once", func(t *testing.T) {
+		// --- Given ---
+		var calls int
+		fn := func() string { calls++; return "value" }
+		msg := New("header").AppendLazy("first", fn)
+
+		// --- When ---
+		_ = msg.Error()
+		have := msg.Error()
+
+		// --- Then ---
+		affirm.Equal(t, "header:\n  first: value", have)
+		affirm.Equal(t, 1, calls)
+	})
+
+	t.Run("append an existing name overwrites", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").Append("first", "%d", 1).Append("second", "%d", 2)
+
+		// --- When ---
+		_ = msg.AppendLazy("first", func() string { return "3" })
+
+		// --- Then ---
+		want := "" +
+			"header:\n" +
+			"   first: 3\n" +
+			"  second: 2"
+		affirm.Equal(t, want, msg.Error())
+	})
 }
 
 func Test_Notice_Prepend(t *testing.T) {
@@ -292,6 +434,66 @@ func Test_Notice_Prepend(t *testing.T) {
 	})
 }
 
+func Test_Notice_SortRows(t *testing.T) {
+	t.Run("canonical order", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").
+			Append("hint", "h").
+			Append("types", "t").
+			Have("%d", 2).
+			Append("first", "f").
+			Want("%d", 1).
+			Append("second", "s")
+
+		// --- When ---
+		have := msg.SortRows()
+
+		// --- Then ---
+		affirm.Equal(t, true, core.Same(msg, have))
+		wRows := []Row{
+			{Name: "want", Format: "%d", Args: []any{1}},
+			{Name: "have", Format: "%d", Args: []any{2}},
+			{Name: "first", Format: "f"},
+			{Name: "second", Format: "s"},
+			{Name: "types", Format: "t"},
+			{Name: "hint", Format: "h"},
+		}
+		affirm.DeepEqual(t, wRows, have.Rows)
+	})
+
+	t.Run("custom order", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").
+			Append("a", "a").
+			Append("b", "b").
+			Append("c", "c").
+			Append("d", "d")
+
+		// --- When ---
+		have := msg.SortRows("c", "x", "a")
+
+		// --- Then ---
+		wRows := []Row{
+			{Name: "c", Format: "c"},
+			{Name: "a", Format: "a"},
+			{Name: "b", Format: "b"},
+			{Name: "d", Format: "d"},
+		}
+		affirm.DeepEqual(t, wRows, have.Rows)
+	})
+
+	t.Run("no rows", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header")
+
+		// --- When ---
+		have := msg.SortRows()
+
+		// --- Then ---
+		affirm.Nil(t, have.Rows)
+	})
+}
+
 func Test_Notice_SetTrail(t *testing.T) {
 	t.Run("add as first row", func(t *testing.T) {
 		// --- Given ---
@@ -444,6 +646,109 @@ func Test_Notice_Unwrap(t *testing.T) {
 	})
 }
 
+func Test_Notice_Cause(t *testing.T) {
+	// --- Given ---
+	msg := New("header").Append("first", "%d", 1)
+
+	// --- When ---
+	have := msg.Cause(io.EOF)
+
+	// --- Then ---
+	affirm.Equal(t, true, core.Same(msg, have))
+	affirm.Equal(t, true, errors.Is(msg, ErrNotice))
+	affirm.Equal(t, true, errors.Is(msg, io.EOF))
+	affirm.Equal(t, true, core.Same(ErrNotice, msg.Unwrap()))
+	wRows := []Row{{Name: "first", Format: "%d", Args: []any{1}}}
+	affirm.DeepEqual(t, wRows, msg.Rows)
+}
+
+func Test_Notice_Code(t *testing.T) {
+	// --- Given ---
+	msg := New("header").Want("%d", 1)
+
+	// --- When ---
+	have := msg.Code("equal.type-mismatch")
+
+	// --- Then ---
+	affirm.Equal(t, true, core.Same(msg, have))
+	affirm.Equal(t, "equal.type-mismatch", msg.code)
+	affirm.Equal(t, "header:\n  want: 1", msg.Error())
+}
+
+func Test_CodeFrom(t *testing.T) {
+	t.Run("notice with code", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").Code("json.parse-error")
+
+		// --- When ---
+		have := CodeFrom(msg)
+
+		// --- Then ---
+		affirm.Equal(t, "json.parse-error", have)
+	})
+
+	t.Run("wrapped notice with code", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").Code("json.parse-error")
+		err := fmt.Errorf("wrapped: %w", msg)
+
+		// --- When ---
+		have := CodeFrom(err)
+
+		// --- Then ---
+		affirm.Equal(t, "json.parse-error", have)
+	})
+
+	t.Run("notice without code", func(t *testing.T) {
+		// --- When ---
+		have := CodeFrom(New("header"))
+
+		// --- Then ---
+		affirm.Equal(t, "", have)
+	})
+
+	t.Run("not notice", func(t *testing.T) {
+		// --- When ---
+		have := CodeFrom(errors.New("my error"))
+
+		// --- Then ---
+		affirm.Equal(t, "", have)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		// --- When ---
+		have := CodeFrom(nil)
+
+		// --- Then ---
+		affirm.Equal(t, "", have)
+	})
+}
+
+func Test_Notice_Truncate(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header")
+
+		// --- When ---
+		have := msg.Truncate(3)
+
+		// --- Then ---
+		affirm.Equal(t, true, core.Same(msg, have))
+		affirm.Equal(t, 3, msg.limit)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header")
+
+		// --- When ---
+		msg.Truncate(-1)
+
+		// --- Then ---
+		affirm.Equal(t, 0, msg.limit)
+	})
+}
+
 func Test_Notice_Remove(t *testing.T) {
 	t.Run("remove existing", func(t *testing.T) {
 		// --- Given ---
@@ -495,6 +800,60 @@ func Test_Notice_Is(t *testing.T) {
 		// --- Then ---
 		affirm.Equal(t, false, have)
 	})
+
+	t.Run("is cause", func(t *testing.T) {
+		// --- Given ---
+		err := fmt.Errorf("wrapped: %w", io.EOF)
+		msg := New("header").Cause(err)
+
+		// --- When ---
+		have := msg.Is(io.EOF)
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("wrapped notice with cause", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").Cause(io.EOF)
+		err := fmt.Errorf("wrapped: %w", msg)
+
+		// --- When ---
+		have := errors.Is(err, io.EOF)
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+}
+
+func Test_Notice_As(t *testing.T) {
+	t.Run("cause", func(t *testing.T) {
+		// --- Given ---
+		cause := &fs.PathError{Op: "open", Path: "file", Err: fs.ErrNotExist}
+		msg := New("header").Cause(fmt.Errorf("wrapped: %w", cause))
+
+		// --- When ---
+		var target *fs.PathError
+		have := errors.As(msg, &target)
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+		affirm.Equal(t, true, core.Same(cause, target))
+		affirm.Equal(t, true, errors.Is(msg, fs.ErrNotExist))
+	})
+
+	t.Run("no cause", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header")
+
+		// --- When ---
+		var target *fs.PathError
+		have := msg.As(&target)
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+		affirm.Nil(t, target)
+	})
 }
 
 func Test_Notice_Error(t *testing.T) {
@@ -565,6 +924,37 @@ func Test_Notice_Error(t *testing.T) {
 		affirm.Equal(t, want, have)
 	})
 
+	t.Run("joined multi line row values", func(t *testing.T) {
+		// --- Given ---
+		msg0 := New("header0").
+			Want("%s", "{\n  A: 1,\n}").
+			Have("%s", "{\n  A: 2,\n}")
+		msg1 := New("header1").Append("longer", "%s", "a\nb")
+		msg := Join(msg0, msg1)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"multiple expectations violated:\n" +
+			"   error: header0\n" +
+			"    want:\n" +
+			"          {\n" +
+			"            A: 1,\n" +
+			"          }\n" +
+			"    have:\n" +
+			"          {\n" +
+			"            A: 2,\n" +
+			"          }\n" +
+			"       ---\n" +
+			"   error: header1\n" +
+			"  longer:\n" +
+			"          a\n" +
+			"          b"
+		affirm.Equal(t, want, have)
+	})
+
 	t.Run("force a row message to start on the next line", func(t *testing.T) {
 		// --- Given ---
 		msg := New("expected values to be equal").
@@ -618,92 +1008,888 @@ func Test_Notice_Error(t *testing.T) {
 		affirm.Equal(t, want, have)
 	})
 
-	t.Run("joined", func(t *testing.T) {
+	t.Run("with footer", func(t *testing.T) {
 		// --- Given ---
-		msg0 := New("header0").Want("%d", 42).Have("%d", 44)
-		msg1 := New("header1").Want("%d", 11).Have("%d", 7)
-		msg := Join(msg0, msg1)
+		msg := New("header").
+			Want("%d", 42).
+			Have("%d", 44).
+			SetFooter("run with -update to refresh golden files")
 
 		// --- When ---
 		have := msg.Error()
 
 		// --- Then ---
 		want := "" +
-			"multiple expectations violated:\n" +
-			"  error: header0\n" +
-			"   want: 42\n" +
-			"   have: 44\n" +
-			"      ---\n" +
-			"  error: header1\n" +
-			"   want: 11\n" +
+			"header:\n" +
+			"  want: 42\n" +
+			"  have: 44\n" +
+			"\n" +
+			"  run with -update to refresh golden files"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("with multi line footer", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").Want("%d", 42).SetFooter("line1\n\nline2")
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"header:\n" +
+			"  want: 42\n" +
+			"\n" +
+			"  line1\n" +
+			"\n" +
+			"  line2"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("header only with footer", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").SetFooter("footer")
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		affirm.Equal(t, "header\n\n  footer", have)
+	})
+
+	t.Run("joined with footer", func(t *testing.T) {
+		// --- Given ---
+		msg0 := New("header0").Want("%d", 42).SetFooter("footer0")
+		msg1 := New("header1").Want("%d", 11)
+		msg := Join(msg0, msg1)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"multiple expectations violated:\n" +
+			"  error: header0\n" +
+			"   want: 42\n" +
+			"\n" +
+			"  footer0\n" +
+			"      ---\n" +
+			"  error: header1\n" +
+			"   want: 11"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("joined", func(t *testing.T) {
+		// --- Given ---
+		msg0 := New("header0").Want("%d", 42).Have("%d", 44)
+		msg1 := New("header1").Want("%d", 11).Have("%d", 7)
+		msg := Join(msg0, msg1)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"multiple expectations violated:\n" +
+			"  error: header0\n" +
+			"   want: 42\n" +
+			"   have: 44\n" +
+			"      ---\n" +
+			"  error: header1\n" +
+			"   want: 11\n" +
+			"   have: 7"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("joined the last has a simple header", func(t *testing.T) {
+		// --- Given ---
+		msg0 := New("header0").Want("%d", 42).Have("%d", 44)
+		msg1 := New("header1")
+		msg := Join(msg0, msg1)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"multiple expectations violated:\n" +
+			"  error: header0\n" +
+			"   want: 42\n" +
+			"   have: 44\n" +
+			"      ---\n" +
+			"  error: header1"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("joined the first has a simple header", func(t *testing.T) {
+		// --- Given ---
+		msg0 := New("header0")
+		msg1 := New("header1").Want("%d", 11).Have("%d", 7)
+		msg := Join(msg0, msg1)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"multiple expectations violated:\n" +
+			"  error: header0\n" +
+			"      ---\n" +
+			"  error: header1\n" +
+			"   want: 11\n" +
 			"   have: 7"
 		affirm.Equal(t, want, have)
 	})
 
-	t.Run("joined the last has a simple header", func(t *testing.T) {
+	t.Run("joined with empty error row", func(t *testing.T) {
+		// --- Given ---
+		msg0 := New("").Append("name", "%s", "value")
+		msg1 := New("header0").Want("%d", 42).Have("%d", 44)
+		msg2 := New("header1").Want("%d", 11).Have("%d", 7)
+		msg := Join(msg0, msg1, msg2)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"multiple expectations violated:\n" +
+			"   name: value\n" +
+			"      ---\n" +
+			"  error: header0\n" +
+			"   want: 42\n" +
+			"   have: 44\n" +
+			"      ---\n" +
+			"  error: header1\n" +
+			"   want: 11\n" +
+			"   have: 7"
+		affirm.Equal(t, want, have)
+	})
+}
+
+func Test_EnableColor(t *testing.T) {
+	t.Run("disabled with NO_COLOR", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv("NO_COLOR", "1")
+		t.Cleanup(DisableColor)
+		color.Store(true)
+
+		// --- When ---
+		EnableColor()
+
+		// --- Then ---
+		affirm.Equal(t, false, color.Load())
+	})
+}
+
+func Test_DisableColor(t *testing.T) {
+	// --- Given ---
+	color.Store(true)
+
+	// --- When ---
+	DisableColor()
+
+	// --- Then ---
+	affirm.Equal(t, false, color.Load())
+}
+
+func Test_WithMaxRows(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { WithMaxRows(0) })
+
+		// --- When ---
+		WithMaxRows(3)
+
+		// --- Then ---
+		affirm.Equal(t, int64(3), maxRows.Load())
+	})
+
+	t.Run("negative turns off the limit", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { WithMaxRows(0) })
+		maxRows.Store(3)
+
+		// --- When ---
+		WithMaxRows(-1)
+
+		// --- Then ---
+		affirm.Equal(t, int64(0), maxRows.Load())
+	})
+}
+
+func Test_WithStack(t *testing.T) {
+	// --- Given ---
+	t.Cleanup(func() { withStack.Store(false) })
+
+	// --- When ---
+	WithStack()
+
+	// --- Then ---
+	affirm.Equal(t, true, withStack.Load())
+}
+
+func Test_Notice_Error_stack(t *testing.T) {
+	t.Run("recorded", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { withStack.Store(false) })
+		WithStack()
+		msg := New("header").Want("%d", 1)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"header:\n" +
+			"  want: 1\n" +
+			"\n" +
+			"  stack:\n" +
+			"    github.com/ctx42/testing/pkg/notice." +
+			"Test_Notice_Error_stack.func1\n" +
+			"        /"
+		affirm.Equal(t, true, strings.HasPrefix(have, want))
+		affirm.Equal(t, false, strings.Contains(have, "testing.tRunner"))
+	})
+
+	t.Run("not recorded by default", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").Want("%d", 1)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		affirm.Equal(t, "header:\n  want: 1", have)
+	})
+}
+
+func Test_WithSource(t *testing.T) {
+	// --- Given ---
+	t.Cleanup(func() { withSource.Store(false) })
+
+	// --- When ---
+	WithSource()
+
+	// --- Then ---
+	affirm.Equal(t, true, withSource.Load())
+}
+
+func Test_Notice_Error_source(t *testing.T) {
+	t.Run("recorded", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { withSource.Store(false) })
+		WithSource()
+		msg := New("header").Want("%d", 1)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"header:\n" +
+			"    want: 1\n" +
+			"  source: /"
+		affirm.Equal(t, true, strings.HasPrefix(have, want))
+		affirm.Equal(t, true, strings.Contains(have, "notice_test.go:"))
+	})
+
+	t.Run("not recorded by default", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").Want("%d", 1)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		affirm.Equal(t, "header:\n  want: 1", have)
+	})
+}
+
+func Test_WithMaxPad(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { WithMaxPad(0) })
+
+		// --- When ---
+		WithMaxPad(8)
+
+		// --- Then ---
+		affirm.Equal(t, int64(8), maxPad.Load())
+	})
+
+	t.Run("negative turns off the limit", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { WithMaxPad(0) })
+		maxPad.Store(8)
+
+		// --- When ---
+		WithMaxPad(-1)
+
+		// --- Then ---
+		affirm.Equal(t, int64(0), maxPad.Load())
+	})
+}
+
+func Test_Notice_Error_max_pad(t *testing.T) {
+	t.Run("long name", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { WithMaxPad(0) })
+		WithMaxPad(6)
+		msg := New("header").
+			Want("%d", 1).
+			Have("%d", 2).
+			Append("expected response body", "%s", "abc")
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"header:\n" +
+			"    want: 1\n" +
+			"    have: 2\n" +
+			"  expected response body: abc"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("names shorter than the limit", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { WithMaxPad(0) })
+		WithMaxPad(10)
+		msg := New("header").Want("%d", 1).Append("other", "%d", 2)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"header:\n" +
+			"   want: 1\n" +
+			"  other: 2"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("joined", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { WithMaxPad(0) })
+		WithMaxPad(5)
+		msg0 := New("header0").Want("%d", 1).Append("long name", "%d", 2)
+		msg1 := New("header1").Have("%d", 3)
+		msg := Join(msg0, msg1)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"multiple expectations violated:\n" +
+			"  error: header0\n" +
+			"   want: 1\n" +
+			"  long name: 2\n" +
+			"      ---\n" +
+			"  error: header1\n" +
+			"   have: 3"
+		affirm.Equal(t, want, have)
+	})
+}
+
+func Test_Notice_Error_truncated(t *testing.T) {
+	t.Run("single notice", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").
+			Append("first", "%d", 1).
+			Append("second", "%d", 2).
+			Append("third", "%d", 3).
+			Append("fourth", "%d", 4).
+			Truncate(2)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"header:\n" +
+			"   first: 1\n" +
+			"  second: 2\n" +
+			"  … 2 more rows"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("one more row", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").Want("%d", 1).Have("%d", 2).Truncate(1)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"header:\n" +
+			"  want: 1\n" +
+			"  … 1 more row"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("limit not reached", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").Want("%d", 1).Have("%d", 2).Truncate(2)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"header:\n" +
+			"  want: 1\n" +
+			"  have: 2"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("joined cut in the middle of a notice", func(t *testing.T) {
+		// --- Given ---
+		msg0 := New("header0").Want("%d", 42).Have("%d", 44)
+		msg1 := New("header1").Want("%d", 11).Have("%d", 7)
+		msg2 := New("header2").Want("%d", 1).Have("%d", 2)
+		msg := msg2.Chain(msg1.Chain(msg0)).Truncate(3)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"multiple expectations violated:\n" +
+			"  error: header0\n" +
+			"   want: 42\n" +
+			"   have: 44\n" +
+			"      ---\n" +
+			"  error: header1\n" +
+			"   want: 11\n" +
+			"  … 3 more rows in 2 notices\n" +
+			"      ---\n" +
+			"  error: header2"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("joined cut at the end of a notice", func(t *testing.T) {
+		// --- Given ---
+		msg0 := New("header0").Want("%d", 42).Have("%d", 44)
+		msg1 := New("header1").Want("%d", 11).Have("%d", 7)
+		msg := msg1.Chain(msg0).Truncate(2)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"multiple expectations violated:\n" +
+			"  error: header0\n" +
+			"   want: 42\n" +
+			"   have: 44\n" +
+			"  … 2 more rows\n" +
+			"      ---\n" +
+			"  error: header1"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("footer is rendered", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").
+			Want("%d", 1).
+			Have("%d", 2).
+			SetFooter("footer").
+			Truncate(1)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"header:\n" +
+			"  want: 1\n" +
+			"  … 1 more row\n" +
+			"\n" +
+			"  footer"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("footers of joined notices are rendered", func(t *testing.T) {
+		// --- Given ---
+		msg0 := New("header0").Want("%d", 42).Have("%d", 44)
+		msg1 := New("header1").Want("%d", 11).SetFooter("footer1")
+		msg := msg1.Chain(msg0).Truncate(1)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"multiple expectations violated:\n" +
+			"  error: header0\n" +
+			"   want: 42\n" +
+			"  … 2 more rows in 2 notices\n" +
+			"      ---\n" +
+			"  error: header1\n" +
+			"\n" +
+			"  footer1"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("stack is rendered", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { withStack.Store(false) })
+		WithStack()
+		msg := New("header").Want("%d", 1).Have("%d", 2).Truncate(1)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"header:\n" +
+			"  want: 1\n" +
+			"  … 1 more row\n" +
+			"\n" +
+			"  stack:\n"
+		affirm.Equal(t, true, strings.HasPrefix(have, want))
+	})
+
+	t.Run("source is not cut off", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { withSource.Store(false) })
+		WithSource()
+		msg := New("header").Want("%d", 1).Have("%d", 2).Truncate(1)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"header:\n" +
+			"    want: 1\n" +
+			"  source: "
+		affirm.Equal(t, true, strings.HasPrefix(have, want))
+		affirm.Equal(t, true, strings.HasSuffix(have, "\n  … 1 more row"))
+	})
+
+	t.Run("default limit", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { WithMaxRows(0) })
+		WithMaxRows(1)
+		msg := New("header").Want("%d", 1).Have("%d", 2)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"header:\n" +
+			"  want: 1\n" +
+			"  … 1 more row"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("limit takes precedence over the default", func(t *testing.T) {
 		// --- Given ---
-		msg0 := New("header0").Want("%d", 42).Have("%d", 44)
-		msg1 := New("header1")
-		msg := Join(msg0, msg1)
+		t.Cleanup(func() { WithMaxRows(0) })
+		WithMaxRows(1)
+		msg := New("header").Want("%d", 1).Have("%d", 2).Truncate(2)
 
 		// --- When ---
 		have := msg.Error()
 
 		// --- Then ---
 		want := "" +
-			"multiple expectations violated:\n" +
-			"  error: header0\n" +
-			"   want: 42\n" +
-			"   have: 44\n" +
-			"      ---\n" +
-			"  error: header1"
+			"header:\n" +
+			"  want: 1\n" +
+			"  have: 2"
 		affirm.Equal(t, want, have)
 	})
+}
 
-	t.Run("joined the first has a simple header", func(t *testing.T) {
+func Test_Notice_Error_texts(t *testing.T) {
+	t.Run("single notice", func(t *testing.T) {
 		// --- Given ---
-		msg0 := New("header0")
-		msg1 := New("header1").Want("%d", 11).Have("%d", 7)
-		msg := Join(msg0, msg1)
+		t.Cleanup(func() { SetTexts(nil) })
+		SetTexts(map[string]string{
+			"expected values to be equal": "mismatch at",
+			"want":                        "expected",
+			"have":                        "actual",
+		})
+		msg := New("expected values to be equal").
+			SetTrail("T.Field").
+			Want("%d", 1).
+			Have("%d", 2)
 
 		// --- When ---
 		have := msg.Error()
 
 		// --- Then ---
 		want := "" +
-			"multiple expectations violated:\n" +
-			"  error: header0\n" +
-			"      ---\n" +
-			"  error: header1\n" +
-			"   want: 11\n" +
-			"   have: 7"
+			"mismatch at:\n" +
+			"     trail: T.Field\n" +
+			"  expected: 1\n" +
+			"    actual: 2"
 		affirm.Equal(t, want, have)
+		affirm.Equal(t, "expected values to be equal", msg.Header)
 	})
 
-	t.Run("joined with empty error row", func(t *testing.T) {
+	t.Run("multiple notices", func(t *testing.T) {
 		// --- Given ---
-		msg0 := New("").Append("name", "%s", "value")
-		msg1 := New("header0").Want("%d", 42).Have("%d", 44)
-		msg2 := New("header1").Want("%d", 11).Have("%d", 7)
-		msg := Join(msg0, msg1, msg2)
+		t.Cleanup(func() { SetTexts(nil) })
+		SetTexts(map[string]string{
+			multiHeader: "failures",
+			"error":     "failure",
+			"header0":   "first",
+		})
+		msg := New("header1").Want("%d", 1).Chain(New("header0"))
 
 		// --- When ---
 		have := msg.Error()
 
 		// --- Then ---
 		want := "" +
-			"multiple expectations violated:\n" +
-			"   name: value\n" +
-			"      ---\n" +
-			"  error: header0\n" +
-			"   want: 42\n" +
-			"   have: 44\n" +
+			"failures:\n" +
+			"  failure: first\n" +
+			"        ---\n" +
+			"  failure: header1\n" +
+			"     want: 1"
+		affirm.Equal(t, want, have)
+	})
+}
+
+func Test_Notice_Error_want_have_texts(t *testing.T) {
+	t.Run("renamed rows keep the canonical order", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { SetTexts(nil) })
+		SetTexts(map[string]string{"want": "expected", "have": "actual"})
+		msg := New("header").
+			Append("hint", "%s", "text").
+			Have("%d", 2).
+			Want("%d", 1).
+			SortRows()
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"header:\n" +
+			"  expected: 1\n" +
+			"    actual: 2\n" +
+			"      hint: text"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("renamed rows keep colors", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { SetTexts(nil); DisableColor() })
+		SetTexts(map[string]string{"want": "expected", "have": "actual"})
+		color.Store(true)
+		msg := New("header").Want("%d", 1).Have("%d", 2)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "\x1b[31mheader\x1b[0m:\n" +
+			"  expected: \x1b[32m1\x1b[0m\n" +
+			"    actual: \x1b[31m2\x1b[0m"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("JSON uses canonical names", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { SetTexts(nil) })
+		SetTexts(map[string]string{"want": "expected", "have": "actual"})
+		msg := New("header").Want("%d", 1).Have("%d", 2)
+
+		// --- When ---
+		have, err := json.Marshal(msg)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		want := `{"header":"header","rows":[` +
+			`{"name":"want","value":"1"},{"name":"have","value":"2"}]}`
+		affirm.Equal(t, want, string(have))
+	})
+}
+
+func Test_Notice_Error_color(t *testing.T) {
+	t.Run("single notice", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(DisableColor)
+		color.Store(true)
+		msg := New("header").
+			SetTrail("T.Field").
+			Want("%s", "a").
+			Have("%s", "b").
+			Append("other", "%s", "c")
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "\x1b[31mheader\x1b[0m:\n" +
+			"  trail: \x1b[2mT.Field\x1b[0m\n" +
+			"   want: \x1b[32ma\x1b[0m\n" +
+			"   have: \x1b[31mb\x1b[0m\n" +
+			"  other: c"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("multiple notices", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(DisableColor)
+		color.Store(true)
+		msg := New("header1").Chain(New("header0"))
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "\x1b[31mmultiple expectations violated\x1b[0m:\n" +
+			"  error: \x1b[31mheader0\x1b[0m\n" +
 			"      ---\n" +
-			"  error: header1\n" +
-			"   want: 11\n" +
-			"   have: 7"
+			"  error: \x1b[31mheader1\x1b[0m"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		// --- Given ---
+		DisableColor()
+		msg := New("header").Want("%s", "a")
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		affirm.Equal(t, "header:\n  want: a", have)
+	})
+}
+
+func Test_Notice_Error_optional_rows(t *testing.T) {
+	t.Run("empty optional row is not rendered", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").
+			Want("%d", 42).
+			Have("%d", 44).
+			AppendOptional("longer", "%s", "")
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"header:\n" +
+			"  want: 42\n" +
+			"  have: 44"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("not empty optional row is rendered", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").
+			Want("%d", 42).
+			Have("%d", 44).
+			AppendOptional("longer", "%s", "value")
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "" +
+			"header:\n" +
+			"    want: 42\n" +
+			"    have: 44\n" +
+			"  longer: value"
 		affirm.Equal(t, want, have)
 	})
+
+	t.Run("lazy values", func(t *testing.T) {
+		// --- Given ---
+		var calls int
+		fn := func() string { calls++; return "42" }
+		msg := New("header").Want("%s", Lazy(fn))
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		affirm.Equal(t, "header:\n  want: 42", have)
+		affirm.Equal(t, 1, calls)
+	})
+}
+
+func Test_Notice_MarshalJSON(t *testing.T) {
+	t.Run("header only", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header")
+
+		// --- When ---
+		have, err := json.Marshal(msg)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, `{"header":"header","rows":[]}`, string(have))
+	})
+
+	t.Run("with trail and rows", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").
+			SetTrail("T.Field").
+			Want("%d", 1).
+			Have("%q", "a\nb").
+			AppendOptional("opt", "")
+
+		// --- When ---
+		have, err := json.Marshal(msg)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		want := `{"header":"header","trail":"T.Field","rows":[` +
+			`{"name":"want","value":"1"},` +
+			`{"name":"have","value":"\"a\\nb\""}]}`
+		affirm.Equal(t, want, string(have))
+	})
+
+	t.Run("with code", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").Code("json.parse-error")
+
+		// --- When ---
+		have, err := json.Marshal(msg)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		want := `{"header":"header","code":"json.parse-error","rows":[]}`
+		affirm.Equal(t, want, string(have))
+	})
+
+	t.Run("with footer", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").SetFooter("footer")
+
+		// --- When ---
+		have, err := json.Marshal(msg)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		want := `{"header":"header","rows":[],"footer":"footer"}`
+		affirm.Equal(t, want, string(have))
+	})
+
+	t.Run("chained notices are not serialized", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header1").Chain(New("header0"))
+
+		// --- When ---
+		have, err := json.Marshal(msg)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, `{"header":"header1","rows":[]}`, string(have))
+	})
 }
 
 func Test_Notice_MetaSet(t *testing.T) {
@@ -758,6 +1944,55 @@ func Test_Notice_MetaLookup(t *testing.T) {
 	})
 }
 
+func Test_MetaFrom(t *testing.T) {
+	t.Run("notice", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").MetaSet(MetaWant, 1).MetaSet(MetaHave, 2)
+
+		// --- When ---
+		have := MetaFrom(msg)
+
+		// --- Then ---
+		affirm.DeepEqual(t, map[string]any{"want": 1, "have": 2}, have)
+	})
+
+	t.Run("wrapped notice", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").MetaSet("key", "val")
+		err := fmt.Errorf("wrap: %w", msg)
+
+		// --- When ---
+		have := MetaFrom(err)
+
+		// --- Then ---
+		affirm.DeepEqual(t, map[string]any{"key": "val"}, have)
+	})
+
+	t.Run("notice without metadata", func(t *testing.T) {
+		// --- When ---
+		have := MetaFrom(New("header"))
+
+		// --- Then ---
+		affirm.Nil(t, have)
+	})
+
+	t.Run("not notice", func(t *testing.T) {
+		// --- When ---
+		have := MetaFrom(errors.New("test"))
+
+		// --- Then ---
+		affirm.Nil(t, have)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		// --- When ---
+		have := MetaFrom(nil)
+
+		// --- Then ---
+		affirm.Nil(t, have)
+	})
+}
+
 func Test_Notice_longest(t *testing.T) {
 	t.Run("empty trail", func(t *testing.T) {
 		// --- Given ---
@@ -810,6 +2045,23 @@ func Test_Notice_longest(t *testing.T) {
 		// --- Then ---
 		affirm.DeepEqual(t, 9, have)
 	})
+
+	t.Run("empty optional rows are ignored", func(t *testing.T) {
+		// --- Given ---
+		msg := &Notice{
+			Rows: []Row{
+				{Name: "a"},
+				{Name: "long-name", Optional: true},
+				{Name: "aa"},
+			},
+		}
+
+		// --- When ---
+		have := msg.longest()
+
+		// --- Then ---
+		affirm.DeepEqual(t, 2, have)
+	})
 }
 
 func Test_Notice_Chain(t *testing.T) {
@@ -989,3 +2241,55 @@ func Test_Join(t *testing.T) {
 		affirm.Equal(t, true, core.Same(msg1.prev, msg0))
 	})
 }
+
+func Test_ToJSON(t *testing.T) {
+	t.Run("nil error", func(t *testing.T) {
+		// --- When ---
+		have, err := ToJSON(nil)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, "[]", string(have))
+	})
+
+	t.Run("notice", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").Want("%d", 1)
+
+		// --- When ---
+		have, err := ToJSON(msg)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		want := `[{"header":"header","rows":[{"name":"want","value":"1"}]}]`
+		affirm.Equal(t, want, string(have))
+	})
+
+	t.Run("joined notices", func(t *testing.T) {
+		// --- Given ---
+		e := Join(New("header0"), New("header1"))
+
+		// --- When ---
+		have, err := ToJSON(e)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		want := `[{"header":"header0","rows":[]},` +
+			`{"header":"header1","rows":[]}]`
+		affirm.Equal(t, want, string(have))
+	})
+
+	t.Run("errors joined with errors.Join", func(t *testing.T) {
+		// --- Given ---
+		e := errors.Join(New("header0"), errors.New("std"))
+
+		// --- When ---
+		have, err := ToJSON(e)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		want := `[{"header":"header0","rows":[]},` +
+			`{"header":"assertion error","rows":[]}]`
+		affirm.Equal(t, want, string(have))
+	})
+}
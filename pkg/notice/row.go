@@ -5,6 +5,7 @@ package notice
 
 import (
 	"fmt"
+	"sync"
 )
 
 // Row represents [Notice] row.
@@ -12,6 +13,9 @@ type Row struct {
 	Name   string
 	Format string
 	Args   []any
+
+	// When set to true, the row is not rendered when its value is empty.
+	Optional bool
 }
 
 // NewRow is constructor function for [Row].
@@ -26,3 +30,23 @@ func (r Row) String() string { return fmt.Sprintf(r.Format, r.Args...) }
 func (r Row) PadName(length int) string {
 	return Pad(r.Name, length)
 }
+
+// Lazy returns a [fmt.Stringer] which calls the "fn" function the first time
+// its String method is called and returns the cached value for all subsequent
+// calls. Use it as a [Row] argument to postpone expensive formatting until the
+// [Notice] message is rendered.
+func Lazy(fn func() string) fmt.Stringer {
+	return &lazy{fn: fn}
+}
+
+// lazy implements [fmt.Stringer] returning a lazily computed string.
+type lazy struct {
+	once sync.Once     // Guards the computation.
+	fn   func() string // Function computing the value.
+	val  string        // Computed value.
+}
+
+func (l *lazy) String() string {
+	l.once.Do(func() { l.val = l.fn(); l.fn = nil })
+	return l.val
+}
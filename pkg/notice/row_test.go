@@ -94,3 +94,31 @@ func Test_PaddedName_tabular(t *testing.T) {
 		})
 	}
 }
+
+func Test_Lazy(t *testing.T) {
+	t.Run("value is computed once", func(t *testing.T) {
+		// --- Given ---
+		var calls int
+		fn := func() string { calls++; return "value" }
+
+		// --- When ---
+		have := Lazy(fn)
+
+		// --- Then ---
+		affirm.Equal(t, 0, calls)
+		affirm.Equal(t, "value", have.String())
+		affirm.Equal(t, "value", have.String())
+		affirm.Equal(t, 1, calls)
+	})
+
+	t.Run("used as row argument", func(t *testing.T) {
+		// --- Given ---
+		row := NewRow("name", "this %s", Lazy(func() string { return "that" }))
+
+		// --- When ---
+		have := row.String()
+
+		// --- Then ---
+		affirm.Equal(t, "this that", have)
+	})
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package notice
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// module is the import path prefix of this module's packages.
+const module = "github.com/ctx42/testing/"
+
+// callStack returns the call stack of the calling goroutine with frames of
+// the Go runtime, the "testing" package, and non-test files of this module
+// skipped. Each frame is rendered as the function name followed by the
+// indented file and line. The skip argument is the number of stack frames
+// to skip, with 0 identifying the frame for [runtime.Callers] itself.
+func callStack(skip int) string {
+	pcs := make([]uintptr, 64)
+	pcs = pcs[:runtime.Callers(skip, pcs)]
+
+	var lines []string
+	frames := runtime.CallersFrames(pcs)
+	for {
+		frm, more := frames.Next()
+		if !skipFrame(frm) {
+			lines = append(
+				lines,
+				frm.Function,
+				fmt.Sprintf("    %s:%d", frm.File, frm.Line),
+			)
+		}
+		if !more {
+			break
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// callSource returns the "file:line" of the first frame which is not skipped
+// by [skipFrame] in the call stack of the calling goroutine. Returns an empty
+// string if there is no such frame. The skip argument has the same meaning as
+// for [callStack].
+func callSource(skip int) string {
+	pcs := make([]uintptr, 64)
+	pcs = pcs[:runtime.Callers(skip, pcs)]
+
+	frames := runtime.CallersFrames(pcs)
+	for {
+		frm, more := frames.Next()
+		if !skipFrame(frm) {
+			return fmt.Sprintf("%s:%d", frm.File, frm.Line)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// skipFrame returns true if the frame should not be included in the call
+// stack recorded by notices.
+func skipFrame(frm runtime.Frame) bool {
+	fn := frm.Function
+	if strings.HasPrefix(fn, "runtime.") || strings.HasPrefix(fn, "testing.") {
+		return true
+	}
+	if strings.HasPrefix(fn, module) {
+		return !strings.HasSuffix(frm.File, "_test.go")
+	}
+	return false
+}
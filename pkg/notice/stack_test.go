@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package notice
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+)
+
+func Test_callStack(t *testing.T) {
+	// --- When ---
+	have := callStack(1)
+
+	// --- Then ---
+	lines := strings.Split(have, "\n")
+	affirm.Equal(t, 2, len(lines))
+	wFn := "github.com/ctx42/testing/pkg/notice.Test_callStack"
+	affirm.Equal(t, wFn, lines[0])
+	affirm.Equal(t, true, strings.HasPrefix(lines[1], "    /"))
+	affirm.Equal(t, true, strings.HasSuffix(lines[1], "stack_test.go:16"))
+}
+
+func Test_callSource(t *testing.T) {
+	// --- When ---
+	have := callSource(1)
+
+	// --- Then ---
+	affirm.Equal(t, true, strings.HasPrefix(have, "/"))
+	affirm.Equal(t, true, strings.HasSuffix(have, "stack_test.go:29"))
+}
+
+func Test_skipFrame_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		fn   string
+		file string
+		want bool
+	}{
+		{"runtime", "runtime.goexit", "/go/asm.s", true},
+		{"testing", "testing.tRunner", "/go/testing.go", true},
+		{"module", module + "pkg/check.Equal", "/m/equal.go", true},
+		{"module test", module + "pkg/check.Test", "/m/equal_test.go", false},
+		{"other", "example.com/pkg.Helper", "/m/helper.go", false},
+		{"other test", "example.com/pkg.Test", "/m/pkg_test.go", false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			frm := runtime.Frame{Function: tc.fn, File: tc.file}
+
+			// --- When ---
+			have := skipFrame(frm)
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package notice
+
+import (
+	"maps"
+	"sync/atomic"
+)
+
+// texts holds replacements for headers and row names. See [SetTexts].
+var texts atomic.Pointer[map[string]string]
+
+// SetTexts sets global replacements for the notice headers and row names used
+// when notices are rendered by [Notice.Error]. The map keys are the default
+// texts used by checkers, for example, "expected values to be equal", "want"
+// or "have", and the values are their replacements. It allows teams to
+// standardize the wording across all checkers without forking them. Only
+// exact matches are replaced. The [Notice] fields and the JSON representation
+// are not changed. Calling it with nil or an empty map removes replacements.
+//
+// Example:
+//
+//	notice.SetTexts(map[string]string{
+//	    "want": "expected",
+//	    "have": "actual",
+//	})
+func SetTexts(txt map[string]string) {
+	if len(txt) == 0 {
+		texts.Store(nil)
+		return
+	}
+	txt = maps.Clone(txt)
+	texts.Store(&txt)
+}
+
+// Text returns the replacement of the header or row name set with [SetTexts].
+// Returns the "str" when it has no replacement.
+func Text(str string) string {
+	if txt := texts.Load(); txt != nil {
+		if rep, ok := (*txt)[str]; ok {
+			return rep
+		}
+	}
+	return str
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package notice
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+)
+
+func Test_SetTexts(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { SetTexts(nil) })
+		txt := map[string]string{"want": "expected"}
+
+		// --- When ---
+		SetTexts(txt)
+
+		// --- Then ---
+		txt["want"] = "changed"
+		affirm.Equal(t, "expected", Text("want"))
+	})
+
+	t.Run("nil removes replacements", func(t *testing.T) {
+		// --- Given ---
+		SetTexts(map[string]string{"want": "expected"})
+
+		// --- When ---
+		SetTexts(nil)
+
+		// --- Then ---
+		affirm.Nil(t, texts.Load())
+	})
+
+	t.Run("empty removes replacements", func(t *testing.T) {
+		// --- Given ---
+		SetTexts(map[string]string{"want": "expected"})
+
+		// --- When ---
+		SetTexts(map[string]string{})
+
+		// --- Then ---
+		affirm.Nil(t, texts.Load())
+	})
+}
+
+func Test_Text(t *testing.T) {
+	t.Run("no replacements", func(t *testing.T) {
+		// --- When ---
+		have := Text("want")
+
+		// --- Then ---
+		affirm.Equal(t, "want", have)
+	})
+
+	t.Run("replaced", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { SetTexts(nil) })
+		SetTexts(map[string]string{"want": "expected"})
+
+		// --- When ---
+		have := Text("want")
+
+		// --- Then ---
+		affirm.Equal(t, "expected", have)
+	})
+
+	t.Run("not replaced", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { SetTexts(nil) })
+		SetTexts(map[string]string{"want": "expected"})
+
+		// --- When ---
+		have := Text("have")
+
+		// --- Then ---
+		affirm.Equal(t, "have", have)
+	})
+}
@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package notice
+
+import (
+	"strings"
+)
+
+// SegmentKind represents a kind of the [Trail] segment.
+type SegmentKind int
+
+// Kinds of [Trail] segments.
+const (
+	SegField SegmentKind = iota // Type or struct field name, e.g. "Field".
+	SegIndex                    // Slice or array index, e.g. "[1]".
+	SegKey                      // Map key, e.g. `["A"]`.
+)
+
+// Segment represents a single element of the [Trail].
+type Segment struct {
+	Kind SegmentKind // The segment kind.
+
+	// The type or field name, the index, or the map key representation (for
+	// example, `"A"`) without the brackets.
+	Name string
+}
+
+// Trail represents a trail (path) to the field, element, or key as a
+// sequence of segments. It's the structured version of [Notice.Trail], which
+// can be used to operate on trails without fragile string manipulation.
+type Trail []Segment
+
+// ParseTrail parses the trail in the format used by [Notice.Trail], for
+// example, `Type.Field[1]["A"]`. The bracket segments with digits only are
+// parsed as indexes, other bracket segments are parsed as map keys. The
+// "map" prefix, used in trails for map keys without a field name, for
+// example, `map["A"]` or `[1]map["A"]`, is not a separate segment.
+func ParseTrail(str string) Trail {
+	var tr Trail
+	for i := 0; i < len(str); {
+		switch str[i] {
+		case '.':
+			i++
+
+		case '[':
+			end := bracketEnd(str, i)
+			name := str[i+1 : end]
+			kind := SegKey
+			if isIndex(name) {
+				kind = SegIndex
+			}
+			if isMapPrefix(str, i) {
+				tr = tr[:len(tr)-1]
+				kind = SegKey
+			}
+			tr = append(tr, Segment{Kind: kind, Name: name})
+			i = min(end+1, len(str))
+
+		default:
+			end := i
+			for end < len(str) && str[end] != '.' && str[end] != '[' {
+				end++
+			}
+			tr = append(tr, Segment{Kind: SegField, Name: str[i:end]})
+			i = end
+		}
+	}
+	return tr
+}
+
+// String returns the trail in the format used by [Notice.Trail].
+func (tr Trail) String() string {
+	buf := &strings.Builder{}
+	for i, seg := range tr {
+		switch seg.Kind {
+		case SegField:
+			if i > 0 {
+				buf.WriteByte('.')
+			}
+			buf.WriteString(seg.Name)
+			continue
+
+		case SegKey:
+			if i == 0 || tr[i-1].Kind != SegField {
+				buf.WriteString("map")
+			}
+		}
+		buf.WriteByte('[')
+		buf.WriteString(seg.Name)
+		buf.WriteByte(']')
+	}
+	return buf.String()
+}
+
+// Parent returns the trail without the last segment. Returns nil for empty
+// trail and trails with one segment.
+func (tr Trail) Parent() Trail {
+	if len(tr) < 2 {
+		return nil
+	}
+	return tr[:len(tr)-1]
+}
+
+// Match returns true if the trail matches the pattern. The pattern is a trail
+// where the "*" matches any sequence of characters and the "?" matches any
+// single character in a segment name. The "**" segment matches zero or more
+// segments. The bracket segments in the pattern match both indexes and map
+// keys.
+//
+// Example patterns:
+//
+//	Type.Field
+//	Type.Users[*].Password
+//	**.Password
+//	Type.Pass*
+func (tr Trail) Match(pattern string) bool {
+	return matchTrail(ParseTrail(pattern), tr)
+}
+
+// matchTrail returns true if the trail matches the pattern trail.
+func matchTrail(pat, tr Trail) bool {
+	if len(pat) == 0 {
+		return len(tr) == 0
+	}
+	if pat[0].Kind == SegField && pat[0].Name == "**" {
+		for i := 0; i <= len(tr); i++ {
+			if matchTrail(pat[1:], tr[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(tr) == 0 || !pat[0].match(tr[0]) {
+		return false
+	}
+	return matchTrail(pat[1:], tr[1:])
+}
+
+// match returns true if the segment used as a pattern matches the other
+// segment. The field segments match only field segments, and the bracket
+// segments match both indexes and map keys.
+func (seg Segment) match(other Segment) bool {
+	if (seg.Kind == SegField) != (other.Kind == SegField) {
+		return false
+	}
+	return globMatch(seg.Name, other.Name)
+}
+
+// globMatch returns true if the string matches the pattern, where "*" matches
+// any sequence of characters and "?" matches any single character.
+func globMatch(pat, str string) bool {
+	for pat != "" {
+		switch pat[0] {
+		case '*':
+			for i := 0; i <= len(str); i++ {
+				if globMatch(pat[1:], str[i:]) {
+					return true
+				}
+			}
+			return false
+
+		case '?':
+			if str == "" {
+				return false
+			}
+
+		default:
+			if str == "" || str[0] != pat[0] {
+				return false
+			}
+		}
+		pat, str = pat[1:], str[1:]
+	}
+	return str == ""
+}
+
+// bracketEnd returns the index of the bracket closing the one at the "start"
+// index. Brackets in quoted map keys are skipped. Returns the length of the
+// string when the bracket is not closed.
+func bracketEnd(str string, start int) int {
+	for i := start + 1; i < len(str); i++ {
+		switch str[i] {
+		case ']':
+			return i
+		case '"', '\'':
+			quote := str[i]
+			for i++; i < len(str) && str[i] != quote; i++ {
+				if str[i] == '\\' {
+					i++
+				}
+			}
+		}
+	}
+	return len(str)
+}
+
+// isIndex returns true if the string is not empty and has only digits.
+func isIndex(str string) bool {
+	if str == "" {
+		return false
+	}
+	for _, r := range str {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// isMapPrefix returns true if the bracket at the index "i" is preceded by the
+// "map" prefix at the beginning of the trail or after the other bracket.
+func isMapPrefix(str string, i int) bool {
+	if i < 3 || str[i-3:i] != "map" {
+		return false
+	}
+	return i == 3 || str[i-4] == ']'
+}
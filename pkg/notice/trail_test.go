@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package notice
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+)
+
+func Test_ParseTrail(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		// --- When ---
+		have := ParseTrail("")
+
+		// --- Then ---
+		affirm.Nil(t, have)
+	})
+
+	t.Run("fields indexes and keys", func(t *testing.T) {
+		// --- When ---
+		have := ParseTrail(`Type.Field[1]["A"].Other`)
+
+		// --- Then ---
+		want := Trail{
+			{Kind: SegField, Name: "Type"},
+			{Kind: SegField, Name: "Field"},
+			{Kind: SegIndex, Name: "1"},
+			{Kind: SegKey, Name: `"A"`},
+			{Kind: SegField, Name: "Other"},
+		}
+		affirm.DeepEqual(t, want, have)
+	})
+
+	t.Run("map prefix", func(t *testing.T) {
+		// --- When ---
+		have := ParseTrail(`map[1][2]map["A"]`)
+
+		// --- Then ---
+		want := Trail{
+			{Kind: SegKey, Name: "1"},
+			{Kind: SegIndex, Name: "2"},
+			{Kind: SegKey, Name: `"A"`},
+		}
+		affirm.DeepEqual(t, want, have)
+	})
+
+	t.Run("field named map", func(t *testing.T) {
+		// --- When ---
+		have := ParseTrail(`T.map[1]`)
+
+		// --- Then ---
+		want := Trail{
+			{Kind: SegField, Name: "T"},
+			{Kind: SegField, Name: "map"},
+			{Kind: SegIndex, Name: "1"},
+		}
+		affirm.DeepEqual(t, want, have)
+	})
+
+	t.Run("brackets in quoted keys", func(t *testing.T) {
+		// --- When ---
+		have := ParseTrail(`m["a]\"b"].F`)
+
+		// --- Then ---
+		want := Trail{
+			{Kind: SegField, Name: "m"},
+			{Kind: SegKey, Name: `"a]\"b"`},
+			{Kind: SegField, Name: "F"},
+		}
+		affirm.DeepEqual(t, want, have)
+	})
+
+	t.Run("not closed bracket", func(t *testing.T) {
+		// --- When ---
+		have := ParseTrail(`T[1`)
+
+		// --- Then ---
+		want := Trail{
+			{Kind: SegField, Name: "T"},
+			{Kind: SegIndex, Name: "1"},
+		}
+		affirm.DeepEqual(t, want, have)
+	})
+}
+
+func Test_Trail_String_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		trail string
+	}{
+		{"empty", ""},
+		{"type", "Type"},
+		{"fields", "Type.Field.Other"},
+		{"index", "Type.Field[1].Other"},
+		{"key", `Type.Field["A"].Other`},
+		{"map key", `map[1]`},
+		{"map key after index", `[1]map["A"]`},
+		{"slice index", `<slice>[1][2]`},
+		{"only index", `[1]`},
+		{"field after index", `[1].Field`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := ParseTrail(tc.trail).String()
+
+			// --- Then ---
+			affirm.Equal(t, tc.trail, have)
+		})
+	}
+}
+
+func Test_Trail_Parent(t *testing.T) {
+	t.Run("parent", func(t *testing.T) {
+		// --- When ---
+		have := ParseTrail(`Type.Field["A"]`).Parent()
+
+		// --- Then ---
+		affirm.Equal(t, "Type.Field", have.String())
+	})
+
+	t.Run("single segment", func(t *testing.T) {
+		// --- When ---
+		have := ParseTrail("Type").Parent()
+
+		// --- Then ---
+		affirm.Nil(t, have)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// --- When ---
+		have := Trail(nil).Parent()
+
+		// --- Then ---
+		affirm.Nil(t, have)
+	})
+}
+
+func Test_Trail_Match_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		trail   string
+		pattern string
+		want    bool
+	}{
+		{"exact", "Type.Field", "Type.Field", true},
+		{"different", "Type.Field", "Type.Other", false},
+		{"prefix only", "Type.Field.Other", "Type.Field", false},
+		{"star in name", "Type.Password", "Type.Pass*", true},
+		{"question mark", "Type.F1", "Type.F?", true},
+		{"any index", "T.Users[2].Pass", "T.Users[*].Pass", true},
+		{"any key", `T.Users["bob"].Pass`, "T.Users[*].Pass", true},
+		{"index is not field", "T[1]", "T.*", false},
+		{"double star", "T.A[1].B.Pass", "**.Pass", true},
+		{"double star zero segments", "Pass", "**.Pass", true},
+		{"double star in the middle", "T.A.B.Pass", "T.**.Pass", true},
+		{"double star no match", "T.A.B.Name", "T.**.Pass", false},
+		{"empty", "", "", true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := ParseTrail(tc.trail).Match(tc.pattern)
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
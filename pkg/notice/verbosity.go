@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package notice
+
+import (
+	"strings"
+)
+
+// Verbosity represents the level of details in failure messages.
+type Verbosity int
+
+// Verbosity levels.
+const (
+	// Normal renders the header, trail, and all rows (default).
+	Normal Verbosity = iota
+
+	// Quiet renders a one-line summary with headers and trails only.
+	Quiet
+
+	// Verbose renders notices like [Normal], checkers may add more details,
+	// for example, full value dumps or logs of visited trails.
+	Verbose
+)
+
+// String returns the verbosity level name.
+func (v Verbosity) String() string {
+	switch v {
+	case Quiet:
+		return "quiet"
+	case Verbose:
+		return "verbose"
+	default:
+		return "normal"
+	}
+}
+
+// SetVerbosity sets the verbosity level used when the notice is rendered by
+// [Notice.Error]. Implements fluent interface.
+func (msg *Notice) SetVerbosity(v Verbosity) *Notice {
+	msg.verbosity = v
+	return msg
+}
+
+// summary returns a one-line summary of all notices in the chain, used when
+// rendering with the [Quiet] verbosity level.
+func (msg *Notice) summary() string {
+	mgs := msg.collect()
+	parts := make([]string, 0, len(mgs))
+	for _, m := range mgs {
+		part := colorize(colorRed, Text(m.Header))
+		if m.Trail != "" {
+			tr := colorize(colorDim, m.Trail)
+			part += " (" + Text(trail) + ": " + tr + ")"
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package notice
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+)
+
+func Test_Verbosity_String_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		v    Verbosity
+		want string
+	}{
+		{"normal", Normal, "normal"},
+		{"quiet", Quiet, "quiet"},
+		{"verbose", Verbose, "verbose"},
+		{"unknown", Verbosity(42), "normal"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := tc.v.String()
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_Notice_SetVerbosity(t *testing.T) {
+	// --- Given ---
+	msg := New("header")
+
+	// --- When ---
+	have := msg.SetVerbosity(Quiet)
+
+	// --- Then ---
+	affirm.Equal(t, true, msg == have)
+	affirm.Equal(t, Quiet, msg.verbosity)
+}
+
+func Test_Notice_Error_verbosity(t *testing.T) {
+	t.Run("quiet", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").Want("%d", 1).Have("%d", 2)
+		msg.SetVerbosity(Quiet)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		affirm.Equal(t, "header", have)
+	})
+
+	t.Run("quiet with trail", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").SetTrail("type.field").Want("%d", 1)
+		msg.SetVerbosity(Quiet)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		affirm.Equal(t, "header (trail: type.field)", have)
+	})
+
+	t.Run("quiet multiple notices", func(t *testing.T) {
+		// --- Given ---
+		msg0 := New("header 0").SetTrail("type.field0").Want("%d", 1)
+		msg1 := New("header 1").Want("%d", 2)
+		msg := msg1.Chain(msg0).SetVerbosity(Quiet)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		affirm.Equal(t, "header 0 (trail: type.field0); header 1", have)
+	})
+
+	t.Run("quiet with colors and texts", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { color.Store(false); SetTexts(nil) })
+		color.Store(true)
+		SetTexts(map[string]string{"header": "H", "trail": "at"})
+		msg := New("header").SetTrail("type.field").SetVerbosity(Quiet)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		want := "\x1b[31mH\x1b[0m (at: \x1b[2mtype.field\x1b[0m)"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("verbose", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header").Want("%d", 1).SetVerbosity(Verbose)
+
+		// --- When ---
+		have := msg.Error()
+
+		// --- Then ---
+		affirm.Equal(t, "header:\n  want: 1", have)
+	})
+}
@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package require
+
+import (
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// True asserts "have" is true. On failure, it marks the test as failed, writes
+// an error message to the test log and stops the test execution.
+func True(t tester.Minimal, have bool, opts ...check.Option) {
+	t.Helper()
+	if e := check.True(have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// False asserts "have" is false. On failure, it marks the test as failed,
+// writes an error message to the test log and stops the test execution.
+func False(t tester.Minimal, have bool, opts ...check.Option) {
+	t.Helper()
+	if err := check.False(have, opts...); err != nil {
+		t.Fatal(check.Report(err, opts...))
+	}
+}
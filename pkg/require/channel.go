@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package require
+
+import (
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// ChannelWillClose asserts the channel will be closed "within" a given time
+// duration. On failure, it marks the test as failed, writes an error message to
+// the test log and stops the test execution.
+//
+// The "within" may represent duration in the form of a string, int, int64 or
+// [time.Duration].
+func ChannelWillClose[C any](
+	t tester.Minimal,
+	within any,
+	c <-chan C,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if err := check.ChannelWillClose(within, c, opts...); err != nil {
+		t.Fatal(check.Report(err, opts...))
+	}
+}
+
+// ChannelClosed asserts channel "ch" is closed. On failure, it marks the test
+// as failed, writes an error message to the test log and stops the test
+// execution. See [check.ChannelClosed] for details.
+func ChannelClosed(t tester.Minimal, ch any, opts ...check.Option) {
+	t.Helper()
+	if e := check.ChannelClosed(ch, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// ChannelEmpty asserts channel "ch" has no buffered values. On failure, it
+// marks the test as failed, writes an error message to the test log and stops
+// the test execution.
+func ChannelEmpty(t tester.Minimal, ch any, opts ...check.Option) {
+	t.Helper()
+	if e := check.ChannelEmpty(ch, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// ChannelReceives asserts a value equal to "want" is received from channel "ch"
+// within the "timeout" duration. On failure, it marks the test as failed,
+// writes an error message to the test log and stops the test execution.
+//
+// The "timeout" may represent duration in the form of a string, int, int64 or
+// [time.Duration].
+func ChannelReceives(
+	t tester.Minimal,
+	want, ch, timeout any,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if e := check.ChannelReceives(want, ch, timeout, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package require
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_ChannelWillClose(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		c := make(chan struct{})
+		done := make(chan struct{})
+
+		// --- When ---
+		go func() { ChannelWillClose(tspy, "1s", c); close(done) }()
+
+		// --- Then ---
+		close(c)
+		<-done
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		c := make(chan struct{})
+		defer close(c)
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { ChannelWillClose(tspy, "1s", c) })
+
+		// --- Then ---
+		tspy.Finish().AssertExpectations()
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("   trail: type.field")
+		tspy.Close()
+
+		c := make(chan struct{})
+		defer close(c)
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { ChannelWillClose(tspy, "1s", c, opt) })
+
+		// --- Then ---
+		tspy.Finish().AssertExpectations()
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+}
+
+func Test_ChannelClosed(t *testing.T) {
+	closed := make(chan int)
+	close(closed)
+
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		ChannelClosed(tspy, closed)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { ChannelClosed(tspy, make(chan int)) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		msg := affirm.Panic(t, func() {
+			ChannelClosed(tspy, make(chan int), opt)
+		})
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+}
+
+func Test_ChannelEmpty(t *testing.T) {
+	full := make(chan int, 1)
+	full <- 1
+
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		ChannelEmpty(tspy, make(chan int, 1))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { ChannelEmpty(tspy, full) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { ChannelEmpty(tspy, full, opt) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+}
+
+func Test_ChannelReceives(t *testing.T) {
+	one := func() chan int {
+		c := make(chan int, 1)
+		c <- 1
+		return c
+	}
+
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		ChannelReceives(tspy, 1, one(), "1s")
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { ChannelReceives(tspy, 2, one(), "1s") })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		msg := affirm.Panic(t, func() {
+			ChannelReceives(tspy, 2, one(), "1s", opt)
+		})
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+}
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package require
+
+import (
+	"cmp"
+
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// Len asserts "have" has "want" length. On failure, it marks the test as
+// failed, writes an error message to the test log and stops the test execution.
+func Len(t tester.Minimal, want int, have any, opts ...check.Option) {
+	t.Helper()
+	if e := check.Len(want, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// Cap asserts "have" has "want" capacity. On failure, it marks the test as
+// failed, writes an error message to the test log and stops the test execution.
+func Cap(t tester.Minimal, want int, have any, opts ...check.Option) {
+	t.Helper()
+	if e := check.Cap(want, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// Has asserts the slice has "want" value. On failure, it marks the test as
+// failed, writes an error message to the test log and stops the test execution.
+func Has[T comparable](
+	t tester.Minimal,
+	want T,
+	bag []T,
+	opts ...check.Option,
+) {
+	t.Helper()
+	if e := check.Has(want, bag, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// HasNo asserts slice does not have a "want" value. On failure, it marks the
+// test as failed, writes an error message to the test log and stops the test
+// execution.
+func HasNo[T comparable](
+	t tester.Minimal,
+	want T,
+	bag []T,
+	opts ...check.Option,
+) {
+	t.Helper()
+	if e := check.HasNo(want, bag, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// HasKey asserts the map has a key and returns its value. On failure, it marks
+// the test as failed, writes an error message to the test log and stops the
+// test execution.
+func HasKey[K comparable, V any](
+	t tester.Minimal,
+	key K,
+	set map[K]V,
+	opts ...check.Option,
+) V {
+
+	t.Helper()
+	val, e := check.HasKey(key, set, opts...)
+	if e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+	return val
+}
+
+// HasNoKey asserts the map has no key. On failure, it marks the test as failed,
+// writes an error message to the test log and stops the test execution.
+func HasNoKey[K comparable, V any](
+	t tester.Minimal,
+	key K,
+	set map[K]V,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if e := check.HasNoKey(key, set, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// HasKeyValue asserts the map has a key with the given value. On failure, it
+// marks the test as failed, writes an error message to the test log and stops
+// the test execution.
+func HasKeyValue[K, V comparable](
+	t tester.Minimal,
+	key K,
+	want V,
+	set map[K]V,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if e := check.HasKeyValue(key, want, set, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// SliceSubset asserts the "want" is a subset "have". In other words, all
+// values in the "want" slice must be in the "have" slice. On failure, it marks
+// the test as failed, writes an error message to the test log and stops the
+// test execution.
+func SliceSubset[T comparable](
+	t tester.Minimal,
+	want, have []T,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if e := check.SliceSubset(want, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// MapSubset asserts the "want" is a subset "have". In other words, all keys and
+// their corresponding values in the "want" map must be in the "have" map. It is
+// not an error when the "have" map has some other keys. On failure, it marks
+// the test as failed, writes an error message to the test log and stops the
+// test execution.
+func MapSubset[K cmp.Ordered, V any](
+	t tester.Minimal,
+	want, have map[K]V,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if e := check.MapSubset(want, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// MapsSubset asserts all the "want" maps are subsets of corresponding "have"
+// maps using [MapSubset]. On failure, it marks the test as failed, writes an
+// error message to the test log and stops the test execution.
+func MapsSubset[K cmp.Ordered, V any](
+	t tester.Minimal,
+	want, have []map[K]V,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if e := check.MapsSubset(want, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// SliceSorted asserts the slice or array is sorted according to the "less"
+// function. On failure, it marks the test as failed, writes an error message to
+// the test log and stops the test execution.
+func SliceSorted(
+	t tester.Minimal,
+	have any,
+	less func(i, j int) bool,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if e := check.SliceSorted(have, less, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// Unique asserts the slice or array has no duplicate elements. On failure, it
+// marks the test as failed, writes an error message to the test log and stops
+// the test execution.
+func Unique(t tester.Minimal, have any, opts ...check.Option) {
+	t.Helper()
+	if e := check.Unique(have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
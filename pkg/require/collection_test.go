@@ -0,0 +1,614 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package require
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_Len(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		Len(tspy, 2, []int{0, 1})
+	})
+
+	t.Run("error - want is greater than actual length", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { Len(tspy, 3, []int{0, 1}) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("error - want is less than actual length", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { Len(tspy, 1, []int{0, 1}) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { Len(tspy, 1, []int{0, 1}, opt) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+}
+
+func Test_Cap(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		Cap(tspy, 2, []int{0, 1})
+	})
+
+	t.Run("error - want is greater than actual capacity", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { Cap(tspy, 3, []int{0, 1}) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("error - want is less than actual capacity", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		s := make([]int, 0, 3)
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { Cap(tspy, 2, s) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { Cap(tspy, 1, []int{0, 1}, opt) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+}
+
+func Test_Has(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		val := []int{1, 2, 3}
+
+		// --- When ---
+		Has(tspy, 2, val)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		val := []int{1, 2, 3}
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { Has(tspy, 42, val) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		val := []int{1, 2, 3}
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { Has(tspy, 42, val, opt) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+}
+
+func Test_HasNo(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		val := []int{1, 2, 3}
+
+		// --- When ---
+		HasNo(tspy, 4, val)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		val := []int{1, 2, 3}
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { HasNo(tspy, 2, val) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		val := []int{1, 2, 3}
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { HasNo(tspy, 2, val, opt) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+}
+
+func Test_HasKey(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		val := map[string]int{"A": 1, "B": 2, "C": 3}
+
+		// --- When ---
+		have := HasKey(tspy, "B", val)
+
+		// --- Then ---
+		affirm.Equal(t, 2, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		val := map[string]int{"A": 1, "B": 2, "C": 3}
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { HasKey(tspy, "X", val) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		val := map[string]int{"A": 1, "B": 2, "C": 3}
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { HasKey(tspy, "X", val, opt) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+}
+
+func Test_HasNoKey(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		val := map[string]int{"A": 1, "B": 2, "C": 3}
+
+		// --- When ---
+		HasNoKey(tspy, "D", val)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		val := map[string]int{"A": 1, "B": 2, "C": 3}
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { HasNoKey(tspy, "B", val) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		val := map[string]int{"A": 1, "B": 2, "C": 3}
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { HasNoKey(tspy, "B", val, opt) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+}
+
+func Test_HasKeyValue(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		val := map[string]int{"A": 1, "B": 2, "C": 3}
+
+		// --- When ---
+		HasKeyValue(tspy, "B", 2, val)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		val := map[string]int{"A": 1, "B": 2, "C": 3}
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { HasKeyValue(tspy, "B", 100, val) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		val := map[string]int{"A": 1, "B": 2, "C": 3}
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { HasKeyValue(tspy, "B", 100, val, opt) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+}
+
+func Test_SliceSubset(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		s0 := []string{"A", "B", "C"}
+		s1 := []string{"C", "B", "A"}
+
+		// --- When ---
+		SliceSubset(tspy, s0, s1)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		sWant := []string{"X", "Y", "A", "B", "C"}
+		sHave := []string{"C", "B", "A"}
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { SliceSubset(tspy, sWant, sHave) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("           trail: type.field\n")
+		tspy.Close()
+
+		s0 := []string{"X", "Y", "A", "B", "C"}
+		s1 := []string{"C", "B", "A"}
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { SliceSubset(tspy, s0, s1, opt) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+}
+
+func Test_MapSubset(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		mWant := map[string]string{
+			"KEY0": "VAL0",
+		}
+		mHave := map[string]string{
+			"KEY0": "VAL0",
+			"KEY1": "VAL1",
+		}
+
+		// --- When ---
+		MapSubset(tspy, mWant, mHave)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		m0 := map[string]string{
+			"KEY0": "VAL0",
+			"KEY1": "VAL1",
+			"KEY2": "VAL2",
+		}
+		m1 := map[string]string{
+			"KEY0": "VAL0",
+			"KEY1": "VAL1",
+		}
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { MapSubset(tspy, m0, m1) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		m0 := map[string]string{
+			"KEY0": "VAL0",
+			"KEY1": "VAL1",
+		}
+		m1 := map[string]string{
+			"KEY0": "VAL0",
+		}
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { MapSubset(tspy, m0, m1, opt) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+}
+
+func Test_MapsSubset(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		w0 := []map[string]string{
+			{"KEY0": "VAL0"},
+			{"KEY0": "VAL0", "KEY1": "VAL1"},
+		}
+		w1 := []map[string]string{
+			{"KEY0": "VAL0", "KEY1": "VAL1"},
+			{"KEY0": "VAL0", "KEY1": "VAL1"},
+		}
+
+		// --- When ---
+		MapsSubset(tspy, w0, w1)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		w0 := []map[string]string{
+			{"KEY0": "VAL0", "KEY1": "VAL1", "KEY2": "VAL2"},
+		}
+		w1 := []map[string]string{
+			{"KEY0": "VAL0", "KEY1": "VAL1"},
+		}
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { MapsSubset(tspy, w0, w1) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("  trail: <slice>[0]map[2]\n")
+		tspy.Close()
+
+		w0 := []map[int]int{
+			{1: 10, 2: 20},
+		}
+		w1 := []map[int]int{
+			{1: 10, 2: 200},
+		}
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { MapsSubset(tspy, w0, w1) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+}
+
+func Test_SliceSorted(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		SliceSorted(tspy, []int{1, 2}, lessInt([]int{1, 2}))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		msg := affirm.Panic(t, func() {
+			SliceSorted(tspy, []int{2, 1}, lessInt([]int{2, 1}))
+		})
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("     trail: type.field[1]\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		msg := affirm.Panic(t, func() {
+			SliceSorted(tspy, []int{2, 1}, lessInt([]int{2, 1}), opt)
+		})
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+}
+
+// lessInt returns less function for a slice of integers.
+func lessInt(s []int) func(i, j int) bool {
+	return func(i, j int) bool { return s[i] < s[j] }
+}
+
+func Test_Unique(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		Unique(tspy, []int{1, 2})
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { Unique(tspy, []int{1, 1}) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("       trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { Unique(tspy, []int{1, 1}, opt) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+}
@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package require
+
+import (
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// Empty asserts "have" is empty. On failure, it marks the test as failed,
+// writes an error message to the test log and stops the test execution.
+//
+// See [check.Empty] for the list of values which are considered empty.
+func Empty(t tester.Minimal, have any, opts ...check.Option) {
+	t.Helper()
+	if e := check.Empty(have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// NotEmpty asserts "have" is not empty. On failure, it marks the test as
+// failed, writes an error message to the test log and stops the test execution.
+//
+// See [check.Empty] for the list of values which are considered empty.
+func NotEmpty(t tester.Minimal, have any, opts ...check.Option) {
+	t.Helper()
+	if e := check.NotEmpty(have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package require
+
+import (
+	"reflect"
+
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// Equal asserts both values are equal. On failure, it marks the test as failed,
+// writes an error message to the test log and stops the test execution.
+func Equal(t tester.Minimal, want, have any, opts ...check.Option) {
+	t.Helper()
+	if err := check.Equal(want, have, opts...); err != nil {
+		t.Fatal(check.Report(err, opts...))
+	}
+}
+
+// EqualValues asserts both values are equal. It works like [Equal] but uses
+// [reflect.Value] instances. On failure, it marks the test as failed, writes an
+// error message to the test log and stops the test execution.
+func EqualValues(
+	t tester.Minimal,
+	wVal, hVal reflect.Value,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if err := check.EqualValues(wVal, hVal, opts...); err != nil {
+		t.Fatal(check.Report(err, opts...))
+	}
+}
+
+// EqualT asserts both values are equal. It works like [Equal] but, when no
+// options are given, compares values using the == operator first and uses
+// [check.Equal] only when they are not equal (see [check.EqualT]). On failure,
+// it marks the test as failed, writes an error message to the test log and
+// stops the test execution.
+func EqualT[T comparable](
+	t tester.Minimal,
+	want, have T,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if err := check.EqualT(want, have, opts...); err != nil {
+		t.Fatal(check.Report(err, opts...))
+	}
+}
+
+// NotEqual asserts both values are not equal. On failure, it marks the test as
+// failed, writes an error message to the test log and stops the test execution.
+func NotEqual(t tester.Minimal, want, have any, opts ...check.Option) {
+	t.Helper()
+	if err := check.NotEqual(want, have, opts...); err != nil {
+		t.Fatal(check.Report(err, opts...))
+	}
+}
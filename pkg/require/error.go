@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package require
+
+import (
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// Error asserts "err" is not nil. On failure, it marks the test as failed,
+// writes an error message to the test log and stops the test execution.
+func Error(t tester.Minimal, err error, opts ...check.Option) {
+	t.Helper()
+	if e := check.Error(err, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// NoError asserts "err" is nil. On failure, it marks the test as failed, writes
+// an error message to the test log and stops the test execution.
+func NoError(t tester.Minimal, err error, opts ...check.Option) {
+	t.Helper()
+	if e := check.NoError(err, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// ErrorIs asserts whether any error in "err" tree matches the "want" target. On
+// failure, it marks the test as failed, writes an error message to the test log
+// and stops the test execution.
+func ErrorIs(t tester.Minimal, want, err error, opts ...check.Option) {
+	t.Helper()
+	if e := check.ErrorIs(want, err, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// ErrorAs finds the first error in "err" tree that matches the "want" target,
+// and if one is found, sets a target to that error. On failure, it marks the
+// test as failed, writes an error message to the test log and stops the test
+// execution.
+func ErrorAs(t tester.Minimal, want any, err error, opts ...check.Option) {
+	t.Helper()
+	if e := check.ErrorAs(want, err, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// ErrorEqual asserts "err" is not nil and its message equals to "want". On
+// failure, it marks the test as failed, writes an error message to the test log
+// and stops the test execution.
+func ErrorEqual(
+	t tester.Minimal,
+	want string,
+	err error,
+	opts ...check.Option,
+) {
+	t.Helper()
+	if e := check.ErrorEqual(want, err, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// ErrorContain asserts "err" is not nil and its message contains "want". On
+// failure, it marks the test as failed, writes an error message to the test log
+// and stops the test execution.
+func ErrorContain(
+	t tester.Minimal,
+	want string,
+	err error,
+	opts ...check.Option,
+) {
+	t.Helper()
+	if e := check.ErrorContain(want, err, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// ErrorRegexp asserts "err" is not nil and its message matches the "want"
+// regex. On failure, it marks the test as failed, writes an error message to
+// the test log and stops the test execution.
+//
+// The "want" can be either a regular expression string or instance of
+// [regexp.Regexp]. The [fmt.Sprint] is used to get string representation of
+// have argument.
+func ErrorRegexp(
+	t tester.Minimal,
+	want string,
+	err error,
+	opts ...check.Option,
+) {
+	t.Helper()
+	if e := check.ErrorRegexp(want, err, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
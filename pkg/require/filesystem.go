@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package require
+
+import (
+	"io/fs"
+
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// FileExist asserts "pth" points to an existing file. It fails if the path
+// points to a filesystem entry, which is not a file, or there is an error when
+// trying to check the path. On failure, it marks the test as failed, writes an
+// error message to the test log and stops the test execution.
+func FileExist(t tester.Minimal, pth string, opts ...check.Option) {
+	t.Helper()
+	if e := check.FileExist(pth, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// NoFileExist asserts "pth" points to a not existing file. It fails if the path
+// points to an existing filesystem entry. On failure, it marks the test as
+// failed, writes an error message to the test log and stops the test execution.
+func NoFileExist(t tester.Minimal, pth string, opts ...check.Option) {
+	t.Helper()
+	if e := check.NoFileExist(pth, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// FileContain asserts a file at "pth" can be read and its string content
+// contains "want". It fails if the path points to a filesystem entry, which is
+// not a file, or there is an error reading the file. The file is read in full,
+// then [Contain] assertion is used to check it contains the "want" string. On
+// failure, it marks the test as failed, writes an error message to the test log
+// and stops the test execution.
+func FileContain[T check.Content](
+	t tester.Minimal,
+	want T,
+	pth string,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if e := check.FileContain(want, pth, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// FileEqual asserts a file at "pth" can be read and its content is equal to
+// "want". It fails if the path points to a filesystem entry, which is not a
+// file, or there is an error reading the file. On failure, it marks the test as
+// failed, writes an error message to the test log and stops the test execution.
+func FileEqual[T check.Content](
+	t tester.Minimal,
+	want T,
+	pth string,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if e := check.FileEqual(want, pth, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// FileMode asserts "pth" points to an existing filesystem entry with "want"
+// permission bits. On failure, it marks the test as failed, writes an error
+// message to the test log and stops the test execution.
+func FileMode(
+	t tester.Minimal,
+	want fs.FileMode,
+	pth string,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if e := check.FileMode(want, pth, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// DirExist asserts "pth" points to an existing directory. It fails if the path
+// points to a filesystem entry, which is not a directory, or there is an error
+// when trying to check the path. On failure, it marks the test as failed,
+// writes an error message to the test log and stops the test execution.
+func DirExist(t tester.Minimal, pth string, opts ...check.Option) {
+	t.Helper()
+	if e := check.DirExist(pth, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// NoDirExist asserts "pth" points to not existing directory. It fails if the
+// path points to an existing filesystem entry. On failure, it marks the test as
+// failed, writes an error message to the test log and stops the test execution.
+func NoDirExist(t tester.Minimal, pth string, opts ...check.Option) {
+	t.Helper()
+	if e := check.NoDirExist(pth, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package require
+
+import (
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// JSON asserts that two JSON strings are equivalent. On failure, it marks the
+// test as failed, writes an error message to the test log and stops the test
+// execution.
+//
+// Example:
+//
+//	assert.JSON(t, `{"hello": "world"}`, `{"foo": "bar"}`)
+func JSON(t tester.Minimal, want, have string, opts ...check.Option) {
+	t.Helper()
+	if e := check.JSON(want, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// JSONPath asserts the value at "path" in "jsonDoc" is equal to "want". On
+// failure, it marks the test as failed, writes an error message to the test log
+// and stops the test execution. See [check.JSONPath] for the supported document
+// types and the path syntax.
+//
+// Example:
+//
+//	assert.JSONPath(t, `$.users[0].name`, "Bob", doc)
+func JSONPath(
+	t tester.Minimal,
+	path string,
+	want, jsonDoc any,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if e := check.JSONPath(path, want, jsonDoc, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package require
+
+import (
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// Nil asserts "have" is nil. On failure, it marks the test as failed, writes an
+// error message to the test log and stops the test execution.
+func Nil(t tester.Minimal, have any, opts ...check.Option) {
+	t.Helper()
+	if e := check.Nil(have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// NotNil asserts "have" is not nil. On failure, it marks the test as failed,
+// writes an error message to the test log and stops the test execution.
+func NotNil(t tester.Minimal, have any, opts ...check.Option) {
+	t.Helper()
+	if e := check.NotNil(have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
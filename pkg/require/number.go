@@ -0,0 +1,281 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package require
+
+import (
+	"github.com/ctx42/testing/internal/constraints"
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// Greater checks the "want" value is greater than the "have" value. On failure,
+// it marks the test as failed, writes an error message to the test log and
+// stops the test execution.
+//
+//	want > have
+func Greater[T constraints.Ordered](
+	t tester.Minimal,
+	want, have T,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if err := check.Greater(want, have, opts...); err != nil {
+		t.Fatal(check.Report(err, opts...))
+	}
+}
+
+// GreaterOrEqual checks the "want" value is greater or equal than the "have"
+// value. On failure, it marks the test as failed, writes an error message to
+// the test log and stops the test execution.
+//
+//	want >= have
+func GreaterOrEqual[T constraints.Ordered](
+	t tester.Minimal,
+	want, have T,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if err := check.GreaterOrEqual(want, have, opts...); err != nil {
+		t.Fatal(check.Report(err, opts...))
+	}
+}
+
+// Smaller checks the "want" value is smaller than the "have" value. On failure,
+// it marks the test as failed, writes an error message to the test log and
+// stops the test execution.
+//
+//	want < have
+func Smaller[T constraints.Ordered](
+	t tester.Minimal,
+	want, have T,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if err := check.Smaller(want, have, opts...); err != nil {
+		t.Fatal(check.Report(err, opts...))
+	}
+}
+
+// SmallerOrEqual checks the "want" value is smaller or equal than the "have"
+// value. On failure, it marks the test as failed, writes an error message to
+// the test log and stops the test execution.
+//
+//	want <= have
+func SmallerOrEqual[T constraints.Ordered](
+	t tester.Minimal,
+	want, have T,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if err := check.SmallerOrEqual(want, have, opts...); err != nil {
+		t.Fatal(check.Report(err, opts...))
+	}
+}
+
+// Delta asserts both values are within the given delta. On failure, it marks
+// the test as failed, writes an error message to the test log and stops the
+// test execution.
+//
+//	|w-h|/|w| <= delta
+func Delta[T, E constraints.Number](
+	t tester.Minimal,
+	want T, delta E, have T,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if e := check.Delta(want, delta, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// DeltaSlice asserts values are within the given delta for all respective slice
+// indexes. On failure, it marks the test as failed, writes an error message to
+// the test log and stops the test execution.
+//
+//	|w[i]-h[i]| <= delta
+func DeltaSlice[T, E constraints.Number](
+	t tester.Minimal,
+	want []T, delta E, have []T,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if err := check.DeltaSlice(want, delta, have, opts...); err != nil {
+		t.Fatal(check.Report(err, opts...))
+	}
+}
+
+// Epsilon asserts the relative error is less than epsilon. On failure, it marks
+// the test as failed, writes an error message to the test log and stops the
+// test execution.
+//
+//	|w-h|/|w| <= epsilon
+func Epsilon[T, E constraints.Number](
+	t tester.Minimal,
+	want T, epsilon E, have T,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if e := check.Epsilon(want, epsilon, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// EpsilonSlice asserts the relative error is less than epsilon for all
+// respective values in the provided slices. On failure, it marks the test as
+// failed, writes an error message to the test log and stops the test execution.
+//
+//	|w[i]-h[i]|/|w[i]| <= epsilon
+func EpsilonSlice[T, E constraints.Number](
+	t tester.Minimal,
+	want []T, epsilon E, have []T,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if err := check.EpsilonSlice(want, epsilon, have, opts...); err != nil {
+		t.Fatal(check.Report(err, opts...))
+	}
+}
+
+// Increasing checks if the given sequence has values in the increasing order.
+// You may use the [check.WithIncreasingSoft] option to allow consecutive values
+// to be equal. On failure, it marks the test as failed, writes an error message
+// to the test log and stops the test execution.
+func Increasing[T constraints.Ordered](
+	t tester.Minimal,
+	seq []T,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if err := check.Increasing(seq, opts...); err != nil {
+		t.Fatal(check.Report(err, opts...))
+	}
+}
+
+// NotIncreasing is inverse of [Increasing].
+func NotIncreasing[T constraints.Ordered](
+	t tester.Minimal,
+	seq []T,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if err := check.NotIncreasing(seq, opts...); err != nil {
+		t.Fatal(check.Report(err, opts...))
+	}
+}
+
+// Decreasing checks if the given sequence has values in the decreasing order.
+// You may use the [check.WithDecreasingSoft] option to allow consecutive values
+// to be equal. On failure, it marks the test as failed, writes an error message
+// to the test log and stops the test execution.
+func Decreasing[T constraints.Ordered](
+	t tester.Minimal,
+	seq []T,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if err := check.Decreasing(seq, opts...); err != nil {
+		t.Fatal(check.Report(err, opts...))
+	}
+}
+
+// NotDecreasing is inverse of [Decreasing].
+func NotDecreasing[T constraints.Ordered](
+	t tester.Minimal,
+	seq []T,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if err := check.NotDecreasing(seq, opts...); err != nil {
+		t.Fatal(check.Report(err, opts...))
+	}
+}
+
+// Between asserts the "have" value is within the closed range of "minimum" and
+// "maximum" values. On failure, it marks the test as failed, writes an error
+// message to the test log and stops the test execution.
+//
+//	minimum <= have <= maximum
+func Between(
+	t tester.Minimal,
+	minimum, maximum, have any,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if err := check.Between(minimum, maximum, have, opts...); err != nil {
+		t.Fatal(check.Report(err, opts...))
+	}
+}
+
+// GreaterThan asserts the "have" value is greater than the "limit" value. On
+// failure, it marks the test as failed, writes an error message to the test log
+// and stops the test execution.
+//
+// For values of the same type, it's the same as [Smaller] with the same
+// arguments (see [check.GreaterThan]).
+//
+//	have > limit
+func GreaterThan(t tester.Minimal, limit, have any, opts ...check.Option) {
+	t.Helper()
+	if err := check.GreaterThan(limit, have, opts...); err != nil {
+		t.Fatal(check.Report(err, opts...))
+	}
+}
+
+// LessOrEqual asserts the "have" value is less or equal to the "limit" value.
+// On failure, it marks the test as failed, writes an error message to the test
+// log and stops the test execution.
+//
+// For values of the same type, it's the same as [GreaterOrEqual] with the
+// same arguments (see [check.GreaterThan]).
+//
+//	have <= limit
+func LessOrEqual(t tester.Minimal, limit, have any, opts ...check.Option) {
+	t.Helper()
+	if err := check.LessOrEqual(limit, have, opts...); err != nil {
+		t.Fatal(check.Report(err, opts...))
+	}
+}
+
+// Positive asserts the "have" value is greater than zero. On failure, it marks
+// the test as failed, writes an error message to the test log and stops the
+// test execution.
+func Positive[T constraints.Number](
+	t tester.Minimal,
+	have T,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if err := check.Positive(have, opts...); err != nil {
+		t.Fatal(check.Report(err, opts...))
+	}
+}
+
+// Negative asserts the "have" value is less than zero. On failure, it marks the
+// test as failed, writes an error message to the test log and stops the test
+// execution.
+func Negative[T constraints.Number](
+	t tester.Minimal,
+	have T,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if err := check.Negative(have, opts...); err != nil {
+		t.Fatal(check.Report(err, opts...))
+	}
+}
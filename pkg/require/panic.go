@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package require
+
+import (
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// Panic asserts "fn" panics. On failure, it marks the test as failed, writes an
+// error message to the test log and stops the test execution.
+func Panic(t tester.Minimal, fn check.TestFunc, opts ...check.Option) {
+	t.Helper()
+	if e := check.Panic(fn, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// NoPanic asserts "fn" does not panic. On failure, it marks the test as failed,
+// writes an error message to the test log and stops the test execution.
+func NoPanic(t tester.Minimal, fn check.TestFunc, opts ...check.Option) {
+	t.Helper()
+	if e := check.NoPanic(fn, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// PanicContain asserts "fn" panics, and the recovered panic value represented
+// as a string contains "want". On failure, it marks the test as failed, writes
+// an error message to the test log and stops the test execution.
+func PanicContain(
+	t tester.Minimal,
+	want string,
+	fn check.TestFunc,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if e := check.PanicContain(want, fn, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// PanicMsg asserts the "fn" panics and returns the recovered panic value
+// represented as a string. If the function did not panic, it marks the test as
+// failed, writes an error message to the test log and stops the test
+// execution.
+func PanicMsg(
+	t tester.Minimal,
+	fn check.TestFunc,
+	opts ...check.Option,
+) *string {
+	t.Helper()
+	msg, e := check.PanicMsg(fn, opts...)
+	if e != nil {
+		t.Fatal(check.Report(e, opts...))
+		return nil
+	}
+	return msg
+}
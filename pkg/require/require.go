@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+// Package require provides assertion functions which stop the test on
+// failure.
+//
+// The functions mirror the [github.com/ctx42/testing/pkg/assert] package,
+// but instead of marking the test as failed and returning false, they call
+// [tester.T.Fatal], so the test doesn't continue after a failed precondition.
+package require
+
+import (
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// Count asserts there is "count" occurrences of "what" in "where". On failure,
+// it marks the test as failed, writes an error message to the test log and
+// stops the test execution.
+//
+// Currently, strings, slices and arrays are supported. See [check.Count] for
+// details.
+func Count(t tester.Minimal, count int, what, where any, opts ...check.Option) {
+	t.Helper()
+	if e := check.Count(count, what, where, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// Type asserts that both arguments are of the same type. On failure, it marks
+// the test as failed, writes an error message to the test log and stops the
+// test execution.
+func Type(t tester.Minimal, want, have any, opts ...check.Option) {
+	t.Helper()
+	if e := check.Type(want, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// Fields asserts struct or pointer to a struct "s" has "want" number of fields.
+// On failure, it marks the test as failed, writes an error message to the test
+// log and stops the test execution.
+func Fields(t tester.Minimal, want int, s any, opts ...check.Option) {
+	t.Helper()
+	if e := check.Fields(want, s, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package require
+
+import (
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// Same asserts "want" and "have" are generic pointers and that both reference
+// the same object. On failure, it marks the test as failed, writes an error
+// message to the test log and stops the test execution.
+//
+// Both arguments must be pointer variables. Pointer variable sameness is
+// determined based on the equality of both type and value.
+func Same(t tester.Minimal, want, have any, opts ...check.Option) {
+	t.Helper()
+	if e := check.Same(want, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// NotSame asserts "want" and "have" are generic pointers and that both do not
+// reference the same object. On failure, it marks the test as failed, writes an
+// error message to the test log and stops the test execution.
+//
+// Both arguments must be pointer variables. Pointer variable sameness is
+// determined based on the equality of both type and value.
+func NotSame(t tester.Minimal, want, have any, opts ...check.Option) {
+	t.Helper()
+	if e := check.NotSame(want, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
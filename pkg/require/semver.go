@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package require
+
+import (
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// SemVer asserts "have" is a valid semantic version as defined by
+// https://semver.org. On failure, it marks the test as failed, writes an error
+// message to the test log and stops the test execution.
+func SemVer(t tester.Minimal, have string, opts ...check.Option) {
+	t.Helper()
+	if e := check.SemVer(have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// SemVerConstraint asserts "have" semantic version satisfies the "constraint".
+// On failure, it marks the test as failed, writes an error message to the test
+// log and stops the test execution. See [check.SemVerConstraint] for the
+// constraint syntax.
+func SemVerConstraint(
+	t tester.Minimal,
+	constraint, have string,
+	opts ...check.Option,
+) {
+
+	t.Helper()
+	if e := check.SemVerConstraint(constraint, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
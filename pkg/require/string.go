@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package require
+
+import (
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// Contain asserts "want" is a substring of "have". On failure, it marks the
+// test as failed, writes an error message to the test log and stops the test
+// execution.
+func Contain(t tester.Minimal, want, have string, opts ...check.Option) {
+	t.Helper()
+	if e := check.Contain(want, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// NotContain asserts "want" is not a substring of "have". On failure, it marks
+// the test as failed, writes an error message to the test log and stops the
+// test execution.
+func NotContain(t tester.Minimal, want, have string, opts ...check.Option) {
+	t.Helper()
+	if e := check.NotContain(want, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// EqualFold asserts "want" and "have" strings are equal under simple Unicode
+// case-folding. On failure, it marks the test as failed, writes an error
+// message to the test log and stops the test execution.
+func EqualFold(t tester.Minimal, want, have string, opts ...check.Option) {
+	t.Helper()
+	if e := check.EqualFold(want, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// EqualTrimmed asserts "want" and "have" strings are equal after normalizing
+// whitespace (see [check.EqualTrimmed]). On failure, it marks the test as
+// failed, writes an error message to the test log and stops the test execution.
+func EqualTrimmed(t tester.Minimal, want, have string, opts ...check.Option) {
+	t.Helper()
+	if e := check.EqualTrimmed(want, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// HasPrefix asserts "have" string starts with the "prefix". On failure, it
+// marks the test as failed, writes an error message to the test log and stops
+// the test execution.
+func HasPrefix(t tester.Minimal, prefix, have string, opts ...check.Option) {
+	t.Helper()
+	if e := check.HasPrefix(prefix, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// HasSuffix asserts "have" string ends with the "suffix". On failure, it marks
+// the test as failed, writes an error message to the test log and stops the
+// test execution.
+func HasSuffix(t tester.Minimal, suffix, have string, opts ...check.Option) {
+	t.Helper()
+	if e := check.HasSuffix(suffix, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// StringContains asserts "want" is a substring of "have". On failure, it marks
+// the test as failed, writes an error message to the test log and stops the
+// test execution.
+func StringContains(t tester.Minimal, want, have string, opts ...check.Option) {
+	t.Helper()
+	if e := check.StringContains(want, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
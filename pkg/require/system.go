@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package require
+
+import (
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// ExitCode asserts "err" is a pointer to [exec.ExitError] with exit code equal
+// to "want". On failure, it marks the test as failed, writes an error message
+// to the test log and stops the test execution.
+func ExitCode(t tester.Minimal, want int, err error, opts ...check.Option) {
+	t.Helper()
+	if e := check.ExitCode(want, err, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
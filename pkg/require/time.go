@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package require
+
+import (
+	"time"
+
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// Time asserts "want" and "have" dates are equal. On failure, it marks the test
+// as failed, writes an error message to the test log and stops the test
+// execution.
+//
+// The "want" and "have" might be date representations in the form of a string,
+// int, int64 or [time.Time]. For string representations the
+// [check.Options.TimeFormat] is used during parsing and the returned date is
+// always in UTC. The int and int64 types are interpreted as Unix Timestamp, and
+// the date returned is also in UTC.
+func Time(t tester.Minimal, want, have any, opts ...check.Option) {
+	t.Helper()
+	if e := check.Time(want, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// Exact asserts "want" and "have" dates are equal and are in the same timezone.
+// On failure, it marks the test as failed, writes an error message to the test
+// log and stops the test execution.
+//
+// The "want" and "have" might be date representations in the form of a string,
+// int, int64 or [time.Time]. For string representations the
+// [check.Options.TimeFormat] is used during parsing and the returned date is
+// always in UTC. The int and int64 types are interpreted as Unix Timestamp, and
+// the date returned is also in UTC.
+func Exact(t tester.Minimal, want, have any, opts ...check.Option) {
+	t.Helper()
+	if e := check.Exact(want, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// Before asserts "date" is before "mark". On failure, it marks the test as
+// failed, writes an error message to the test log and stops the test execution.
+//
+// The "date" and "mark" might be date representations in the form of string,
+// int, int64 or [time.Time]. For string representations the
+// [check.Options.TimeFormat] is used during parsing and the returned date is
+// always in UTC. The int and int64 types are interpreted as Unix Timestamp, and
+// the date returned is also in UTC.
+func Before(t tester.Minimal, date, mark any, opts ...check.Option) {
+	t.Helper()
+	if e := check.Before(date, mark, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// After asserts "date" is after "mark". On failure, it marks the test as
+// failed, writes an error message to the test log and stops the test execution.
+//
+// The "date" and "mark" might be date representations in the form of string,
+// int, int64 or [time.Time]. For string representations the
+// [check.Options.TimeFormat] is used during parsing and the returned date is
+// always in UTC. The int and int64 types are interpreted as Unix Timestamp, and
+// the date returned is also in UTC.
+func After(t tester.Minimal, date, mark time.Time, opts ...check.Option) {
+	t.Helper()
+	if e := check.After(date, mark, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// BeforeOrEqual asserts "date" is equal or before "mark". On failure, it marks
+// the test as failed, writes an error message to the test log and stops the
+// test execution.
+//
+// The "date" and "mark" might be date representations in the form of a string,
+// int, int64 or [time.Time]. For string representations the
+// [check.Options.TimeFormat] is used during parsing and the returned date is
+// always in UTC. The int and int64 types are interpreted as Unix Timestamp, and
+// the date returned is also in UTC.
+func BeforeOrEqual(
+	t tester.Minimal,
+	date, mark time.Time,
+	opts ...check.Option,
+) {
+	t.Helper()
+	if e := check.BeforeOrEqual(date, mark, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// AfterOrEqual asserts "date" is equal or after "mark". On failure, it marks
+// the test as failed, writes an error message to the test log and stops the
+// test execution.
+//
+// The "date" and "mark" might be date representations in the form of a string,
+// int, int64 or [time.Time]. For string representations the
+// [check.Options.TimeFormat] is used during parsing and the returned date is
+// always in UTC. The int and int64 types are interpreted as Unix Timestamp, and
+// the date returned is also in UTC.
+func AfterOrEqual(t tester.Minimal, date, mark any, opts ...check.Option) {
+	t.Helper()
+	if e := check.AfterOrEqual(date, mark, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// Within asserts "want" and "have" dates are equal "within" given duration. On
+// failure, it marks the test as failed, writes an error message to the test log
+// and stops the test execution.
+//
+// The "want" and "have" might be date representations in the form of a string,
+// int, int64 or [time.Time]. For string representations the
+// [check.Options.TimeFormat] is used during parsing and the returned date is
+// always in UTC. The int and int64 types are interpreted as Unix Timestamp, and
+// the date returned is also in UTC.
+func Within(t tester.Minimal, want, within, have any, opts ...check.Option) {
+	t.Helper()
+	if e := check.Within(want, within, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// Recent asserts "have" is within [check.Options.Recent] from [time.Now]. On
+// failure, it marks the test as failed, writes an error message to the test log
+// and stops the test execution.
+//
+// The "have" may represent date in the form of a string, int, int64 or
+// [time.Time]. For string representations the [check.Options.TimeFormat] is
+// used during parsing and the returned date is always in UTC. The int and int64
+// types are interpreted as Unix Timestamp, and the date returned is also in
+// UTC.
+func Recent(t tester.Minimal, have any, opts ...check.Option) {
+	t.Helper()
+	if e := check.Recent(have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// Zone asserts "want" and "have" timezones are equal. On failure, it marks the
+// test as failed, writes an error message to the test log and stops the test
+// execution.
+func Zone(t tester.Minimal, want, have *time.Location, opts ...check.Option) {
+	t.Helper()
+	if e := check.Zone(want, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// Duration asserts "want" and "have" durations are equal. On failure, it marks
+// the test as failed, writes an error message to the test log and stops the
+// test execution. Use the [check.WithDurationDelta] option to allow durations
+// to differ by the given delta.
+//
+// The "want" and "have" might be duration representation in the form of string,
+// int, int64 or [time.Duration].
+func Duration(t tester.Minimal, want, have any, opts ...check.Option) {
+	t.Helper()
+	if e := check.Duration(want, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
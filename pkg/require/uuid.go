@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package require
+
+import (
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// UUID asserts "have" is a UUID in the canonical RFC 4122 textual form. The
+// version of the UUID is not checked. On failure, it marks the test as failed,
+// writes an error message to the test log and stops the test execution.
+func UUID(t tester.Minimal, have string, opts ...check.Option) {
+	t.Helper()
+	if e := check.UUID(have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// UUIDv4 asserts "have" is a valid version 4 UUID in the canonical RFC 4122
+// textual form. On failure, it marks the test as failed, writes an error
+// message to the test log and stops the test execution.
+func UUIDv4(t tester.Minimal, have string, opts ...check.Option) {
+	t.Helper()
+	if e := check.UUIDv4(have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// UUIDEqual asserts "want" and "have" represent the same UUID. The comparison
+// is case-insensitive and the UUIDs may be given with or without dashes. On
+// failure, it marks the test as failed, writes an error message to the test log
+// and stops the test execution.
+func UUIDEqual(t tester.Minimal, want, have string, opts ...check.Option) {
+	t.Helper()
+	if e := check.UUIDEqual(want, have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package require
+
+import (
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// Zero asserts "have" is the zero value for its type. On failure, it marks the
+// test as failed, writes an error message to the test log and stops the test
+// execution.
+func Zero(t tester.Minimal, have any, opts ...check.Option) {
+	t.Helper()
+	if e := check.Zero(have, opts...); e != nil {
+		t.Fatal(check.Report(e, opts...))
+	}
+}
+
+// NotZero asserts "have" is not the zero value for its type. On failure, it
+// marks the test as failed, writes an error message to the test log and stops
+// the test execution.
+func NotZero(t tester.Minimal, have any, opts ...check.Option) {
+	t.Helper()
+	if err := check.NotZero(have, opts...); err != nil {
+		t.Fatal(check.Report(err, opts...))
+	}
+}
@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package snap
+
+import (
+	"flag"
+	"os"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	// Tests set the update flag explicitly, running them with the -update
+	// flag must not overwrite the package test data.
+	_ = flag.Set("update", "false")
+	os.Exit(m.Run())
+}
+
+// setUpdate sets the -update flag for the duration of the test.
+func setUpdate(t *testing.T) {
+	t.Helper()
+	_ = flag.Set("update", "true")
+	t.Cleanup(func() { _ = flag.Set("update", "false") })
+}
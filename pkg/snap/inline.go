@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package snap
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ctx42/testing/pkg/golden"
+	"github.com/ctx42/testing/pkg/notice"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// shift represents the change in the number of lines of a source file after
+// an inline snapshot at the given line was updated.
+type shift struct {
+	line  int // Line of the updated [Match] call before any updates.
+	delta int // Change in the number of lines.
+}
+
+// Guards inline snapshot updates and line shifts of updated source files.
+var (
+	inlineMx sync.Mutex
+	shifts   = make(map[string][]shift)
+)
+
+// matchInline asserts the dumped value matches the inline snapshot. When the
+// tests run with the -update flag, it updates the snapshot in the source
+// file of the [Match] caller.
+func matchInline(t tester.T, want, have string) bool {
+	t.Helper()
+	if golden.Updating() {
+		// Skip matchInline and Match frames.
+		_, file, line, _ := runtime.Caller(2)
+		if err := updateInline(file, line, have); err != nil {
+			t.Fatal(err)
+			return false
+		}
+		return true
+	}
+	if err := compare(inlineValue(want), have); err != nil {
+		t.Error(err)
+		return false
+	}
+	return true
+}
+
+// inlineValue returns the inline snapshot without the leading new line and
+// with the trailing new line, so it can be compared with dumped values.
+func inlineValue(str string) string {
+	str = strings.TrimPrefix(str, "\n")
+	if !strings.HasSuffix(str, "\n") {
+		str += "\n"
+	}
+	return str
+}
+
+// updateInline replaces the string literal passed to the [Inline] option in
+// the [Match] call at the given line of the source file with the literal
+// representing "val". The line is the one before any updates in the current
+// test run.
+func updateInline(file string, line int, val string) error {
+	inlineMx.Lock()
+	defer inlineMx.Unlock()
+
+	cur := line
+	for _, s := range shifts[file] {
+		if s.line < line {
+			cur += s.delta
+		}
+	}
+
+	src, err := os.ReadFile(file)
+	if err != nil {
+		return inlineError(file, line, err)
+	}
+	fset := token.NewFileSet()
+	fil, err := parser.ParseFile(fset, file, src, parser.ParseComments)
+	if err != nil {
+		return inlineError(file, line, err)
+	}
+	lit := findInline(fset, fil, cur)
+	if lit == nil {
+		return notice.New("cannot find inline snapshot").
+			Append("path", "%s:%d", file, line).
+			SetFooter("The snapshot must be a string literal passed to " +
+				"the Inline option in the Match call.")
+	}
+
+	rep := literal(val)
+	start := fset.Position(lit.Pos()).Offset
+	end := fset.Position(lit.End()).Offset
+	buf := make([]byte, 0, len(src)+len(rep)-len(lit.Value))
+	buf = append(buf, src[:start]...)
+	buf = append(buf, rep...)
+	buf = append(buf, src[end:]...)
+
+	inf, err := os.Stat(file)
+	if err != nil {
+		return inlineError(file, line, err)
+	}
+	if err = os.WriteFile(file, buf, inf.Mode().Perm()); err != nil {
+		return inlineError(file, line, err)
+	}
+	delta := strings.Count(rep, "\n") - strings.Count(lit.Value, "\n")
+	if delta != 0 {
+		shifts[file] = append(shifts[file], shift{line: line, delta: delta})
+	}
+	return nil
+}
+
+// findInline returns the string literal passed to the [Inline] option in the
+// [Match] call spanning the given line. Returns nil if it cannot be found.
+func findInline(fset *token.FileSet, fil *ast.File, line int) *ast.BasicLit {
+	var lit *ast.BasicLit
+	ast.Inspect(fil, func(node ast.Node) bool {
+		if lit != nil {
+			return false
+		}
+		call, ok := node.(*ast.CallExpr)
+		if !ok || funcName(call.Fun) != "Match" {
+			return true
+		}
+		if fset.Position(call.Pos()).Line > line {
+			return true
+		}
+		if fset.Position(call.End()).Line < line {
+			return true
+		}
+		for _, arg := range call.Args {
+			opt, ok := arg.(*ast.CallExpr)
+			if !ok || funcName(opt.Fun) != "Inline" || len(opt.Args) != 1 {
+				continue
+			}
+			if bl, ok := opt.Args[0].(*ast.BasicLit); ok {
+				if bl.Kind == token.STRING {
+					lit = bl
+					return false
+				}
+			}
+		}
+		return true
+	})
+	return lit
+}
+
+// funcName returns the name of the function in the call expression, for
+// example, "Match" for both "Match" and "snap.Match". Returns empty string for
+// other expressions.
+func funcName(expr ast.Expr) string {
+	switch fn := expr.(type) {
+	case *ast.Ident:
+		return fn.Name
+	case *ast.SelectorExpr:
+		return fn.Sel.Name
+	}
+	return ""
+}
+
+// literal returns the Go string literal representing the inline snapshot.
+// The raw string literal starting with a new line is used when possible.
+func literal(val string) string {
+	if strings.ContainsAny(val, "`\r") {
+		return strconv.Quote(val)
+	}
+	return "`\n" + val + "`"
+}
+
+// inlineError returns the error for the inline snapshot which cannot be
+// updated.
+func inlineError(file string, line int, err error) error {
+	return notice.New("error updating inline snapshot").
+		Append("path", "%s:%d", file, line).
+		Append("error", "%s", err).
+		Wrap(err)
+}
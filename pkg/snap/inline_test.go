@@ -0,0 +1,273 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package snap
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// tSource is the test source file with inline snapshots.
+const tSource = `package test
+
+func Test(t *testing.T) {
+	snap.Match(t, 1, snap.Inline("1"))
+	snap.Match(t, 2, snap.Inline(` + "`\n2\n`" + `))
+	Match(
+		t,
+		3,
+		Inline("3"),
+	)
+}
+`
+
+// writeSource writes the [tSource] to the temporary directory and returns
+// its path.
+func writeSource(t *testing.T) string {
+	t.Helper()
+	pth := filepath.Join(t.TempDir(), "test.go")
+	affirm.Nil(t, os.WriteFile(pth, []byte(tSource), 0600))
+	return pth
+}
+
+func Test_Match_inline(t *testing.T) {
+	t.Run("match", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := Match(tspy, []int{1, 2}, Inline(`
+[]int{
+  1,
+  2,
+}`))
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("error - does not match", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "expected value to match the snapshot:\n" +
+			"  diff:\n" +
+			"        @@ -1 +1 @@\n" +
+			"        -1\n" +
+			"        +2\n" +
+			"\n" +
+			"  Run tests with the -update flag to update snapshots."
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		// --- When ---
+		have := Match(tspy, 2, Inline("1"))
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("error - update without Match call", func(t *testing.T) {
+		// --- Given ---
+		setUpdate(t)
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("cannot find inline snapshot:\n")
+		tspy.Close()
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { matchInline(tspy, "1", "2\n") })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+}
+
+func Test_inlineValue_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		str  string
+		want string
+	}{
+		{"empty", "", "\n"},
+		{"single line", "abc", "abc\n"},
+		{"leading new line", "\nabc", "abc\n"},
+		{"trailing new line", "abc\n", "abc\n"},
+		{"multi line", "\na\nb", "a\nb\n"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := inlineValue(tc.str)
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_updateInline(t *testing.T) {
+	t.Run("single line literal", func(t *testing.T) {
+		// --- Given ---
+		pth := writeSource(t)
+
+		// --- When ---
+		err := updateInline(pth, 4, "10\n")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		content, _ := os.ReadFile(pth)
+		want := "package test\n" +
+			"\n" +
+			"func Test(t *testing.T) {\n" +
+			"\tsnap.Match(t, 1, snap.Inline(`\n" +
+			"10\n" +
+			"`))\n" +
+			"\tsnap.Match(t, 2, snap.Inline(`\n2\n`))\n" +
+			"\tMatch(\n" +
+			"\t\tt,\n" +
+			"\t\t3,\n" +
+			"\t\tInline(\"3\"),\n" +
+			"\t)\n" +
+			"}\n"
+		affirm.Equal(t, want, string(content))
+	})
+
+	t.Run("lines of previous updates are shifted", func(t *testing.T) {
+		// --- Given ---
+		pth := writeSource(t)
+		t.Cleanup(func() { delete(shifts, pth) })
+
+		// --- When ---
+		err0 := updateInline(pth, 4, "10\n")
+		err1 := updateInline(pth, 5, "20\n21\n")
+		err2 := updateInline(pth, 8, "30\n")
+
+		// --- Then ---
+		affirm.Nil(t, err0)
+		affirm.Nil(t, err1)
+		affirm.Nil(t, err2)
+		content, _ := os.ReadFile(pth)
+		want := "package test\n" +
+			"\n" +
+			"func Test(t *testing.T) {\n" +
+			"\tsnap.Match(t, 1, snap.Inline(`\n" +
+			"10\n" +
+			"`))\n" +
+			"\tsnap.Match(t, 2, snap.Inline(`\n20\n21\n`))\n" +
+			"\tMatch(\n" +
+			"\t\tt,\n" +
+			"\t\t3,\n" +
+			"\t\tInline(`\n" +
+			"30\n" +
+			"`),\n" +
+			"\t)\n" +
+			"}\n"
+		affirm.Equal(t, want, string(content))
+	})
+
+	t.Run("error - snapshot not found", func(t *testing.T) {
+		// --- Given ---
+		pth := writeSource(t)
+
+		// --- When ---
+		err := updateInline(pth, 1, "10\n")
+
+		// --- Then ---
+		wMsg := "cannot find inline snapshot:\n" +
+			"  path: " + pth + ":1\n" +
+			"\n" +
+			"  The snapshot must be a string literal passed to the Inline " +
+			"option in the Match call."
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - file does not exist", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "test.go")
+
+		// --- When ---
+		err := updateInline(pth, 1, "10\n")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "error updating inline snapshot:\n" +
+			"   path: " + pth + ":1\n" +
+			"  error: open " + pth + ": no such file or directory"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - invalid source", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "test.go")
+		affirm.Nil(t, os.WriteFile(pth, []byte("package"), 0600))
+
+		// --- When ---
+		err := updateInline(pth, 1, "10\n")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+	})
+}
+
+func Test_findInline(t *testing.T) {
+	t.Run("not a string literal", func(t *testing.T) {
+		// --- Given ---
+		src := "package test\n\nfunc Test() { Match(t, 1, Inline(str)) }\n"
+		fset := token.NewFileSet()
+		fil, err := parser.ParseFile(fset, "test.go", src, 0)
+		affirm.Nil(t, err)
+
+		// --- When ---
+		have := findInline(fset, fil, 3)
+
+		// --- Then ---
+		affirm.Nil(t, have)
+	})
+
+	t.Run("found", func(t *testing.T) {
+		// --- Given ---
+		fset := token.NewFileSet()
+		fil, err := parser.ParseFile(fset, "test.go", tSource, 0)
+		affirm.Nil(t, err)
+
+		// --- When ---
+		have := findInline(fset, fil, 9)
+
+		// --- Then ---
+		affirm.NotNil(t, have)
+		affirm.Equal(t, `"3"`, have.Value)
+	})
+}
+
+func Test_literal_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		val  string
+		want string
+	}{
+		{"raw", "abc\n", "`\nabc\n`"},
+		{"back quote", "a`b\n", "\"a`b\\n\""},
+		{"carriage return", "a\r\n", `"a\r\n"`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := literal(tc.val)
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+// Package snap provides snapshot testing of arbitrary values.
+//
+// Values are dumped using the [dump] package with a canonical configuration
+// and compared with the snapshots stored in the "testdata/snapshots"
+// directory or embedded in the test source. Snapshots are updated when the
+// tests run with the -update flag (see [golden.Updating]).
+package snap
+
+import (
+	"errors"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ctx42/testing/pkg/diff"
+	"github.com/ctx42/testing/pkg/dump"
+	"github.com/ctx42/testing/pkg/golden"
+	"github.com/ctx42/testing/pkg/notice"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// Dir is the directory where snapshot files are stored.
+const Dir = "testdata/snapshots"
+
+// Ext is the snapshot file extension.
+const Ext = ".snap"
+
+// Option represents a [Match] option.
+type Option func(*options)
+
+// WithName is the [Match] option setting the snapshot name used instead of
+// the name generated from the test name.
+func WithName(name string) Option {
+	return func(ops *options) { ops.name = name }
+}
+
+// WithDumper is the [Match] option setting [dump.Dump] options used to dump
+// the value on top of the canonical configuration.
+func WithDumper(opts ...dump.Option) Option {
+	return func(ops *options) { ops.dumpOpts = append(ops.dumpOpts, opts...) }
+}
+
+// Inline is the [Match] option setting the snapshot embedded in the test
+// source, instead of the one stored in a file. When the tests run with the
+// -update flag, the string literal passed to it is rewritten in the test
+// source file. To be updated, the literal must be passed directly to the
+// option used directly in the [Match] call.
+//
+// Example:
+//
+//	snap.Match(t, []int{1, 2}, snap.Inline(`
+//	[]int{
+//	  1,
+//	  2,
+//	}`))
+func Inline(snapshot string) Option {
+	return func(ops *options) { ops.inline = &snapshot }
+}
+
+// options represents [Match] options.
+type options struct {
+	name     string        // Snapshot name, see [WithName].
+	dumpOpts []dump.Option // Dump options, see [WithDumper].
+	inline   *string       // Inline snapshot, see [Inline].
+}
+
+// counters holds the numbers of snapshots matched by the test managers. See
+// [name].
+var counters sync.Map // map[tester.T]*atomic.Int64
+
+// Match asserts the dumped "have" value matches the snapshot. On the first
+// run, the snapshot file is created, later runs compare the dumped value with
+// it. The file is named after the test, so names of following snapshots in
+// the same test have the "_2", "_3", and so on suffixes. Returns true if they
+// match, otherwise marks the test as failed, writes an error message with the
+// diff to the test log, calls the reporters set with [golden.SetReporters]
+// and returns false. Reporters are not called for inline snapshots. When the
+// snapshot cannot be read or written, it marks the test as failed, writes an
+// error message to the test log and stops the test execution.
+//
+// When the tests run with the -update flag, the snapshot is updated with the
+// dumped value, and the function returns true.
+func Match(t tester.T, have any, opts ...Option) bool {
+	t.Helper()
+	ops := &options{}
+	for _, opt := range opts {
+		opt(ops)
+	}
+	str := render(have, ops.dumpOpts...)
+
+	if ops.inline != nil {
+		return matchInline(t, *ops.inline, str)
+	}
+
+	pth := filepath.Join(Dir, name(t, ops.name)+Ext)
+	want, err := os.ReadFile(pth)
+	if errors.Is(err, fs.ErrNotExist) || golden.Updating() {
+		if err = write(pth, str); err != nil {
+			t.Fatal(err)
+			return false
+		}
+		return true
+	}
+	if err != nil {
+		t.Fatal(notice.New("error reading snapshot").
+			Append("path", "%s", pth).
+			Append("error", "%s", err).
+			Wrap(err))
+		return false
+	}
+	if err = compare(string(want), str); err != nil {
+		t.Error(notice.From(err).Prepend("path", "%s", pth))
+		mis := golden.Mismatch{Path: pth, Want: string(want), Have: str}
+		golden.Report(t, mis)
+		return false
+	}
+	return true
+}
+
+// render returns the value dumped with the canonical configuration.
+func render(val any, opts ...dump.Option) string {
+	opts = append([]dump.Option{dump.WithMaxDepth(math.MaxInt)}, opts...)
+	return dump.New(opts...).Any(val) + "\n"
+}
+
+// name returns the snapshot name. When "name" is empty, it's generated from
+// the test name and the number of snapshots already matched by the test.
+func name(t tester.T, name string) string {
+	if name != "" {
+		return sanitize(name)
+	}
+	val, loaded := counters.LoadOrStore(t, &atomic.Int64{})
+	cnt := val.(*atomic.Int64) // nolint: forcetypeassert
+	if !loaded {
+		t.Cleanup(func() { counters.Delete(t) })
+	}
+	name = sanitize(t.Name())
+	if n := cnt.Add(1); n > 1 {
+		name += "_" + strconv.FormatInt(n, 10)
+	}
+	return name
+}
+
+// sanitize replaces characters which should not be used in file names with
+// underscores. The slashes separating subtest names are kept, so snapshots
+// of subtests are stored in subdirectories.
+func sanitize(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case r == '_', r == '-', r == '.', r == '/':
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// compare compares the snapshot with the dumped value. Returns nil if they
+// are equal, otherwise returns an error with the unified diff.
+func compare(want, have string) error {
+	want = strings.ReplaceAll(want, "\r\n", "\n")
+	if want == have {
+		return nil
+	}
+	return notice.New("expected value to match the snapshot").
+		Append("diff", "%s", diff.Unified(want, have, 3)).
+		SetFooter("Run tests with the -update flag to update snapshots.")
+}
+
+// write writes the snapshot file creating missing directories.
+func write(pth, str string) error {
+	err := os.MkdirAll(filepath.Dir(pth), 0700)
+	if err == nil {
+		err = os.WriteFile(pth, []byte(str), 0600)
+	}
+	if err != nil {
+		return notice.New("error writing snapshot").
+			Append("path", "%s", pth).
+			Append("error", "%s", err).
+			Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,341 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package snap
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/dump"
+	"github.com/ctx42/testing/pkg/golden"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+type tUser struct {
+	Name string
+	Tags map[string]int
+}
+
+func Test_WithName(t *testing.T) {
+	// --- Given ---
+	ops := &options{}
+
+	// --- When ---
+	WithName("name")(ops)
+
+	// --- Then ---
+	affirm.Equal(t, "name", ops.name)
+}
+
+func Test_WithDumper(t *testing.T) {
+	// --- Given ---
+	ops := &options{}
+
+	// --- When ---
+	WithDumper(dump.WithFlat, dump.WithCompact)(ops)
+
+	// --- Then ---
+	affirm.Equal(t, 2, len(ops.dumpOpts))
+}
+
+func Test_Inline(t *testing.T) {
+	// --- Given ---
+	ops := &options{}
+
+	// --- When ---
+	Inline("abc")(ops)
+
+	// --- Then ---
+	affirm.NotNil(t, ops.inline)
+	affirm.Equal(t, "abc", *ops.inline)
+}
+
+func Test_Match(t *testing.T) {
+	t.Run("creates snapshot", func(t *testing.T) {
+		// --- Given ---
+		t.Chdir(t.TempDir())
+		tspy := tester.New(t).ExpectCleanups(1).ExpectedNames(1).Close()
+		val := tUser{Name: "bob", Tags: map[string]int{"b": 2, "a": 1}}
+
+		// --- When ---
+		have := Match(tspy, val)
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+		pth := filepath.Join(Dir, "Test_Match/creates_snapshot.snap")
+		content, err := os.ReadFile(pth)
+		affirm.Nil(t, err)
+		want := "" +
+			"{\n" +
+			"  Name: \"bob\",\n" +
+			"  Tags: map[string]int{\n" +
+			"    \"a\": 1,\n" +
+			"    \"b\": 2,\n" +
+			"  },\n" +
+			"}\n"
+		affirm.Equal(t, want, string(content))
+	})
+
+	t.Run("matches snapshot", func(t *testing.T) {
+		// --- Given ---
+		t.Chdir(t.TempDir())
+		pth := filepath.Join(Dir, "Test_Match/matches_snapshot.snap")
+		affirm.Nil(t, os.MkdirAll(filepath.Dir(pth), 0700))
+		affirm.Nil(t, os.WriteFile(pth, []byte("[]int{\n  1,\n}\n"), 0600))
+
+		tspy := tester.New(t).ExpectCleanups(1).ExpectedNames(1).Close()
+
+		// --- When ---
+		have := Match(tspy, []int{1})
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("snapshot with CRLF line endings", func(t *testing.T) {
+		// --- Given ---
+		t.Chdir(t.TempDir())
+		pth := filepath.Join(Dir, "name.snap")
+		affirm.Nil(t, os.MkdirAll(Dir, 0700))
+		affirm.Nil(t, os.WriteFile(pth, []byte("[]int{\r\n  1,\r\n}\r\n"), 0600))
+
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := Match(tspy, []int{1}, WithName("name"))
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("error - does not match snapshot", func(t *testing.T) {
+		// --- Given ---
+		t.Chdir(t.TempDir())
+		pth := filepath.Join(Dir, "name.snap")
+		affirm.Nil(t, os.MkdirAll(Dir, 0700))
+		affirm.Nil(t, os.WriteFile(pth, []byte("[]int{\n  1,\n}\n"), 0600))
+
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "expected value to match the snapshot:\n" +
+			"  path: testdata/snapshots/name.snap\n" +
+			"  diff:\n" +
+			"        @@ -1,3 +1,3 @@\n" +
+			"         []int{\n" +
+			"        -  1,\n" +
+			"        +  2,\n" +
+			"         }\n" +
+			"\n" +
+			"  Run tests with the -update flag to update snapshots."
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		// --- When ---
+		have := Match(tspy, []int{2}, WithName("name"))
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("error - reporters are called", func(t *testing.T) {
+		// --- Given ---
+		t.Chdir(t.TempDir())
+		pth := filepath.Join(Dir, "name.snap")
+		affirm.Nil(t, os.MkdirAll(Dir, 0700))
+		affirm.Nil(t, os.WriteFile(pth, []byte("1\n"), 0600))
+
+		var mis golden.Mismatch
+		golden.SetReporters(func(_ tester.T, m golden.Mismatch) error {
+			mis = m
+			return nil
+		})
+		t.Cleanup(func() { golden.SetReporters() })
+
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := Match(tspy, 2, WithName("name"))
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+		want := golden.Mismatch{Path: pth, Want: "1\n", Have: "2\n"}
+		affirm.Equal(t, want, mis)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		// --- Given ---
+		setUpdate(t)
+		t.Chdir(t.TempDir())
+		pth := filepath.Join(Dir, "name.snap")
+		affirm.Nil(t, os.MkdirAll(Dir, 0700))
+		affirm.Nil(t, os.WriteFile(pth, []byte("[]int{\n  1,\n}\n"), 0600))
+
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := Match(tspy, []int{2}, WithName("name"))
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+		content, err := os.ReadFile(pth)
+		affirm.Nil(t, err)
+		affirm.Equal(t, "[]int{\n  2,\n}\n", string(content))
+	})
+
+	t.Run("multiple snapshots in a test", func(t *testing.T) {
+		// --- Given ---
+		t.Chdir(t.TempDir())
+		tspy := tester.New(t).ExpectCleanups(1).ExpectedNames(2).Close()
+
+		// --- When ---
+		have0 := Match(tspy, 1)
+		have1 := Match(tspy, 2)
+
+		// --- Then ---
+		affirm.Equal(t, true, have0)
+		affirm.Equal(t, true, have1)
+		name := "Test_Match/multiple_snapshots_in_a_test"
+		content, err := os.ReadFile(filepath.Join(Dir, name+".snap"))
+		affirm.Nil(t, err)
+		affirm.Equal(t, "1\n", string(content))
+		content, err = os.ReadFile(filepath.Join(Dir, name+"_2.snap"))
+		affirm.Nil(t, err)
+		affirm.Equal(t, "2\n", string(content))
+	})
+
+	t.Run("with dumper options", func(t *testing.T) {
+		// --- Given ---
+		t.Chdir(t.TempDir())
+		tspy := tester.New(t).Close()
+		opt := WithDumper(dump.WithFlat)
+
+		// --- When ---
+		have := Match(tspy, []int{1, 2}, WithName("n"), opt)
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+		content, err := os.ReadFile(filepath.Join(Dir, "n.snap"))
+		affirm.Nil(t, err)
+		affirm.Equal(t, "[]int{1, 2}\n", string(content))
+	})
+
+	t.Run("error - cannot write snapshot", func(t *testing.T) {
+		// --- Given ---
+		setUpdate(t)
+		t.Chdir(t.TempDir())
+		affirm.Nil(t, os.MkdirAll("testdata", 0700))
+		affirm.Nil(t, os.WriteFile(Dir, nil, 0600))
+
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("error writing snapshot:\n")
+		tspy.Close()
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { Match(tspy, 1, WithName("name")) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+
+	t.Run("error - cannot read snapshot", func(t *testing.T) {
+		// --- Given ---
+		t.Chdir(t.TempDir())
+		affirm.Nil(t, os.MkdirAll(filepath.Join(Dir, "name.snap"), 0700))
+
+		tspy := tester.New(t)
+		tspy.ExpectFatal()
+		tspy.ExpectLogContain("error reading snapshot:\n")
+		tspy.Close()
+
+		// --- When ---
+		msg := affirm.Panic(t, func() { Match(tspy, 1, WithName("name")) })
+
+		// --- Then ---
+		affirm.Equal(t, tester.FailNowMsg, *msg)
+	})
+}
+
+func Test_name(t *testing.T) {
+	t.Run("explicit", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).ExpectHelpers(0).Close()
+
+		// --- When ---
+		have := name(tspy, "a b")
+
+		// --- Then ---
+		affirm.Equal(t, "a_b", have)
+	})
+
+	t.Run("from test name", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).ExpectHelpers(0)
+		tspy.ExpectCleanups(1).ExpectedNames(2).Close()
+
+		// --- When ---
+		have0 := name(tspy, "")
+		have1 := name(tspy, "")
+
+		// --- Then ---
+		affirm.Equal(t, "Test_name/from_test_name", have0)
+		affirm.Equal(t, "Test_name/from_test_name_2", have1)
+	})
+
+	t.Run("counter removed at cleanup", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).ExpectHelpers(0)
+		tspy.ExpectCleanups(1).ExpectedNames(1).Close()
+		name(tspy, "")
+
+		// --- When ---
+		tspy.Finish()
+
+		// --- Then ---
+		_, ok := counters.Load(tspy)
+		affirm.Equal(t, false, ok)
+	})
+}
+
+func Test_sanitize_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		name string
+		want string
+	}{
+		{"empty", "", ""},
+		{"allowed", "Test_a-b.c/d", "Test_a-b.c/d"},
+		{"not allowed", `a:b*c?d"e<f>g|h\i j`, "a_b_c_d_e_f_g_h_i_j"},
+		{"unicode", "zażółć", "za____"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := sanitize(tc.name)
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_render(t *testing.T) {
+	t.Run("deep values are not truncated", func(t *testing.T) {
+		// --- Given ---
+		val := [][][][][][][][]int{{{{{{{{1}}}}}}}}
+
+		// --- When ---
+		have := render(val, dump.WithFlat)
+
+		// --- Then ---
+		affirm.Equal(t, "[][][][][][][][]int{{{{{{{{1}}}}}}}}\n", have)
+	})
+}
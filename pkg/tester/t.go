@@ -88,3 +88,23 @@ type T interface {
 	// completes.
 	Context() context.Context
 }
+
+// Minimal is the minimal subset of [T] used by assertions. It's small enough
+// to be implemented by custom test harnesses, recorders, or adapters used in
+// the TestMain function, where the [testing.T] is not available.
+type Minimal interface {
+	// Cleanup registers a function to be called when the test completes.
+	Cleanup(func())
+
+	// Error is equivalent to Log followed by Fail.
+	Error(args ...any)
+
+	// Fatal is equivalent to Log followed by FailNow.
+	Fatal(args ...any)
+
+	// Helper marks the calling function as a test helper function.
+	Helper()
+
+	// Name returns the name of the running (sub-) test.
+	Name() string
+}
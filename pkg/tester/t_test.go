@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package tester
+
+import (
+	"testing"
+)
+
+// Test managers which can be used with test helpers accepting [T].
+var (
+	_ T = (*testing.T)(nil)
+	_ T = (*testing.B)(nil)
+	_ T = (*testing.F)(nil)
+	_ T = (testing.TB)(nil)
+	_ T = (*Spy)(nil)
+)
+
+// Test managers which can be used with test helpers accepting [Minimal].
+var (
+	_ Minimal = (*testing.T)(nil)
+	_ Minimal = (*testing.B)(nil)
+	_ Minimal = (*testing.F)(nil)
+	_ Minimal = (testing.TB)(nil)
+	_ Minimal = (T)(nil)
+	_ Minimal = (*Spy)(nil)
+)